@@ -275,6 +275,7 @@ angular.module('serviceCenter.sc')
                                     json.basePath = "/testSchema" + json.basePath;
                                     json.instanceIP = ip;
                                     json.schemaName = selectedSchema;
+                                    json.serviceId = serviceId;
                                     var yamlString = YAML.stringify(json);
                                     $scope.testSchema = yamlString;
                                     $scope.showSchema = true;
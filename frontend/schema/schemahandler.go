@@ -18,47 +18,84 @@
 package schema
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo"
 )
 
-func SchemaHandleFunc(c echo.Context) (err error) {
-	r := c.Request()
+// SchemaHandleFunc returns the handler which proxies the test requests to
+// the provider instance, the responses are mocked by service center from
+// the schema if the provider is unavailable
+func SchemaHandleFunc(scAddr string) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		r := c.Request()
 
-	//	protocol:= r.Header.Get("X-InstanceProtocol")
-	//	sslActive:=r.Header.Get("X-InstanceSSL")
-	var (
-		response   *http.Response
-		req        *http.Request
-		instanceIP = r.Header.Get("X-InstanceIP")
-		requestUrl = strings.Replace(r.RequestURI, "testSchema/", "", 1)
-		url        = "http://" + instanceIP + requestUrl
-	)
+		//	protocol:= r.Header.Get("X-InstanceProtocol")
+		//	sslActive:=r.Header.Get("X-InstanceSSL")
+		var (
+			response   *http.Response
+			body       []byte
+			instanceIP = r.Header.Get("X-InstanceIP")
+			requestUrl = strings.Replace(r.RequestURI, "testSchema/", "", 1)
+		)
 
-	switch r.Method {
-	case "GET":
-		req, err = http.NewRequest(http.MethodGet, url, nil)
-	case "POST":
-		req, err = http.NewRequest(http.MethodPost, url, r.Body)
-	case "PUT":
-		req, err = http.NewRequest(http.MethodPut, url, r.Body)
-	case "DELETE":
-		req, err = http.NewRequest(http.MethodDelete, url, r.Body)
-	default:
-		c.String(http.StatusNotFound, "Method not found")
-		return
+		switch r.Method {
+		case "GET", "POST", "PUT", "DELETE":
+		default:
+			c.String(http.StatusNotFound, "Method not found")
+			return
+		}
+
+		body, err = ioutil.ReadAll(r.Body)
+		if err != nil {
+			c.String(http.StatusInternalServerError,
+				fmt.Sprintf("( Error while reading request due to : %s", err))
+			return
+		}
+
+		if len(instanceIP) > 0 {
+			response, err = forward(r, "http://"+instanceIP+requestUrl, body)
+			if err == nil {
+				return writeResponse(c, http.StatusOK, response)
+			}
+		}
+
+		serviceId, schemaId := r.Header.Get("X-ServiceId"), r.Header.Get("X-Schemaname")
+		if len(serviceId) == 0 || len(schemaId) == 0 {
+			if err == nil {
+				err = fmt.Errorf("no instance to test schema %s", schemaId)
+			}
+			c.String(http.StatusNotFound,
+				fmt.Sprintf("( Error while sending request due to : %s", err))
+			return
+		}
 
+		// the provider is unavailable, request the mock server
+		if len(r.Header.Get("X-Domain-Name")) == 0 {
+			r.Header.Set("X-Domain-Name", "default")
+		}
+		mockUrl := strings.TrimRight(scAddr, "/") + "/mock/" +
+			url.PathEscape(serviceId) + "/" + url.PathEscape(schemaId) + requestUrl
+		response, err = forward(r, mockUrl, body)
+		if err != nil {
+			c.String(http.StatusNotFound,
+				fmt.Sprintf("( Error while sending request due to : %s", err))
+			return
+		}
+		return writeResponse(c, response.StatusCode, response)
 	}
+}
 
+func forward(r *http.Request, target string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(r.Method, target, bytes.NewReader(body))
 	if err != nil {
-		c.String(http.StatusInternalServerError,
-			fmt.Sprintf("( Error while creating request due to : %s", err))
-		return
+		return nil, err
 	}
 
 	for key, values := range r.Header {
@@ -73,19 +110,18 @@ func SchemaHandleFunc(c echo.Context) (err error) {
 	}
 	req.Header.Add("Content-Type", "application/json")
 	client := http.Client{Timeout: time.Second * 20}
-	response, err = client.Do(req)
-	if err != nil {
-		c.String(http.StatusNotFound,
-			fmt.Sprintf("( Error while sending request due to : %s", err))
-		return
-	}
+	return client.Do(req)
+}
+
+func writeResponse(c echo.Context, code int, response *http.Response) error {
+	defer response.Body.Close()
 	respBody, err := ioutil.ReadAll(response.Body)
 	if err != nil {
 		c.String(http.StatusNotFound,
 			fmt.Sprintf("(could not fetch response body for error %s", err))
-		return
+		return nil
 	}
 
-	c.String(http.StatusOK, string(respBody))
+	c.String(code, string(respBody))
 	return nil
 }
@@ -67,6 +67,9 @@ func TestSCProxy(t *testing.T) {
 		e.GET("/sayHi", func(c echo.Context) error {
 			return c.String(http.StatusOK, greeting)
 		})
+		e.GET("/mock/:serviceId/:schemaId/*", func(c echo.Context) error {
+			return c.String(http.StatusOK, c.Param("serviceId")+"/"+c.Param("schemaId")+"/"+c.Param("*"))
+		})
 		wg.Done()
 		e.Start(SCAddr)
 	}()
@@ -89,3 +92,24 @@ func TestSCProxy(t *testing.T) {
 	}
 
 }
+
+func TestSchemaMock(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://"+FrontAddr+"/testSchema/hello", nil)
+	req.Header.Set("X-ServiceId", "provider")
+	req.Header.Set("X-Schemaname", "schema")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Errorf("Error accessing mock server: %s", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("Expected http %d, got %d", http.StatusOK, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Errorf("Error reading body: %s", err)
+	}
+	if string(body) != "provider/schema/hello" {
+		t.Errorf("Expected %s, got %s", "provider/schema/hello", string(body))
+	}
+}
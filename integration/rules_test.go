@@ -397,7 +397,7 @@ var _ = Describe("MicroService Api Test", func() {
 				defer resp.Body.Close()
 				Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
 
-				//Update Rules with invalid different ruleType
+				//Update Rules with different ruleType
 				updateParams = map[string]interface{}{
 					"ruleType":    "BLACK",
 					"attribute":   "tag_b",
@@ -413,7 +413,7 @@ var _ = Describe("MicroService Api Test", func() {
 				resp, err = scclient.Do(req)
 				Expect(err).To(BeNil())
 				defer resp.Body.Close()
-				Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
 
 			})
 
@@ -0,0 +1,195 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cipher
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+const (
+	// the cipher text is in format 'aes256gcm:<key id>:<base64(nonce|sealed)>'
+	CIPHER_TEXT_PREFIX = "aes256gcm:"
+	KEY_SIZE           = 32
+)
+
+var keyIdRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+// Keyring holds the AES-256 keys by id, the values are encrypted by the
+// primary key and decrypted by the key whose id is embedded in the cipher
+// text, so the keys can be rotated without re-encrypting at once
+type Keyring struct {
+	keys    map[string]cipher.AEAD
+	primary string
+}
+
+func NewKeyring() *Keyring {
+	return &Keyring{keys: make(map[string]cipher.AEAD)}
+}
+
+// Add adds the key, the last added key is the primary one by default
+func (k *Keyring) Add(id string, secret []byte) error {
+	if !keyIdRegex.MatchString(id) {
+		return fmt.Errorf("invalid key id '%s'", id)
+	}
+	if len(secret) != KEY_SIZE {
+		return fmt.Errorf("key %s is %d bytes, expected %d bytes", id, len(secret), KEY_SIZE)
+	}
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	k.keys[id] = aead
+	k.primary = id
+	return nil
+}
+
+// Parse adds the keys in lines or comma separated in format
+// '<key id>=<base64 key>', the blank lines and the lines start with '#'
+// are ignored
+func (k *Keyring) Parse(s string) error {
+	for _, line := range strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == ',' }) {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.Index(line, "=")
+		if i <= 0 {
+			return errors.New("invalid keyring line, expected '<key id>=<base64 key>'")
+		}
+		id := strings.TrimSpace(line[:i])
+		secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(line[i+1:]))
+		if err != nil {
+			return fmt.Errorf("invalid key %s: %s", id, err.Error())
+		}
+		if err := k.Add(id, secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load adds the keys in the keyring file
+func (k *Keyring) Load(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return k.Parse(string(data))
+}
+
+// SetPrimary sets the key to encrypt the values
+func (k *Keyring) SetPrimary(id string) error {
+	if _, ok := k.keys[id]; !ok {
+		return fmt.Errorf("key %s does not exist", id)
+	}
+	k.primary = id
+	return nil
+}
+
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+func (k *Keyring) Len() int {
+	return len(k.keys)
+}
+
+// Encrypt encrypts the value by the primary key
+func (k *Keyring) Encrypt(src string) (string, error) {
+	aead, ok := k.keys[k.primary]
+	if !ok {
+		return "", errors.New("no key in keyring")
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(src)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(src), []byte(k.primary))
+	return CIPHER_TEXT_PREFIX + k.primary + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts the value by the key whose id is embedded in it, the
+// leading and trailing spaces are ignored
+func (k *Keyring) Decrypt(src string) (string, error) {
+	id, data, err := parseCipherText(strings.TrimSpace(src))
+	if err != nil {
+		return "", err
+	}
+	aead, ok := k.keys[id]
+	if !ok {
+		return "", fmt.Errorf("key %s does not exist", id)
+	}
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("cipher text is too short")
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(id))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// IsStale returns true if the value is not encrypted by the primary key, it
+// should be encrypted again before the old key is removed
+func (k *Keyring) IsStale(src string) bool {
+	id, err := KeyIdOf(src)
+	return err != nil || id != k.primary
+}
+
+// KeyIdOf returns the id of the key which encrypted the value
+func KeyIdOf(src string) (string, error) {
+	id, _, err := parseCipherText(strings.TrimSpace(src))
+	return id, err
+}
+
+func parseCipherText(src string) (string, []byte, error) {
+	if !strings.HasPrefix(src, CIPHER_TEXT_PREFIX) {
+		return "", nil, errors.New("invalid cipher text, expected prefix " + CIPHER_TEXT_PREFIX)
+	}
+	src = src[len(CIPHER_TEXT_PREFIX):]
+	i := strings.Index(src, ":")
+	if i <= 0 {
+		return "", nil, errors.New("invalid cipher text, key id is missing")
+	}
+	data, err := base64.StdEncoding.DecodeString(src[i+1:])
+	if err != nil {
+		return "", nil, err
+	}
+	return src[:i], data, nil
+}
+
+// GenerateKey returns a random key encoded in base64
+func GenerateKey() (string, error) {
+	secret := make([]byte, KEY_SIZE)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cipher
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func newTestKeyring(t *testing.T, ids ...string) (*Keyring, string) {
+	var lines []string
+	for _, id := range ids {
+		key, err := GenerateKey()
+		if err != nil {
+			t.Fatalf("GenerateKey failed, %s", err)
+		}
+		lines = append(lines, id+"="+key)
+	}
+	k := NewKeyring()
+	s := "# keyring\n\n" + strings.Join(lines, "\n")
+	if err := k.Parse(s); err != nil {
+		t.Fatalf("Parse keyring failed, %s", err)
+	}
+	return k, s
+}
+
+func TestKeyring_Parse(t *testing.T) {
+	k, _ := newTestKeyring(t, "k1", "k2")
+	if k.Len() != 2 || k.Primary() != "k2" {
+		t.Fatalf("TestKeyring_Parse failed, %d keys, primary %s", k.Len(), k.Primary())
+	}
+	if err := k.SetPrimary("k3"); err == nil {
+		t.Fatalf("TestKeyring_Parse set primary to the missing key should fail")
+	}
+
+	key, _ := GenerateKey()
+	for _, s := range []string{"k1", "=" + key, "k1=!", "k1=YWJj", "k:1=" + key} {
+		if err := NewKeyring().Parse(s); err == nil {
+			t.Fatalf("TestKeyring_Parse %s should fail", s)
+		}
+	}
+	if err := NewKeyring().Parse("k1=" + key + ",k2=" + key); err != nil {
+		t.Fatalf("TestKeyring_Parse comma separated keys failed, %s", err)
+	}
+}
+
+func TestKeyring_Encrypt(t *testing.T) {
+	k, s := newTestKeyring(t, "k1")
+	c1, err := k.Encrypt("secret")
+	if err != nil {
+		t.Fatalf("TestKeyring_Encrypt failed, %s", err)
+	}
+	if c2, _ := k.Encrypt("secret"); c1 == c2 {
+		t.Fatalf("TestKeyring_Encrypt should use random nonce")
+	}
+	if id, _ := KeyIdOf(c1); id != "k1" || !strings.HasPrefix(c1, CIPHER_TEXT_PREFIX) {
+		t.Fatalf("TestKeyring_Encrypt failed, %s", c1)
+	}
+	if plain, err := k.Decrypt(c1 + "\n"); err != nil || plain != "secret" {
+		t.Fatalf("TestKeyring_Decrypt failed, %s, %v", plain, err)
+	}
+
+	// rotate the key
+	key, _ := GenerateKey()
+	if err := k.Parse("k2=" + key); err != nil {
+		t.Fatalf("TestKeyring_Encrypt add key failed, %s", err)
+	}
+	c2, _ := k.Encrypt("secret")
+	if id, _ := KeyIdOf(c2); id != "k2" {
+		t.Fatalf("TestKeyring_Encrypt rotate failed, %s", c2)
+	}
+	if !k.IsStale(c1) || k.IsStale(c2) || !k.IsStale("secret") {
+		t.Fatalf("TestKeyring_IsStale failed")
+	}
+	if plain, err := k.Decrypt(c1); err != nil || plain != "secret" {
+		t.Fatalf("TestKeyring_Decrypt by old key failed, %s, %v", plain, err)
+	}
+
+	// the old keyring does not have the new key
+	old := NewKeyring()
+	old.Parse(s)
+	if _, err := old.Decrypt(c2); err == nil {
+		t.Fatalf("TestKeyring_Decrypt by missing key should fail")
+	}
+	// the cipher text is bound to the key id
+	tampered := strings.Replace(c1, ":k1:", ":k2:", 1)
+	if _, err := k.Decrypt(tampered); err == nil {
+		t.Fatalf("TestKeyring_Decrypt tampered key id should fail")
+	}
+	for _, c := range []string{"secret", CIPHER_TEXT_PREFIX + "k1", CIPHER_TEXT_PREFIX + "k1:!", CIPHER_TEXT_PREFIX + "k1:YWJj"} {
+		if _, err := k.Decrypt(c); err == nil {
+			t.Fatalf("TestKeyring_Decrypt %s should fail", c)
+		}
+	}
+	if _, err := NewKeyring().Encrypt("secret"); err == nil {
+		t.Fatalf("TestKeyring_Encrypt by empty keyring should fail")
+	}
+}
+
+func TestKeyring_Load(t *testing.T) {
+	_, s := newTestKeyring(t, "k1")
+	f, err := ioutil.TempFile("", "keyring")
+	if err != nil {
+		t.Fatalf("TestKeyring_Load create file failed, %s", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(s)
+	f.Close()
+
+	k := NewKeyring()
+	if err := k.Load(f.Name()); err != nil || k.Primary() != "k1" {
+		t.Fatalf("TestKeyring_Load failed, %v", err)
+	}
+	if err := k.Load(f.Name() + ".missing"); err == nil {
+		t.Fatalf("TestKeyring_Load missing file should fail")
+	}
+}
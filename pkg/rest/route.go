@@ -39,7 +39,7 @@ type urlPatternHandler struct {
 }
 
 type Route struct {
-	// Method is one of the following: GET,PUT,POST,DELETE
+	// Method is one of the following: GET,PUT,POST,DELETE,PATCH
 	Method string
 	// Path contains a path pattern
 	Path string
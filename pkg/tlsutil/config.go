@@ -18,11 +18,13 @@ package tlsutil
 
 import (
 	"crypto/tls"
+	"time"
 )
 
 type SSLConfig struct {
 	VerifyPeer     bool
 	VerifyHostName bool
+	ServerName     string
 	CipherSuites   []uint16
 	MinVersion     uint16
 	MaxVersion     uint16
@@ -30,12 +32,15 @@ type SSLConfig struct {
 	CertFile       string
 	KeyFile        string
 	KeyPassphase   string
+	CRLFile        string
+	ReloadInterval time.Duration
 }
 
 type SSLConfigOption func(*SSLConfig)
 
 func WithVerifyPeer(b bool) SSLConfigOption      { return func(c *SSLConfig) { c.VerifyPeer = b } }
 func WithVerifyHostName(b bool) SSLConfigOption  { return func(c *SSLConfig) { c.VerifyHostName = b } }
+func WithServerName(s string) SSLConfigOption    { return func(c *SSLConfig) { c.ServerName = s } }
 func WithCipherSuits(s []uint16) SSLConfigOption { return func(c *SSLConfig) { c.CipherSuites = s } }
 func WithVersion(min, max uint16) SSLConfigOption {
 	return func(c *SSLConfig) { c.MinVersion, c.MaxVersion = min, max }
@@ -44,6 +49,10 @@ func WithCert(f string) SSLConfigOption    { return func(c *SSLConfig) { c.CertF
 func WithKey(k string) SSLConfigOption     { return func(c *SSLConfig) { c.KeyFile = k } }
 func WithKeyPass(p string) SSLConfigOption { return func(c *SSLConfig) { c.KeyPassphase = p } }
 func WithCA(f string) SSLConfigOption      { return func(c *SSLConfig) { c.CACertFile = f } }
+func WithCRL(f string) SSLConfigOption     { return func(c *SSLConfig) { c.CRLFile = f } }
+func WithReloadInterval(d time.Duration) SSLConfigOption {
+	return func(c *SSLConfig) { c.ReloadInterval = d }
+}
 
 func toSSLConfig(opts ...SSLConfigOption) (op SSLConfig) {
 	for _, opt := range opts {
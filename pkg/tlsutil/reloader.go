@@ -0,0 +1,417 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"golang.org/x/net/context"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+type fileStamp struct {
+	ModTime time.Time
+	Size    int64
+}
+
+// Reloader holds the certificate, the CA bundle and the CRL loaded from the
+// files, and reloads them when the files are modified, so that the
+// certificates can be rotated without restarting.
+// The tls.Config made by the Reloader picks the certificate by
+// GetCertificate/GetClientCertificate and verifies the peer certificate
+// against the current CA bundle and CRL in VerifyPeerCertificate.
+// GetConfigForClient is not used, because the servers clone the tls.Config
+// to set the NextProtos, which the config returned per handshake would lose.
+type Reloader struct {
+	load func() []SSLConfigOption
+
+	mux     sync.RWMutex
+	cfg     SSLConfig
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+	revoked map[string]struct{}
+	stamps  map[string]fileStamp
+}
+
+// NewReloader loads the files specified by the options returned from the
+// load func. The load func is called on every reload, so that the key
+// passphase can be read again.
+func NewReloader(load func() []SSLConfigOption) (*Reloader, error) {
+	r := &Reloader{load: load}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reloader) files(cfg SSLConfig) (files []string) {
+	for _, f := range []string{cfg.CACertFile, cfg.CertFile, cfg.KeyFile, cfg.CRLFile} {
+		if len(f) > 0 {
+			files = append(files, f)
+		}
+	}
+	return
+}
+
+func (r *Reloader) stat(files []string) map[string]fileStamp {
+	stamps := make(map[string]fileStamp, len(files))
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			// an absent file is treated as modified, the reload reports it
+			continue
+		}
+		stamps[f] = fileStamp{ModTime: fi.ModTime(), Size: fi.Size()}
+	}
+	return stamps
+}
+
+// Modified returns true if any of the loaded files is modified since the
+// last successful reload
+func (r *Reloader) Modified() bool {
+	r.mux.RLock()
+	files, stamps := r.files(r.cfg), r.stamps
+	r.mux.RUnlock()
+
+	current := r.stat(files)
+	if len(current) != len(stamps) {
+		return true
+	}
+	for f, stamp := range current {
+		old, ok := stamps[f]
+		if !ok || !old.ModTime.Equal(stamp.ModTime) || old.Size != stamp.Size {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload loads all the files, the current ones are kept if any of the files
+// fails to load, e.g. the certificate is replaced but the key is not yet
+func (r *Reloader) Reload() error {
+	cfg := toSSLConfig(r.load()...)
+	// stat before read, so a modification during the read is reloaded next time
+	stamps := r.stat(r.files(cfg))
+
+	var (
+		cert    *tls.Certificate
+		pool    *x509.CertPool
+		cas     []*x509.Certificate
+		revoked map[string]struct{}
+	)
+	if len(cfg.CertFile) > 0 {
+		certs, err := LoadTLSCertificate(cfg.CertFile, cfg.KeyFile, cfg.KeyPassphase)
+		if err != nil {
+			return err
+		}
+		cert = &certs[0]
+	}
+	if len(cfg.CACertFile) > 0 {
+		var err error
+		pool, cas, err = loadCACertificates(cfg.CACertFile)
+		if err != nil {
+			return err
+		}
+	}
+	if len(cfg.CRLFile) > 0 {
+		var err error
+		revoked, err = loadCRL(cfg.CRLFile, cas)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.mux.Lock()
+	r.cfg, r.cert, r.pool, r.revoked, r.stamps = cfg, cert, pool, revoked, stamps
+	r.mux.Unlock()
+	return nil
+}
+
+// Run checks the files every reload interval and reloads them if modified,
+// it returns immediately if the interval is not positive
+func (r *Reloader) Run(ctx context.Context) {
+	r.mux.RLock()
+	interval := r.cfg.ReloadInterval
+	r.mux.RUnlock()
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.Modified() {
+				continue
+			}
+			if err := r.Reload(); err != nil {
+				util.Logger().Errorf(err, "reload tls files failed, keep the current ones.")
+				continue
+			}
+			util.Logger().Infof("reload tls files successfully.")
+		}
+	}
+}
+
+// Certificate returns the current certificate
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.cert
+}
+
+// CertPool returns the current CA bundle
+func (r *Reloader) CertPool() *x509.CertPool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.pool
+}
+
+// IsRevoked returns true if the certificate is listed in the current CRL
+func (r *Reloader) IsRevoked(cert *x509.Certificate) bool {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	_, ok := r.revoked[revokedKey(cert.Issuer, cert.SerialNumber.String())]
+	return ok
+}
+
+func (r *Reloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if cert := r.Certificate(); cert != nil {
+		return cert, nil
+	}
+	return nil, errors.New("no server certificate")
+}
+
+func (r *Reloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if cert := r.Certificate(); cert != nil {
+		return cert, nil
+	}
+	// send no certificate
+	return &tls.Certificate{}, nil
+}
+
+// VerifyClientCertificate verifies the client certificate chain against the
+// current CA bundle and CRL
+func (r *Reloader) VerifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("client certificate is required")
+	}
+	return r.verifyChain(rawCerts, x509.ExtKeyUsageClientAuth, "")
+}
+
+// VerifyServerCertificate verifies the server certificate chain against the
+// current CA bundle and CRL, and the host name if it is not empty
+func (r *Reloader) VerifyServerCertificate(rawCerts [][]byte, hostName string) error {
+	if len(rawCerts) == 0 {
+		return errors.New("server certificate is required")
+	}
+	return r.verifyChain(rawCerts, x509.ExtKeyUsageServerAuth, hostName)
+}
+
+func (r *Reloader) verifyChain(rawCerts [][]byte, usage x509.ExtKeyUsage, hostName string) error {
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs = append(certs, cert)
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         r.CertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+		DNSName:       hostName,
+	}
+	if opts.Roots == nil {
+		return errors.New("no trusted certificate authority")
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	chains, err := certs[0].Verify(opts)
+	if err != nil {
+		return err
+	}
+	for _, chain := range chains {
+		for _, cert := range chain {
+			if r.IsRevoked(cert) {
+				return fmt.Errorf("certificate %s(serial %s) is revoked",
+					cert.Subject.CommonName, cert.SerialNumber.String())
+			}
+		}
+	}
+	return nil
+}
+
+// ServerConfig returns the server tls.Config with the protocol options, the
+// certificate, CA bundle and CRL are the current ones of the Reloader
+func (r *Reloader) ServerConfig(opts ...SSLConfigOption) *tls.Config {
+	cfg := toSSLConfig(opts...)
+	tlsConfig := &tls.Config{
+		GetCertificate:           r.getCertificate,
+		CipherSuites:             cfg.CipherSuites,
+		PreferServerCipherSuites: true,
+		ClientAuth:               tls.NoClientCert,
+		MinVersion:               cfg.MinVersion,
+		MaxVersion:               cfg.MaxVersion,
+	}
+	if cfg.VerifyPeer {
+		// the chain is verified by VerifyClientCertificate instead of ClientCAs,
+		// so that the reloaded CA bundle takes effect
+		tlsConfig.ClientAuth = tls.RequireAnyClientCert
+		tlsConfig.VerifyPeerCertificate = r.VerifyClientCertificate
+	}
+	return tlsConfig
+}
+
+// ClientConfig returns the client tls.Config with the protocol options, the
+// certificate, CA bundle and CRL are the current ones of the Reloader.
+// The host name is verified against the ServerName option instead of the
+// ServerName of the returned tls.Config, since http.Transport and grpc clone
+// the tls.Config before setting it, so the option is required if the
+// VerifyHostName option is true
+func (r *Reloader) ClientConfig(opts ...SSLConfigOption) *tls.Config {
+	cfg := toSSLConfig(opts...)
+	tlsConfig := &tls.Config{
+		GetClientCertificate: r.getClientCertificate,
+		CipherSuites:         cfg.CipherSuites,
+		InsecureSkipVerify:   !cfg.VerifyHostName,
+		ServerName:           cfg.ServerName,
+		MinVersion:           cfg.MinVersion,
+		MaxVersion:           cfg.MaxVersion,
+	}
+	if cfg.VerifyPeer {
+		// the chain is verified by VerifyServerCertificate instead of RootCAs,
+		// so that the reloaded CA bundle takes effect
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if !cfg.VerifyHostName {
+				return r.VerifyServerCertificate(rawCerts, "")
+			}
+			if len(cfg.ServerName) == 0 {
+				return errors.New("server name is required to verify the host name")
+			}
+			return r.VerifyServerCertificate(rawCerts, cfg.ServerName)
+		}
+	}
+	return tlsConfig
+}
+
+func loadCACertificates(caCertFile string) (*x509.CertPool, []*x509.Certificate, error) {
+	content, err := ioutil.ReadFile(caCertFile)
+	if err != nil {
+		util.Logger().Errorf(err, "read ca cert file %s failed.", caCertFile)
+		return nil, nil, err
+	}
+
+	pool := x509.NewCertPool()
+	var cas []*x509.Certificate
+	for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			util.Logger().Errorf(err, "parse ca cert file %s failed.", caCertFile)
+			return nil, nil, err
+		}
+		pool.AddCert(cert)
+		cas = append(cas, cert)
+	}
+	if len(cas) == 0 {
+		err := fmt.Errorf("no certificate found in %s", caCertFile)
+		util.Logger().Errorf(err, "parse ca cert file %s failed.", caCertFile)
+		return nil, nil, err
+	}
+	return pool, cas, nil
+}
+
+// loadCRL returns the revoked certificates listed in the CRL file in PEM or
+// DER format, the CRLs must be signed by the CA certificates
+func loadCRL(crlFile string, cas []*x509.Certificate) (map[string]struct{}, error) {
+	content, err := ioutil.ReadFile(crlFile)
+	if err != nil {
+		util.Logger().Errorf(err, "read crl file %s failed.", crlFile)
+		return nil, err
+	}
+	if len(cas) == 0 {
+		err := errors.New("the ca cert file is required")
+		util.Logger().Errorf(err, "verify crl file %s failed.", crlFile)
+		return nil, err
+	}
+
+	var ders [][]byte
+	for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "X509 CRL" {
+			ders = append(ders, block.Bytes)
+		}
+	}
+	if len(ders) == 0 {
+		ders = append(ders, content)
+	}
+
+	revoked := make(map[string]struct{})
+	now := time.Now()
+	for _, der := range ders {
+		crl, err := x509.ParseDERCRL(der)
+		if err != nil {
+			util.Logger().Errorf(err, "parse crl file %s failed.", crlFile)
+			return nil, err
+		}
+		if err := checkCRLSignature(crl, cas); err != nil {
+			util.Logger().Errorf(err, "verify crl file %s failed.", crlFile)
+			return nil, err
+		}
+		if crl.HasExpired(now) {
+			util.Logger().Warnf(nil, "crl file %s is out of date, next update is %s.",
+				crlFile, crl.TBSCertList.NextUpdate)
+		}
+
+		var issuer pkix.Name
+		issuer.FillFromRDNSequence(&crl.TBSCertList.Issuer)
+		for _, cert := range crl.TBSCertList.RevokedCertificates {
+			revoked[revokedKey(issuer, cert.SerialNumber.String())] = struct{}{}
+		}
+	}
+	return revoked, nil
+}
+
+func checkCRLSignature(crl *pkix.CertificateList, cas []*x509.Certificate) (err error) {
+	for _, ca := range cas {
+		if err = ca.CheckCRLSignature(crl); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("crl is not signed by any ca certificate: %v", err)
+}
+
+func revokedKey(issuer pkix.Name, serial string) string {
+	return util.StringJoin([]string{issuer.String(), serial}, "/")
+}
@@ -0,0 +1,281 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, cn string, serial int64) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     []string{cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func (ca *testCA) crl(t *testing.T, serials ...int64) []byte {
+	var revoked []pkix.RevokedCertificate
+	for _, serial := range serials {
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   big.NewInt(serial),
+			RevocationTime: time.Now(),
+		})
+	}
+	der, err := ca.cert.CreateCRL(rand.Reader, ca.key, revoked, time.Now(), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func writeFile(t *testing.T, path string, content []byte) {
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	// make the modification visible on the file systems of coarse mtime
+	later := time.Now().Add(time.Duration(len(content)) * time.Second)
+	os.Chtimes(path, later, later)
+}
+
+func rawCertOf(t *testing.T, certPEM []byte) [][]byte {
+	block, _ := pem.Decode(certPEM)
+	return [][]byte{block.Bytes}
+}
+
+// handshake dials the server like http.Transport does, which sets the
+// ServerName on a clone of the client tls.Config
+func handshake(serverConfig, clientConfig *tls.Config) error {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		conn.(*tls.Conn).Handshake()
+		conn.Close()
+	}()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	cfg := clientConfig.Clone()
+	cfg.ServerName = "127.0.0.1"
+	return tls.Client(conn, cfg).Handshake()
+}
+
+func TestReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t)
+	caFile := filepath.Join(dir, "trust.cer")
+	certFile := filepath.Join(dir, "server.cer")
+	keyFile := filepath.Join(dir, "server_key.pem")
+	crlFile := filepath.Join(dir, "trust.crl")
+	writeFile(t, caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))
+	certPEM, keyPEM := ca.issue(t, "server", 10)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+	writeFile(t, crlFile, ca.crl(t))
+
+	r, err := NewReloader(func() []SSLConfigOption {
+		return []SSLConfigOption{
+			WithCA(caFile),
+			WithCert(certFile),
+			WithKey(keyFile),
+			WithCRL(crlFile),
+		}
+	})
+	if err != nil {
+		t.Fatalf("NewReloader failed, %v", err)
+	}
+	if r.Modified() {
+		t.Fatalf("Modified failed")
+	}
+
+	serverConfig := r.ServerConfig(DefaultServerTLSOptions()...)
+	if serverConfig.ClientAuth != tls.RequireAnyClientCert || serverConfig.VerifyPeerCertificate == nil {
+		t.Fatalf("ServerConfig failed")
+	}
+	old, err := serverConfig.GetCertificate(nil)
+	if err != nil || old == nil {
+		t.Fatalf("GetCertificate failed, %v", err)
+	}
+	clientConfig := r.ClientConfig(DefaultClientTLSOptions()...)
+	if !clientConfig.InsecureSkipVerify || clientConfig.VerifyPeerCertificate == nil {
+		t.Fatalf("ClientConfig failed")
+	}
+	if err := clientConfig.VerifyPeerCertificate(rawCertOf(t, certPEM), nil); err == nil {
+		t.Fatalf("VerifyPeerCertificate without server name failed")
+	}
+	otherConfig := r.ClientConfig(append(DefaultClientTLSOptions(), WithServerName("other"))...)
+	if err := otherConfig.VerifyPeerCertificate(rawCertOf(t, certPEM), nil); err == nil {
+		t.Fatalf("VerifyPeerCertificate of mismatched host name failed")
+	}
+	hostConfig := r.ClientConfig(append(DefaultClientTLSOptions(), WithServerName("server"))...)
+	if err := hostConfig.VerifyPeerCertificate(rawCertOf(t, certPEM), nil); err != nil {
+		t.Fatalf("VerifyPeerCertificate of server failed, %v", err)
+	}
+	// the test certificates are ECDSA, which the RSA cipher suites refuse
+	ecdsaServerConfig := r.ServerConfig(WithVerifyPeer(true), WithVersion(tls.VersionTLS12, tls.VersionTLS12))
+	if err := handshake(ecdsaServerConfig, hostConfig); err != nil {
+		t.Fatalf("handshake of server failed, %v", err)
+	}
+	if err := handshake(ecdsaServerConfig, otherConfig); err == nil {
+		t.Fatalf("handshake of mismatched host name failed")
+	}
+	noHostConfig := r.ClientConfig(WithVerifyPeer(true))
+	if err := noHostConfig.VerifyPeerCertificate(rawCertOf(t, certPEM), nil); err != nil {
+		t.Fatalf("VerifyPeerCertificate without host name failed, %v", err)
+	}
+
+	clientPEM, _ := ca.issue(t, "client", 20)
+	if err := serverConfig.VerifyPeerCertificate(rawCertOf(t, clientPEM), nil); err != nil {
+		t.Fatalf("VerifyPeerCertificate failed, %v", err)
+	}
+	if err := serverConfig.VerifyPeerCertificate(nil, nil); err == nil {
+		t.Fatalf("VerifyPeerCertificate without certificate failed")
+	}
+	otherPEM, _ := newTestCA(t).issue(t, "client", 20)
+	if err := serverConfig.VerifyPeerCertificate(rawCertOf(t, otherPEM), nil); err == nil {
+		t.Fatalf("VerifyPeerCertificate of unknown authority failed")
+	}
+
+	// revoke the client certificate
+	writeFile(t, crlFile, ca.crl(t, 20))
+	if !r.Modified() {
+		t.Fatalf("Modified failed")
+	}
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload failed, %v", err)
+	}
+	if err := serverConfig.VerifyPeerCertificate(rawCertOf(t, clientPEM), nil); err == nil {
+		t.Fatalf("VerifyPeerCertificate of revoked certificate failed")
+	}
+
+	// the CRL not signed by the CA is refused
+	writeFile(t, crlFile, newTestCA(t).crl(t))
+	if err := r.Reload(); err == nil {
+		t.Fatalf("Reload untrusted crl failed")
+	}
+
+	// the certificate is replaced but the key is not yet
+	writeFile(t, crlFile, ca.crl(t))
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload failed, %v", err)
+	}
+	old, _ = serverConfig.GetCertificate(nil)
+	certPEM, keyPEM = ca.issue(t, "server", 11)
+	writeFile(t, certFile, certPEM)
+	if err := r.Reload(); err == nil {
+		t.Fatalf("Reload mismatched key failed")
+	}
+	if cert, _ := serverConfig.GetCertificate(nil); cert != old {
+		t.Fatalf("Reload should keep the current certificate")
+	}
+
+	writeFile(t, keyFile, keyPEM)
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload failed, %v", err)
+	}
+	if cert, _ := serverConfig.GetCertificate(nil); cert == old {
+		t.Fatalf("Reload certificate failed")
+	}
+	if r.Modified() {
+		t.Fatalf("Modified failed")
+	}
+
+	// rotate the CA bundle, the new authority is trusted by the existing configs
+	newCA := newTestCA(t)
+	newServerPEM, _ := newCA.issue(t, "server", 30)
+	if err := hostConfig.VerifyPeerCertificate(rawCertOf(t, newServerPEM), nil); err == nil {
+		t.Fatalf("VerifyPeerCertificate of unknown authority failed")
+	}
+	writeFile(t, caFile, append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newCA.cert.Raw})...))
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload failed, %v", err)
+	}
+	if err := hostConfig.VerifyPeerCertificate(rawCertOf(t, newServerPEM), nil); err != nil {
+		t.Fatalf("VerifyPeerCertificate of rotated authority failed, %v", err)
+	}
+}
@@ -144,6 +144,28 @@ func ParseTargetProject(ctx context.Context) string {
 	return v
 }
 
+// ParseOperator returns the identity of the request sender which is
+// authenticated by the auth plugin, it is empty if unknown
+func ParseOperator(ctx context.Context) string {
+	v, _ := FromContext(ctx, "operator").(string)
+	return v
+}
+
+func SetOperator(ctx context.Context, operator string) context.Context {
+	return SetContext(ctx, "operator", operator)
+}
+
+// IsAdmin returns true if the request sender is authenticated as an
+// administrator, who can override the ownership of the resources
+func IsAdmin(ctx context.Context) bool {
+	v, _ := FromContext(ctx, "admin").(bool)
+	return v
+}
+
+func SetAdmin(ctx context.Context, admin bool) context.Context {
+	return SetContext(ctx, "admin", admin)
+}
+
 func SetDomain(ctx context.Context, domain string) context.Context {
 	return SetContext(ctx, "domain", domain)
 }
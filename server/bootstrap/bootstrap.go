@@ -28,6 +28,7 @@ import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/i
 
 // cipher
 import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/security/buildin"
+import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/security/aes"
 
 // quota
 import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/quota/buildin"
@@ -35,6 +36,10 @@ import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/i
 
 // auth
 import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/auth/buildin"
+import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/auth/rbac"
+
+// auditlog
+import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/auditlog/buildin"
 
 // uuid
 import _ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/uuid/buildin"
@@ -51,16 +56,21 @@ import _ "github.com/apache/incubator-servicecomb-service-center/server/govern"
 // module 'broker'
 import _ "github.com/apache/incubator-servicecomb-service-center/server/broker"
 
+// module 'mock'
+import _ "github.com/apache/incubator-servicecomb-service-center/server/mock"
+
 // metrics
 import _ "github.com/apache/incubator-servicecomb-service-center/server/metric"
 
 import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/handler/audit"
 	"github.com/apache/incubator-servicecomb-service-center/server/handler/auth"
 	"github.com/apache/incubator-servicecomb-service-center/server/handler/cache"
 	"github.com/apache/incubator-servicecomb-service-center/server/handler/context"
 	"github.com/apache/incubator-servicecomb-service-center/server/handler/maxbody"
 	"github.com/apache/incubator-servicecomb-service-center/server/handler/metric"
+	"github.com/apache/incubator-servicecomb-service-center/server/handler/ratelimit"
 	"github.com/apache/incubator-servicecomb-service-center/server/handler/tracing"
 	"github.com/apache/incubator-servicecomb-service-center/server/interceptor"
 	"github.com/apache/incubator-servicecomb-service-center/server/interceptor/access"
@@ -80,5 +90,7 @@ func init() {
 	tracing.RegisterHandlers()
 	auth.RegisterHandlers()
 	context.RegisterHandlers()
+	ratelimit.RegisterHandlers()
+	audit.RegisterHandlers()
 	cache.RegisterHandlers()
 }
@@ -22,6 +22,7 @@ import (
 
 func init() {
 	registerREST()
+	startRetentionJob()
 }
 
 func registerREST() {
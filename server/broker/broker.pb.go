@@ -20,9 +20,11 @@
 Package broker is a generated protocol buffer package.
 
 It is generated from these files:
+
 	server/broker/broker.proto
 
 It has these top-level messages:
+
 	Participant
 	Version
 	Pact
@@ -48,6 +50,33 @@ It has these top-level messages:
 	BaseBrokerRequest
 	BrokerAPIInfoEntry
 	BrokerHomeResponse
+	VersionTagRequest
+	VersionTagResponse
+	CanIDeployRequest
+	MatrixRow
+	CanIDeploySummary
+	CanIDeployResponse
+	WebhookRequest
+	Webhook
+	WebhookExecution
+	PutWebhookRequest
+	GetWebhookRequest
+	WebhookResponse
+	GetWebhooksResponse
+	GetWebhookExecutionsResponse
+	MatrixRequest
+	MatrixResponse
+	GetParticipantsResponse
+	LatestPact
+	GetLatestPactsResponse
+	StaticVerificationIssue
+	StaticVerification
+	StaticVerificationResponse
+	RetentionPolicy
+	RetentionVersion
+	RetentionReport
+	RunRetentionRequest
+	RetentionResponse
 */
 package broker
 
@@ -252,10 +281,11 @@ func (m *Tag) GetVersionId() int32 {
 }
 
 type PublishPactRequest struct {
-	ProviderId string `protobuf:"bytes,1,opt,name=providerId" json:"providerId,omitempty"`
-	ConsumerId string `protobuf:"bytes,2,opt,name=consumerId" json:"consumerId,omitempty"`
-	Version    string `protobuf:"bytes,3,opt,name=version" json:"version,omitempty"`
-	Pact       []byte `protobuf:"bytes,4,opt,name=pact,proto3" json:"pact,omitempty"`
+	ProviderId string             `protobuf:"bytes,1,opt,name=providerId" json:"providerId,omitempty"`
+	ConsumerId string             `protobuf:"bytes,2,opt,name=consumerId" json:"consumerId,omitempty"`
+	Version    string             `protobuf:"bytes,3,opt,name=version" json:"version,omitempty"`
+	Pact       []byte             `protobuf:"bytes,4,opt,name=pact,proto3" json:"pact,omitempty"`
+	BaseUrl    *BaseBrokerRequest `protobuf:"bytes,5,opt,name=baseUrl" json:"baseUrl,omitempty"`
 }
 
 func (m *PublishPactRequest) Reset()                    { *m = PublishPactRequest{} }
@@ -291,8 +321,16 @@ func (m *PublishPactRequest) GetPact() []byte {
 	return nil
 }
 
+func (m *PublishPactRequest) GetBaseUrl() *BaseBrokerRequest {
+	if m != nil {
+		return m.BaseUrl
+	}
+	return nil
+}
+
 type PublishPactResponse struct {
-	Response *services.Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Response           *services.Response  `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	StaticVerification *StaticVerification `protobuf:"bytes,2,opt,name=staticVerification" json:"staticVerification,omitempty"`
 }
 
 func (m *PublishPactResponse) Reset()                    { *m = PublishPactResponse{} }
@@ -307,9 +345,17 @@ func (m *PublishPactResponse) GetResponse() *services.Response {
 	return nil
 }
 
+func (m *PublishPactResponse) GetStaticVerification() *StaticVerification {
+	if m != nil {
+		return m.StaticVerification
+	}
+	return nil
+}
+
 type GetAllProviderPactsRequest struct {
 	ProviderId string             `protobuf:"bytes,1,opt,name=providerId" json:"providerId,omitempty"`
 	BaseUrl    *BaseBrokerRequest `protobuf:"bytes,2,opt,name=baseUrl" json:"baseUrl,omitempty"`
+	Tag        string             `protobuf:"bytes,3,opt,name=tag" json:"tag,omitempty"`
 }
 
 func (m *GetAllProviderPactsRequest) Reset()                    { *m = GetAllProviderPactsRequest{} }
@@ -331,6 +377,13 @@ func (m *GetAllProviderPactsRequest) GetBaseUrl() *BaseBrokerRequest {
 	return nil
 }
 
+func (m *GetAllProviderPactsRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
 type ConsumerInfo struct {
 	Href string `protobuf:"bytes,1,opt,name=href" json:"href,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
@@ -650,11 +703,12 @@ func (m *VerificationResult) GetXEmbedded() *VerificationDetails {
 }
 
 type PublishVerificationRequest struct {
-	ProviderId                 string `protobuf:"bytes,1,opt,name=providerId" json:"providerId,omitempty"`
-	ConsumerId                 string `protobuf:"bytes,2,opt,name=consumerId" json:"consumerId,omitempty"`
-	PactId                     int32  `protobuf:"varint,3,opt,name=pactId" json:"pactId,omitempty"`
-	Success                    bool   `protobuf:"varint,4,opt,name=success" json:"success,omitempty"`
-	ProviderApplicationVersion string `protobuf:"bytes,5,opt,name=providerApplicationVersion" json:"providerApplicationVersion,omitempty"`
+	ProviderId                 string             `protobuf:"bytes,1,opt,name=providerId" json:"providerId,omitempty"`
+	ConsumerId                 string             `protobuf:"bytes,2,opt,name=consumerId" json:"consumerId,omitempty"`
+	PactId                     int32              `protobuf:"varint,3,opt,name=pactId" json:"pactId,omitempty"`
+	Success                    bool               `protobuf:"varint,4,opt,name=success" json:"success,omitempty"`
+	ProviderApplicationVersion string             `protobuf:"bytes,5,opt,name=providerApplicationVersion" json:"providerApplicationVersion,omitempty"`
+	BaseUrl                    *BaseBrokerRequest `protobuf:"bytes,6,opt,name=baseUrl" json:"baseUrl,omitempty"`
 }
 
 func (m *PublishVerificationRequest) Reset()                    { *m = PublishVerificationRequest{} }
@@ -697,6 +751,13 @@ func (m *PublishVerificationRequest) GetProviderApplicationVersion() string {
 	return ""
 }
 
+func (m *PublishVerificationRequest) GetBaseUrl() *BaseBrokerRequest {
+	if m != nil {
+		return m.BaseUrl
+	}
+	return nil
+}
+
 type PublishVerificationResponse struct {
 	Response     *services.Response  `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	Confirmation *VerificationDetail `protobuf:"bytes,2,opt,name=confirmation" json:"confirmation,omitempty"`
@@ -865,6 +926,1035 @@ func (m *BrokerHomeResponse) GetCuries() []*BrokerAPIInfoEntry {
 	return nil
 }
 
+type VersionTagRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=serviceId" json:"serviceId,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	Tag       string `protobuf:"bytes,3,opt,name=tag" json:"tag,omitempty"`
+}
+
+func (m *VersionTagRequest) Reset()         { *m = VersionTagRequest{} }
+func (m *VersionTagRequest) String() string { return proto.CompactTextString(m) }
+func (*VersionTagRequest) ProtoMessage()    {}
+
+func (m *VersionTagRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *VersionTagRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *VersionTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type VersionTagResponse struct {
+	Response *services.Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Tags     []string           `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *VersionTagResponse) Reset()         { *m = VersionTagResponse{} }
+func (m *VersionTagResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionTagResponse) ProtoMessage()    {}
+
+func (m *VersionTagResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *VersionTagResponse) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type CanIDeployRequest struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=serviceId" json:"serviceId,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to" json:"to,omitempty"`
+}
+
+func (m *CanIDeployRequest) Reset()         { *m = CanIDeployRequest{} }
+func (m *CanIDeployRequest) String() string { return proto.CompactTextString(m) }
+func (*CanIDeployRequest) ProtoMessage()    {}
+
+func (m *CanIDeployRequest) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *CanIDeployRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CanIDeployRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type MatrixRow struct {
+	Consumer         *Participant                   `protobuf:"bytes,1,opt,name=consumer" json:"consumer,omitempty"`
+	ConsumerVersion  string                         `protobuf:"bytes,2,opt,name=consumerVersion" json:"consumerVersion,omitempty"`
+	Provider         *Participant                   `protobuf:"bytes,3,opt,name=provider" json:"provider,omitempty"`
+	ProviderVersion  string                         `protobuf:"bytes,4,opt,name=providerVersion" json:"providerVersion,omitempty"`
+	PactId           int32                          `protobuf:"varint,5,opt,name=pactId" json:"pactId,omitempty"`
+	Status           string                         `protobuf:"bytes,6,opt,name=status" json:"status,omitempty"`
+	VerificationDate string                         `protobuf:"bytes,7,opt,name=verificationDate" json:"verificationDate,omitempty"`
+	XLinks           map[string]*BrokerAPIInfoEntry `protobuf:"bytes,8,rep,name=_links,json=Links" json:"_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *MatrixRow) Reset()         { *m = MatrixRow{} }
+func (m *MatrixRow) String() string { return proto.CompactTextString(m) }
+func (*MatrixRow) ProtoMessage()    {}
+
+func (m *MatrixRow) GetConsumer() *Participant {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
+func (m *MatrixRow) GetConsumerVersion() string {
+	if m != nil {
+		return m.ConsumerVersion
+	}
+	return ""
+}
+
+func (m *MatrixRow) GetProvider() *Participant {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *MatrixRow) GetProviderVersion() string {
+	if m != nil {
+		return m.ProviderVersion
+	}
+	return ""
+}
+
+func (m *MatrixRow) GetPactId() int32 {
+	if m != nil {
+		return m.PactId
+	}
+	return 0
+}
+
+func (m *MatrixRow) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MatrixRow) GetVerificationDate() string {
+	if m != nil {
+		return m.VerificationDate
+	}
+	return ""
+}
+
+func (m *MatrixRow) GetXLinks() map[string]*BrokerAPIInfoEntry {
+	if m != nil {
+		return m.XLinks
+	}
+	return nil
+}
+
+type CanIDeploySummary struct {
+	Deployable bool   `protobuf:"varint,1,opt,name=deployable" json:"deployable,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	Success    int32  `protobuf:"varint,3,opt,name=success" json:"success,omitempty"`
+	Failed     int32  `protobuf:"varint,4,opt,name=failed" json:"failed,omitempty"`
+	Unknown    int32  `protobuf:"varint,5,opt,name=unknown" json:"unknown,omitempty"`
+}
+
+func (m *CanIDeploySummary) Reset()         { *m = CanIDeploySummary{} }
+func (m *CanIDeploySummary) String() string { return proto.CompactTextString(m) }
+func (*CanIDeploySummary) ProtoMessage()    {}
+
+func (m *CanIDeploySummary) GetDeployable() bool {
+	if m != nil {
+		return m.Deployable
+	}
+	return false
+}
+
+func (m *CanIDeploySummary) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CanIDeploySummary) GetSuccess() int32 {
+	if m != nil {
+		return m.Success
+	}
+	return 0
+}
+
+func (m *CanIDeploySummary) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *CanIDeploySummary) GetUnknown() int32 {
+	if m != nil {
+		return m.Unknown
+	}
+	return 0
+}
+
+type CanIDeployResponse struct {
+	Response *services.Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Summary  *CanIDeploySummary `protobuf:"bytes,2,opt,name=summary" json:"summary,omitempty"`
+	Matrix   []*MatrixRow       `protobuf:"bytes,3,rep,name=matrix" json:"matrix,omitempty"`
+}
+
+func (m *CanIDeployResponse) Reset()         { *m = CanIDeployResponse{} }
+func (m *CanIDeployResponse) String() string { return proto.CompactTextString(m) }
+func (*CanIDeployResponse) ProtoMessage()    {}
+
+func (m *CanIDeployResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *CanIDeployResponse) GetSummary() *CanIDeploySummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *CanIDeployResponse) GetMatrix() []*MatrixRow {
+	if m != nil {
+		return m.Matrix
+	}
+	return nil
+}
+
+type WebhookRequest struct {
+	Method  string            `protobuf:"bytes,1,opt,name=method" json:"method,omitempty"`
+	Url     string            `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body    string            `protobuf:"bytes,4,opt,name=body" json:"body,omitempty"`
+}
+
+func (m *WebhookRequest) Reset()         { *m = WebhookRequest{} }
+func (m *WebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhookRequest) ProtoMessage()    {}
+
+func (m *WebhookRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *WebhookRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookRequest) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *WebhookRequest) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+type Webhook struct {
+	Id                    int32           `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Description           string          `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	ConsumerParticipantId int32           `protobuf:"varint,3,opt,name=consumerParticipantId" json:"consumerParticipantId,omitempty"`
+	ProviderParticipantId int32           `protobuf:"varint,4,opt,name=providerParticipantId" json:"providerParticipantId,omitempty"`
+	Events                []string        `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`
+	Request               *WebhookRequest `protobuf:"bytes,6,opt,name=request" json:"request,omitempty"`
+	CreatedAt             string          `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	UpdatedAt             string          `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+
+func (m *Webhook) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Webhook) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Webhook) GetConsumerParticipantId() int32 {
+	if m != nil {
+		return m.ConsumerParticipantId
+	}
+	return 0
+}
+
+func (m *Webhook) GetProviderParticipantId() int32 {
+	if m != nil {
+		return m.ProviderParticipantId
+	}
+	return 0
+}
+
+func (m *Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Webhook) GetRequest() *WebhookRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *Webhook) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Webhook) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type WebhookExecution struct {
+	Id           string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	WebhookId    int32  `protobuf:"varint,2,opt,name=webhookId" json:"webhookId,omitempty"`
+	Event        string `protobuf:"bytes,3,opt,name=event" json:"event,omitempty"`
+	Url          string `protobuf:"bytes,4,opt,name=url" json:"url,omitempty"`
+	Success      bool   `protobuf:"varint,5,opt,name=success" json:"success,omitempty"`
+	Attempts     int32  `protobuf:"varint,6,opt,name=attempts" json:"attempts,omitempty"`
+	StatusCode   int32  `protobuf:"varint,7,opt,name=statusCode" json:"statusCode,omitempty"`
+	ResponseBody string `protobuf:"bytes,8,opt,name=responseBody" json:"responseBody,omitempty"`
+	Error        string `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	ExecutedAt   string `protobuf:"bytes,10,opt,name=executedAt" json:"executedAt,omitempty"`
+}
+
+func (m *WebhookExecution) Reset()         { *m = WebhookExecution{} }
+func (m *WebhookExecution) String() string { return proto.CompactTextString(m) }
+func (*WebhookExecution) ProtoMessage()    {}
+
+func (m *WebhookExecution) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebhookExecution) GetWebhookId() int32 {
+	if m != nil {
+		return m.WebhookId
+	}
+	return 0
+}
+
+func (m *WebhookExecution) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *WebhookExecution) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookExecution) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *WebhookExecution) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookExecution) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *WebhookExecution) GetResponseBody() string {
+	if m != nil {
+		return m.ResponseBody
+	}
+	return ""
+}
+
+func (m *WebhookExecution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *WebhookExecution) GetExecutedAt() string {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return ""
+}
+
+type PutWebhookRequest struct {
+	Id          int32           `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ConsumerId  string          `protobuf:"bytes,2,opt,name=consumerId" json:"consumerId,omitempty"`
+	ProviderId  string          `protobuf:"bytes,3,opt,name=providerId" json:"providerId,omitempty"`
+	Description string          `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Events      []string        `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`
+	Request     *WebhookRequest `protobuf:"bytes,6,opt,name=request" json:"request,omitempty"`
+}
+
+func (m *PutWebhookRequest) Reset()         { *m = PutWebhookRequest{} }
+func (m *PutWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*PutWebhookRequest) ProtoMessage()    {}
+
+func (m *PutWebhookRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PutWebhookRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *PutWebhookRequest) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *PutWebhookRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PutWebhookRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *PutWebhookRequest) GetRequest() *WebhookRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type GetWebhookRequest struct {
+	Id int32 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetWebhookRequest) Reset()         { *m = GetWebhookRequest{} }
+func (m *GetWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWebhookRequest) ProtoMessage()    {}
+
+func (m *GetWebhookRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type WebhookResponse struct {
+	Response *services.Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Webhook  *Webhook           `protobuf:"bytes,2,opt,name=webhook" json:"webhook,omitempty"`
+}
+
+func (m *WebhookResponse) Reset()         { *m = WebhookResponse{} }
+func (m *WebhookResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhookResponse) ProtoMessage()    {}
+
+func (m *WebhookResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *WebhookResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type GetWebhooksResponse struct {
+	Response *services.Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Webhooks []*Webhook         `protobuf:"bytes,2,rep,name=webhooks" json:"webhooks,omitempty"`
+}
+
+func (m *GetWebhooksResponse) Reset()         { *m = GetWebhooksResponse{} }
+func (m *GetWebhooksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWebhooksResponse) ProtoMessage()    {}
+
+func (m *GetWebhooksResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GetWebhooksResponse) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type GetWebhookExecutionsResponse struct {
+	Response   *services.Response  `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Executions []*WebhookExecution `protobuf:"bytes,2,rep,name=executions" json:"executions,omitempty"`
+}
+
+func (m *GetWebhookExecutionsResponse) Reset()         { *m = GetWebhookExecutionsResponse{} }
+func (m *GetWebhookExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWebhookExecutionsResponse) ProtoMessage()    {}
+
+func (m *GetWebhookExecutionsResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GetWebhookExecutionsResponse) GetExecutions() []*WebhookExecution {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+type MatrixRequest struct {
+	ProviderId string             `protobuf:"bytes,1,opt,name=providerId" json:"providerId,omitempty"`
+	ConsumerId string             `protobuf:"bytes,2,opt,name=consumerId" json:"consumerId,omitempty"`
+	BaseUrl    *BaseBrokerRequest `protobuf:"bytes,3,opt,name=baseUrl" json:"baseUrl,omitempty"`
+}
+
+func (m *MatrixRequest) Reset()         { *m = MatrixRequest{} }
+func (m *MatrixRequest) String() string { return proto.CompactTextString(m) }
+func (*MatrixRequest) ProtoMessage()    {}
+
+func (m *MatrixRequest) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *MatrixRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *MatrixRequest) GetBaseUrl() *BaseBrokerRequest {
+	if m != nil {
+		return m.BaseUrl
+	}
+	return nil
+}
+
+type MatrixResponse struct {
+	Response *services.Response             `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Matrix   []*MatrixRow                   `protobuf:"bytes,2,rep,name=matrix" json:"matrix,omitempty"`
+	XLinks   map[string]*BrokerAPIInfoEntry `protobuf:"bytes,3,rep,name=_links,json=Links" json:"_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *MatrixResponse) Reset()         { *m = MatrixResponse{} }
+func (m *MatrixResponse) String() string { return proto.CompactTextString(m) }
+func (*MatrixResponse) ProtoMessage()    {}
+
+func (m *MatrixResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *MatrixResponse) GetMatrix() []*MatrixRow {
+	if m != nil {
+		return m.Matrix
+	}
+	return nil
+}
+
+func (m *MatrixResponse) GetXLinks() map[string]*BrokerAPIInfoEntry {
+	if m != nil {
+		return m.XLinks
+	}
+	return nil
+}
+
+type GetParticipantsResponse struct {
+	Response     *services.Response             `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Participants []*Participant                 `protobuf:"bytes,2,rep,name=participants" json:"participants,omitempty"`
+	XLinks       map[string]*BrokerAPIInfoEntry `protobuf:"bytes,3,rep,name=_links,json=Links" json:"_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *GetParticipantsResponse) Reset()         { *m = GetParticipantsResponse{} }
+func (m *GetParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetParticipantsResponse) ProtoMessage()    {}
+
+func (m *GetParticipantsResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GetParticipantsResponse) GetParticipants() []*Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *GetParticipantsResponse) GetXLinks() map[string]*BrokerAPIInfoEntry {
+	if m != nil {
+		return m.XLinks
+	}
+	return nil
+}
+
+type LatestPact struct {
+	Consumer        *Participant `protobuf:"bytes,1,opt,name=consumer" json:"consumer,omitempty"`
+	ConsumerVersion string       `protobuf:"bytes,2,opt,name=consumerVersion" json:"consumerVersion,omitempty"`
+	Provider        *Participant `protobuf:"bytes,3,opt,name=provider" json:"provider,omitempty"`
+	PactId          int32        `protobuf:"varint,4,opt,name=pactId" json:"pactId,omitempty"`
+}
+
+func (m *LatestPact) Reset()         { *m = LatestPact{} }
+func (m *LatestPact) String() string { return proto.CompactTextString(m) }
+func (*LatestPact) ProtoMessage()    {}
+
+func (m *LatestPact) GetConsumer() *Participant {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
+func (m *LatestPact) GetConsumerVersion() string {
+	if m != nil {
+		return m.ConsumerVersion
+	}
+	return ""
+}
+
+func (m *LatestPact) GetProvider() *Participant {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *LatestPact) GetPactId() int32 {
+	if m != nil {
+		return m.PactId
+	}
+	return 0
+}
+
+type GetLatestPactsResponse struct {
+	Response *services.Response             `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Pacts    []*LatestPact                  `protobuf:"bytes,2,rep,name=pacts" json:"pacts,omitempty"`
+	XLinks   map[string]*BrokerAPIInfoEntry `protobuf:"bytes,3,rep,name=_links,json=Links" json:"_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *GetLatestPactsResponse) Reset()         { *m = GetLatestPactsResponse{} }
+func (m *GetLatestPactsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLatestPactsResponse) ProtoMessage()    {}
+
+func (m *GetLatestPactsResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GetLatestPactsResponse) GetPacts() []*LatestPact {
+	if m != nil {
+		return m.Pacts
+	}
+	return nil
+}
+
+func (m *GetLatestPactsResponse) GetXLinks() map[string]*BrokerAPIInfoEntry {
+	if m != nil {
+		return m.XLinks
+	}
+	return nil
+}
+
+type StaticVerificationIssue struct {
+	Interaction string   `protobuf:"bytes,1,opt,name=interaction" json:"interaction,omitempty"`
+	Method      string   `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	Path        string   `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+	Errors      []string `protobuf:"bytes,4,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *StaticVerificationIssue) Reset()         { *m = StaticVerificationIssue{} }
+func (m *StaticVerificationIssue) String() string { return proto.CompactTextString(m) }
+func (*StaticVerificationIssue) ProtoMessage()    {}
+
+func (m *StaticVerificationIssue) GetInteraction() string {
+	if m != nil {
+		return m.Interaction
+	}
+	return ""
+}
+
+func (m *StaticVerificationIssue) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *StaticVerificationIssue) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *StaticVerificationIssue) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type StaticVerification struct {
+	PactId           int32                      `protobuf:"varint,1,opt,name=pactId" json:"pactId,omitempty"`
+	ProviderVersion  string                     `protobuf:"bytes,2,opt,name=providerVersion" json:"providerVersion,omitempty"`
+	Status           string                     `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	Reason           string                     `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	Issues           []*StaticVerificationIssue `protobuf:"bytes,5,rep,name=issues" json:"issues,omitempty"`
+	VerificationDate string                     `protobuf:"bytes,6,opt,name=verificationDate" json:"verificationDate,omitempty"`
+}
+
+func (m *StaticVerification) Reset()         { *m = StaticVerification{} }
+func (m *StaticVerification) String() string { return proto.CompactTextString(m) }
+func (*StaticVerification) ProtoMessage()    {}
+
+func (m *StaticVerification) GetPactId() int32 {
+	if m != nil {
+		return m.PactId
+	}
+	return 0
+}
+
+func (m *StaticVerification) GetProviderVersion() string {
+	if m != nil {
+		return m.ProviderVersion
+	}
+	return ""
+}
+
+func (m *StaticVerification) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StaticVerification) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *StaticVerification) GetIssues() []*StaticVerificationIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+func (m *StaticVerification) GetVerificationDate() string {
+	if m != nil {
+		return m.VerificationDate
+	}
+	return ""
+}
+
+type StaticVerificationResponse struct {
+	Response     *services.Response  `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Verification *StaticVerification `protobuf:"bytes,2,opt,name=verification" json:"verification,omitempty"`
+}
+
+func (m *StaticVerificationResponse) Reset()         { *m = StaticVerificationResponse{} }
+func (m *StaticVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*StaticVerificationResponse) ProtoMessage()    {}
+
+func (m *StaticVerificationResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *StaticVerificationResponse) GetVerification() *StaticVerification {
+	if m != nil {
+		return m.Verification
+	}
+	return nil
+}
+
+type RetentionPolicy struct {
+	KeepVersions       int32  `protobuf:"varint,1,opt,name=keepVersions" json:"keepVersions,omitempty"`
+	KeepTagged         bool   `protobuf:"varint,2,opt,name=keepTagged" json:"keepTagged,omitempty"`
+	VerificationMaxAge string `protobuf:"bytes,3,opt,name=verificationMaxAge" json:"verificationMaxAge,omitempty"`
+	DryRun             bool   `protobuf:"varint,4,opt,name=dryRun" json:"dryRun,omitempty"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+
+func (m *RetentionPolicy) GetKeepVersions() int32 {
+	if m != nil {
+		return m.KeepVersions
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetKeepTagged() bool {
+	if m != nil {
+		return m.KeepTagged
+	}
+	return false
+}
+
+func (m *RetentionPolicy) GetVerificationMaxAge() string {
+	if m != nil {
+		return m.VerificationMaxAge
+	}
+	return ""
+}
+
+func (m *RetentionPolicy) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type RetentionVersion struct {
+	Participant *Participant `protobuf:"bytes,1,opt,name=participant" json:"participant,omitempty"`
+	Number      string       `protobuf:"bytes,2,opt,name=number" json:"number,omitempty"`
+}
+
+func (m *RetentionVersion) Reset()         { *m = RetentionVersion{} }
+func (m *RetentionVersion) String() string { return proto.CompactTextString(m) }
+func (*RetentionVersion) ProtoMessage()    {}
+
+func (m *RetentionVersion) GetParticipant() *Participant {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+func (m *RetentionVersion) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+type RetentionReport struct {
+	Policy        *RetentionPolicy    `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+	StartedAt     string              `protobuf:"bytes,2,opt,name=startedAt" json:"startedAt,omitempty"`
+	FinishedAt    string              `protobuf:"bytes,3,opt,name=finishedAt" json:"finishedAt,omitempty"`
+	Versions      []*RetentionVersion `protobuf:"bytes,4,rep,name=versions" json:"versions,omitempty"`
+	Tags          int32               `protobuf:"varint,5,opt,name=tags" json:"tags,omitempty"`
+	PactVersions  int32               `protobuf:"varint,6,opt,name=pactVersions" json:"pactVersions,omitempty"`
+	Pacts         int32               `protobuf:"varint,7,opt,name=pacts" json:"pacts,omitempty"`
+	Verifications int32               `protobuf:"varint,8,opt,name=verifications" json:"verifications,omitempty"`
+	Error         string              `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *RetentionReport) Reset()         { *m = RetentionReport{} }
+func (m *RetentionReport) String() string { return proto.CompactTextString(m) }
+func (*RetentionReport) ProtoMessage()    {}
+
+func (m *RetentionReport) GetPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *RetentionReport) GetStartedAt() string {
+	if m != nil {
+		return m.StartedAt
+	}
+	return ""
+}
+
+func (m *RetentionReport) GetFinishedAt() string {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return ""
+}
+
+func (m *RetentionReport) GetVersions() []*RetentionVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *RetentionReport) GetTags() int32 {
+	if m != nil {
+		return m.Tags
+	}
+	return 0
+}
+
+func (m *RetentionReport) GetPactVersions() int32 {
+	if m != nil {
+		return m.PactVersions
+	}
+	return 0
+}
+
+func (m *RetentionReport) GetPacts() int32 {
+	if m != nil {
+		return m.Pacts
+	}
+	return 0
+}
+
+func (m *RetentionReport) GetVerifications() int32 {
+	if m != nil {
+		return m.Verifications
+	}
+	return 0
+}
+
+func (m *RetentionReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RunRetentionRequest struct {
+	Policy *RetentionPolicy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (m *RunRetentionRequest) Reset()         { *m = RunRetentionRequest{} }
+func (m *RunRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*RunRetentionRequest) ProtoMessage()    {}
+
+func (m *RunRetentionRequest) GetPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type RetentionResponse struct {
+	Response *services.Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Report   *RetentionReport   `protobuf:"bytes,2,opt,name=report" json:"report,omitempty"`
+}
+
+func (m *RetentionResponse) Reset()         { *m = RetentionResponse{} }
+func (m *RetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*RetentionResponse) ProtoMessage()    {}
+
+func (m *RetentionResponse) GetResponse() *services.Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *RetentionResponse) GetReport() *RetentionReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Participant)(nil), "Participant")
 	proto.RegisterType((*Version)(nil), "Version")
@@ -891,6 +1981,33 @@ func init() {
 	proto.RegisterType((*BaseBrokerRequest)(nil), "BaseBrokerRequest")
 	proto.RegisterType((*BrokerAPIInfoEntry)(nil), "BrokerAPIInfoEntry")
 	proto.RegisterType((*BrokerHomeResponse)(nil), "BrokerHomeResponse")
+	proto.RegisterType((*VersionTagRequest)(nil), "VersionTagRequest")
+	proto.RegisterType((*VersionTagResponse)(nil), "VersionTagResponse")
+	proto.RegisterType((*CanIDeployRequest)(nil), "CanIDeployRequest")
+	proto.RegisterType((*MatrixRow)(nil), "MatrixRow")
+	proto.RegisterType((*CanIDeploySummary)(nil), "CanIDeploySummary")
+	proto.RegisterType((*CanIDeployResponse)(nil), "CanIDeployResponse")
+	proto.RegisterType((*WebhookRequest)(nil), "WebhookRequest")
+	proto.RegisterType((*Webhook)(nil), "Webhook")
+	proto.RegisterType((*WebhookExecution)(nil), "WebhookExecution")
+	proto.RegisterType((*PutWebhookRequest)(nil), "PutWebhookRequest")
+	proto.RegisterType((*GetWebhookRequest)(nil), "GetWebhookRequest")
+	proto.RegisterType((*WebhookResponse)(nil), "WebhookResponse")
+	proto.RegisterType((*GetWebhooksResponse)(nil), "GetWebhooksResponse")
+	proto.RegisterType((*GetWebhookExecutionsResponse)(nil), "GetWebhookExecutionsResponse")
+	proto.RegisterType((*MatrixRequest)(nil), "MatrixRequest")
+	proto.RegisterType((*MatrixResponse)(nil), "MatrixResponse")
+	proto.RegisterType((*GetParticipantsResponse)(nil), "GetParticipantsResponse")
+	proto.RegisterType((*LatestPact)(nil), "LatestPact")
+	proto.RegisterType((*GetLatestPactsResponse)(nil), "GetLatestPactsResponse")
+	proto.RegisterType((*StaticVerificationIssue)(nil), "StaticVerificationIssue")
+	proto.RegisterType((*StaticVerification)(nil), "StaticVerification")
+	proto.RegisterType((*StaticVerificationResponse)(nil), "StaticVerificationResponse")
+	proto.RegisterType((*RetentionPolicy)(nil), "RetentionPolicy")
+	proto.RegisterType((*RetentionVersion)(nil), "RetentionVersion")
+	proto.RegisterType((*RetentionReport)(nil), "RetentionReport")
+	proto.RegisterType((*RunRetentionRequest)(nil), "RunRetentionRequest")
+	proto.RegisterType((*RetentionResponse)(nil), "RetentionResponse")
 }
 
 func init() { proto.RegisterFile("server/broker/broker.proto", fileDescriptor0) }
@@ -23,14 +23,18 @@ import (
 )
 
 const (
-	BROKER_ROOT_KEY              = "cse-pact"
-	BROKER_PARTICIPANT_KEY       = "participant"
-	BROKER_VERSION_KEY           = "version"
-	BROKER_PACT_KEY              = "pact"
-	BROKER_PACT_VERSION_KEY      = "pact-version"
-	BROKER_PACT_TAG_KEY          = "pact-tag"
-	BROKER_PACT_VERIFICATION_KEY = "verification"
-	BROKER_PACT_LATEST           = "latest"
+	BROKER_ROOT_KEY                = "cse-pact"
+	BROKER_PARTICIPANT_KEY         = "participant"
+	BROKER_VERSION_KEY             = "version"
+	BROKER_PACT_KEY                = "pact"
+	BROKER_PACT_VERSION_KEY        = "pact-version"
+	BROKER_PACT_TAG_KEY            = "pact-tag"
+	BROKER_PACT_VERIFICATION_KEY   = "verification"
+	BROKER_PACT_LATEST             = "latest"
+	BROKER_WEBHOOK_KEY             = "webhook"
+	BROKER_WEBHOOK_EXECUTION_KEY   = "webhook-execution"
+	BROKER_STATIC_VERIFICATION_KEY = "static-verification"
+	BROKER_RETENTION_KEY           = "retention"
 )
 
 // GetBrokerRootKey returns url (/cse-pact)
@@ -141,6 +145,14 @@ func GenerateBrokerTagKey(tenant string, versionId int32) string {
 	}, "/")
 }
 
+//GenerateBrokerVersionTagKey returns the key of the tag on the version
+func GenerateBrokerVersionTagKey(tenant string, versionId int32, tag string) string {
+	return util.StringJoin([]string{
+		GenerateBrokerTagKey(tenant, versionId),
+		tag,
+	}, "/")
+}
+
 //GetBrokerVerificationKey returns the verification root key
 func GetBrokerVerificationKey(tenant string) string {
 	return util.StringJoin([]string{
@@ -159,6 +171,60 @@ func GenerateBrokerVerificationKey(tenant string, pactVersionId int32, number in
 	}, "/")
 }
 
+//GetBrokerWebhookKey returns the webhook root key
+func GetBrokerWebhookKey(tenant string) string {
+	return util.StringJoin([]string{
+		GetBrokerRootKey(),
+		BROKER_WEBHOOK_KEY,
+		tenant,
+	}, "/")
+}
+
+//GenerateBrokerWebhookKey returns the webhook key
+func GenerateBrokerWebhookKey(tenant string, webhookId int32) string {
+	return util.StringJoin([]string{
+		GetBrokerWebhookKey(tenant),
+		strconv.Itoa(int(webhookId)),
+	}, "/")
+}
+
+//GetBrokerWebhookExecutionKey returns the webhook execution root key
+func GetBrokerWebhookExecutionKey(tenant string) string {
+	return util.StringJoin([]string{
+		GetBrokerRootKey(),
+		BROKER_WEBHOOK_EXECUTION_KEY,
+		tenant,
+	}, "/")
+}
+
+//GenerateBrokerWebhookExecutionKey returns the webhook execution key
+func GenerateBrokerWebhookExecutionKey(tenant string, webhookId int32, executionId string) string {
+	return util.StringJoin([]string{
+		GetBrokerWebhookExecutionKey(tenant),
+		strconv.Itoa(int(webhookId)),
+		executionId,
+	}, "/")
+}
+
+//GetBrokerStaticVerificationKey returns the static verification root key
+func GetBrokerStaticVerificationKey(tenant string) string {
+	return util.StringJoin([]string{
+		GetBrokerRootKey(),
+		BROKER_STATIC_VERIFICATION_KEY,
+		tenant,
+	}, "/")
+}
+
+//GenerateBrokerStaticVerificationKey returns the static verification key of the pact
+// against the provider version
+func GenerateBrokerStaticVerificationKey(tenant string, pactId int32, providerVersion string) string {
+	return util.StringJoin([]string{
+		GetBrokerStaticVerificationKey(tenant),
+		strconv.Itoa(int(pactId)),
+		providerVersion,
+	}, "/")
+}
+
 //GetBrokerLatestParticipantIDKey returns the latest participant ID
 func GetBrokerLatestParticipantIDKey() string {
 	return util.StringJoin([]string{
@@ -198,3 +264,19 @@ func GetBrokerLatestVerificationIDKey() string {
 		BROKER_PACT_VERIFICATION_KEY,
 	}, "/")
 }
+
+//GetBrokerLatestWebhookIDKey returns the latest webhook ID
+func GetBrokerLatestWebhookIDKey() string {
+	return util.StringJoin([]string{
+		GetBrokerLatestKey("default"),
+		BROKER_WEBHOOK_KEY,
+	}, "/")
+}
+
+//GetBrokerLatestRetentionReportKey returns the key of the latest retention report
+func GetBrokerLatestRetentionReportKey() string {
+	return util.StringJoin([]string{
+		GetBrokerLatestKey("default"),
+		BROKER_RETENTION_KEY,
+	}, "/")
+}
@@ -17,6 +17,7 @@
 package broker
 
 import (
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	_ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/quota/buildin"
 	_ "github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/registry/etcd"
@@ -29,13 +30,15 @@ import (
 	"testing"
 )
 
-var serviceResource pb.ServiceCtrlServer
+var serviceResource pb.ServiceCtrlServerEx
 var instanceResource pb.SerivceInstanceCtrlServerEx
 var brokerResource = BrokerServiceAPI
 
 var _ = BeforeSuite(func() {
 	//init plugin
 	serviceResource, instanceResource = service.AssembleResources()
+	// the static verification gets the provider schemas by the service api
+	core.ServiceAPI, core.InstanceAPI = serviceResource, instanceResource
 })
 
 func TestBroker(t *testing.T) {
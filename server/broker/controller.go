@@ -44,6 +44,9 @@ func (brokerService *BrokerController) URLPatterns() []rest.Route {
 		{rest.HTTP_METHOD_GET,
 			"/pacts/provider/:providerId/latest",
 			brokerService.GetAllProviderPacts},
+		{rest.HTTP_METHOD_GET,
+			"/pacts/provider/:providerId/latest/:tag",
+			brokerService.GetAllProviderPacts},
 		{rest.HTTP_METHOD_GET,
 			"/pacts/provider/:providerId/consumer/:consumerId/version/:number",
 			brokerService.GetPactsOfProvider},
@@ -56,6 +59,63 @@ func (brokerService *BrokerController) URLPatterns() []rest.Route {
 		{rest.HTTP_METHOD_GET,
 			"/verification-results/consumer/:consumerId/version/:consumerVersion/latest",
 			brokerService.RetrieveVerificationResults},
+		{rest.HTTP_METHOD_PUT,
+			"/participants/:serviceId/versions/:version/tags/:tag",
+			brokerService.PublishVersionTag},
+		{rest.HTTP_METHOD_DELETE,
+			"/participants/:serviceId/versions/:version/tags/:tag",
+			brokerService.DeleteVersionTag},
+		{rest.HTTP_METHOD_GET,
+			"/participants/:serviceId/versions/:version/tags",
+			brokerService.GetVersionTags},
+		{rest.HTTP_METHOD_GET,
+			"/can-i-deploy",
+			brokerService.CanIDeploy},
+		{rest.HTTP_METHOD_POST,
+			"/webhooks",
+			brokerService.CreateWebhook},
+		{rest.HTTP_METHOD_GET,
+			"/webhooks",
+			brokerService.GetWebhooks},
+		{rest.HTTP_METHOD_GET,
+			"/webhooks/:id",
+			brokerService.GetWebhook},
+		{rest.HTTP_METHOD_PUT,
+			"/webhooks/:id",
+			brokerService.UpdateWebhook},
+		{rest.HTTP_METHOD_DELETE,
+			"/webhooks/:id",
+			brokerService.DeleteWebhook},
+		{rest.HTTP_METHOD_GET,
+			"/webhooks/:id/executions",
+			brokerService.GetWebhookExecutions},
+		{rest.HTTP_METHOD_GET,
+			"/matrix/provider/:providerId/consumer/:consumerId",
+			brokerService.GetMatrix},
+		{rest.HTTP_METHOD_GET,
+			"/pacts/provider/:providerId/consumer/:consumerId/version/:number/diff/version/:otherNumber",
+			brokerService.DiffPacts},
+		{rest.HTTP_METHOD_GET,
+			"/participants",
+			brokerService.GetParticipants},
+		{rest.HTTP_METHOD_GET,
+			"/pacts/latest",
+			brokerService.GetLatestPacts},
+		{rest.HTTP_METHOD_GET,
+			"/doc/:rel",
+			brokerService.GetDoc},
+		{rest.HTTP_METHOD_GET,
+			"/pacts/provider/:providerId/consumer/:consumerId/version/:number/static-verification",
+			brokerService.RetrieveStaticVerification},
+		{rest.HTTP_METHOD_POST,
+			"/pacts/provider/:providerId/consumer/:consumerId/version/:number/static-verification",
+			brokerService.RerunStaticVerification},
+		{rest.HTTP_METHOD_POST,
+			"/retention",
+			brokerService.RunRetention},
+		{rest.HTTP_METHOD_GET,
+			"/retention/report",
+			brokerService.GetRetentionReport},
 	}
 }
 
@@ -83,6 +143,10 @@ func (*BrokerController) PublishPact(w http.ResponseWriter, r *http.Request) {
 		ConsumerId: r.URL.Query().Get(":consumerId"),
 		Version:    r.URL.Query().Get(":number"),
 		Pact:       message,
+		BaseUrl: &BaseBrokerRequest{
+			HostAddress: r.Host,
+			Scheme:      getScheme(r),
+		},
 	}
 	PactLogger.Infof("PublishPact: providerId = %s, consumerId = %s, version = %s\n",
 		request.ProviderId, request.ConsumerId, request.Version)
@@ -100,6 +164,7 @@ func (*BrokerController) GetAllProviderPacts(w http.ResponseWriter, r *http.Requ
 			HostAddress: r.Host,
 			Scheme:      getScheme(r),
 		},
+		Tag: r.URL.Query().Get(":tag"),
 	}
 	resp, err := BrokerServiceAPI.GetAllProviderPacts(r.Context(), request /*, href*/)
 	linksObj, err := json.Marshal(resp)
@@ -161,6 +226,10 @@ func (*BrokerController) PublishVerificationResults(w http.ResponseWriter, r *ht
 		return
 	}
 	request.PactId = int32(i)
+	request.BaseUrl = &BaseBrokerRequest{
+		HostAddress: r.Host,
+		Scheme:      getScheme(r),
+	}
 	PactLogger.Infof("PublishVerificationResults: %s, %s, %d, %t, %s\n",
 		request.ProviderId, request.ConsumerId, request.PactId, request.Success,
 		request.ProviderApplicationVersion)
@@ -183,6 +252,294 @@ func (*BrokerController) RetrieveVerificationResults(w http.ResponseWriter, r *h
 	controller.WriteResponse(w, respInternal, resp)
 }
 
+func (*BrokerController) PublishVersionTag(w http.ResponseWriter, r *http.Request) {
+	request := &VersionTagRequest{
+		ServiceId: r.URL.Query().Get(":serviceId"),
+		Version:   r.URL.Query().Get(":version"),
+		Tag:       r.URL.Query().Get(":tag"),
+	}
+	PactLogger.Infof("PublishVersionTag: serviceId = %s, version = %s, tag = %s\n",
+		request.ServiceId, request.Version, request.Tag)
+	resp, _ := BrokerServiceAPI.PublishVersionTag(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) DeleteVersionTag(w http.ResponseWriter, r *http.Request) {
+	request := &VersionTagRequest{
+		ServiceId: r.URL.Query().Get(":serviceId"),
+		Version:   r.URL.Query().Get(":version"),
+		Tag:       r.URL.Query().Get(":tag"),
+	}
+	PactLogger.Infof("DeleteVersionTag: serviceId = %s, version = %s, tag = %s\n",
+		request.ServiceId, request.Version, request.Tag)
+	resp, _ := BrokerServiceAPI.DeleteVersionTag(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetVersionTags(w http.ResponseWriter, r *http.Request) {
+	request := &VersionTagRequest{
+		ServiceId: r.URL.Query().Get(":serviceId"),
+		Version:   r.URL.Query().Get(":version"),
+	}
+	resp, _ := BrokerServiceAPI.GetVersionTags(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) CanIDeploy(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	request := &CanIDeployRequest{
+		ServiceId: query.Get("serviceId"),
+		Version:   query.Get("version"),
+		To:        query.Get("to"),
+	}
+	PactLogger.Infof("CanIDeploy: serviceId = %s, version = %s, to = %s\n",
+		request.ServiceId, request.Version, request.To)
+	resp, _ := BrokerServiceAPI.CanIDeploy(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	request, err := readWebhookRequest(r)
+	if err != nil {
+		PactLogger.Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := BrokerServiceAPI.CreateWebhook(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := getWebhookId(r)
+	if err != nil {
+		PactLogger.Error("Invalid webhook id", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request, err := readWebhookRequest(r)
+	if err != nil {
+		PactLogger.Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request.Id = id
+	resp, _ := BrokerServiceAPI.UpdateWebhook(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	resp, _ := BrokerServiceAPI.GetWebhooks(r.Context(), &BaseBrokerRequest{
+		HostAddress: r.Host,
+		Scheme:      getScheme(r),
+	})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := getWebhookId(r)
+	if err != nil {
+		PactLogger.Error("Invalid webhook id", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := BrokerServiceAPI.GetWebhook(r.Context(), &GetWebhookRequest{Id: id})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := getWebhookId(r)
+	if err != nil {
+		PactLogger.Error("Invalid webhook id", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := BrokerServiceAPI.DeleteWebhook(r.Context(), &GetWebhookRequest{Id: id})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetWebhookExecutions(w http.ResponseWriter, r *http.Request) {
+	id, err := getWebhookId(r)
+	if err != nil {
+		PactLogger.Error("Invalid webhook id", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := BrokerServiceAPI.GetWebhookExecutions(r.Context(), &GetWebhookRequest{Id: id})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetMatrix(w http.ResponseWriter, r *http.Request) {
+	request := &MatrixRequest{
+		ProviderId: r.URL.Query().Get(":providerId"),
+		ConsumerId: r.URL.Query().Get(":consumerId"),
+		BaseUrl: &BaseBrokerRequest{
+			HostAddress: r.Host,
+			Scheme:      getScheme(r),
+		},
+	}
+	resp, _ := BrokerServiceAPI.GetMatrix(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) DiffPacts(w http.ResponseWriter, r *http.Request) {
+	request := &DiffPactsRequest{
+		ProviderId:   r.URL.Query().Get(":providerId"),
+		ConsumerId:   r.URL.Query().Get(":consumerId"),
+		Version:      r.URL.Query().Get(":number"),
+		OtherVersion: r.URL.Query().Get(":otherNumber"),
+		BaseUrl: &BaseBrokerRequest{
+			HostAddress: r.Host,
+			Scheme:      getScheme(r),
+		},
+	}
+	resp, _ := BrokerServiceAPI.DiffPacts(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetParticipants(w http.ResponseWriter, r *http.Request) {
+	resp, _ := BrokerServiceAPI.GetParticipants(r.Context(), &BaseBrokerRequest{
+		HostAddress: r.Host,
+		Scheme:      getScheme(r),
+	})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetLatestPacts(w http.ResponseWriter, r *http.Request) {
+	resp, _ := BrokerServiceAPI.GetLatestPacts(r.Context(), &BaseBrokerRequest{
+		HostAddress: r.Host,
+		Scheme:      getScheme(r),
+	})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetDoc(w http.ResponseWriter, r *http.Request) {
+	rel := r.URL.Query().Get(":rel")
+	title, ok := GetBrokerLinkTitle(rel)
+	if !ok {
+		controller.WriteError(w, scerr.ErrInvalidParams, "Unknown relation "+rel)
+		return
+	}
+	controller.WriteResponse(w, nil, &BrokerAPIInfoEntry{
+		Name:  rel,
+		Title: title,
+	})
+}
+
+func (*BrokerController) RetrieveStaticVerification(w http.ResponseWriter, r *http.Request) {
+	resp, _ := BrokerServiceAPI.RetrieveStaticVerification(r.Context(), getVersionPactRequest(r))
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) RerunStaticVerification(w http.ResponseWriter, r *http.Request) {
+	resp, _ := BrokerServiceAPI.RerunStaticVerification(r.Context(), getVersionPactRequest(r))
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func getVersionPactRequest(r *http.Request) *GetProviderConsumerVersionPactRequest {
+	return &GetProviderConsumerVersionPactRequest{
+		ProviderId: r.URL.Query().Get(":providerId"),
+		ConsumerId: r.URL.Query().Get(":consumerId"),
+		Version:    r.URL.Query().Get(":number"),
+		BaseUrl: &BaseBrokerRequest{
+			HostAddress: r.Host,
+			Scheme:      getScheme(r),
+		},
+	}
+}
+
+func (*BrokerController) RunRetention(w http.ResponseWriter, r *http.Request) {
+	requestBody, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		PactLogger.Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	// the unspecified fields are the configured ones, but the data is only deleted
+	// when dryRun=false is passed explicitly
+	request := &RunRetentionRequest{Policy: GetConfiguredRetentionPolicy()}
+	request.Policy.DryRun = true
+	if len(requestBody) > 0 {
+		err = json.Unmarshal(requestBody, request.Policy)
+		if err != nil {
+			PactLogger.Error("Unmarshal error", err)
+			controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+			return
+		}
+	}
+	if dryRun := r.URL.Query().Get("dryRun"); len(dryRun) > 0 {
+		request.Policy.DryRun, err = strconv.ParseBool(dryRun)
+		if err != nil {
+			PactLogger.Error("Invalid dryRun", err)
+			controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+			return
+		}
+	}
+	resp, _ := BrokerServiceAPI.RunRetention(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (*BrokerController) GetRetentionReport(w http.ResponseWriter, r *http.Request) {
+	resp, _ := BrokerServiceAPI.GetRetentionReport(r.Context(), &BaseBrokerRequest{})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func readWebhookRequest(r *http.Request) (*PutWebhookRequest, error) {
+	requestBody, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	request := &PutWebhookRequest{}
+	err = json.Unmarshal(requestBody, request)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func getWebhookId(r *http.Request) (int32, error) {
+	i, err := strconv.ParseInt(r.URL.Query().Get(":id"), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(i), nil
+}
+
 func getScheme(r *http.Request) string {
 	if len(r.URL.Scheme) < 1 {
 		return DEFAULT_SCHEME
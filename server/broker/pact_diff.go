@@ -0,0 +1,44 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package broker
+
+import (
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+)
+
+// DiffPactsRequest compares the pacts published by two versions of the consumer,
+// the diffs are defined as json nodes so the types are not generated from the proto file
+type DiffPactsRequest struct {
+	ProviderId   string             `json:"providerId,omitempty"`
+	ConsumerId   string             `json:"consumerId,omitempty"`
+	Version      string             `json:"version,omitempty"`
+	OtherVersion string             `json:"otherVersion,omitempty"`
+	BaseUrl      *BaseBrokerRequest `json:"baseUrl,omitempty"`
+}
+
+type DiffPactsResponse struct {
+	Response *pb.Response                   `json:"response,omitempty"`
+	Diffs    []*pb.SchemaDiff               `json:"diffs"`
+	XLinks   map[string]*BrokerAPIInfoEntry `json:"_links,omitempty"`
+}
+
+// DiffPactContents returns the changes from the old pact to the new one,
+// located by json pointers
+func DiffPactContents(oldPact, newPact []byte) []*pb.SchemaDiff {
+	return serviceUtil.DiffSchemas(string(oldPact), string(newPact))
+}
@@ -0,0 +1,308 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package broker
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/apache/incubator-servicecomb-service-center/server/mux"
+	"github.com/astaxie/beego"
+	"golang.org/x/net/context"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// GetConfiguredRetentionPolicy returns the retention policy of the background job
+func GetConfiguredRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{
+		KeepVersions:       int32(beego.AppConfig.DefaultInt("broker_retention_keep_versions", 0)),
+		KeepTagged:         beego.AppConfig.DefaultInt("broker_retention_keep_tagged", 1) != 0,
+		VerificationMaxAge: beego.AppConfig.String("broker_retention_verification_max_age"),
+		DryRun:             beego.AppConfig.DefaultInt("broker_retention_dry_run", 1) != 0,
+	}
+}
+
+func startRetentionJob() {
+	s := beego.AppConfig.String("broker_retention_interval")
+	if len(s) == 0 {
+		return
+	}
+	interval, err := time.ParseDuration(s)
+	if err != nil || interval <= 0 {
+		PactLogger.Errorf(err, "invalid broker retention interval %s, reset to default interval 24h", s)
+		interval = 24 * time.Hour
+	}
+	util.Go(func(ctx context.Context) {
+		PactLogger.Infof("enabled the broker retention job, run once every %s", interval)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+				// only one service center instance cleans up at the same time
+				lock, err := mux.Try(mux.GLOBAL_LOCK)
+				if lock == nil {
+					PactLogger.Warnf(err, "can not run the broker retention job by this service center instance now")
+					continue
+				}
+				report, err := RunRetention(ctx, GetDefaultTenantProject(), GetConfiguredRetentionPolicy())
+				if err != nil {
+					PactLogger.Errorf(err, "broker retention job failed")
+				} else {
+					PactLogger.Infof("broker retention job finished, dry run: %t, versions: %d, pacts: %d, verifications: %d",
+						report.Policy.DryRun, len(report.Versions), report.Pacts, report.Verifications)
+				}
+				lock.Unlock()
+			}
+		}
+	})
+}
+
+// RetentionData is the broker data checked by the retention policy
+type RetentionData struct {
+	Participants  map[int32]*Participant
+	Versions      map[int32]*Version
+	Tags          []*Tag
+	Pacts         map[int32]*Pact
+	PactVersions  []*PactVersion
+	Verifications map[int32][]*Verification
+}
+
+func GetAllTags(ctx context.Context, tenant string) ([]*Tag, error) {
+	key := util.StringJoin([]string{GetBrokerTagKey(tenant), ""}, "/")
+	resp, err := Store().PactTag().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*Tag, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		tag := &Tag{}
+		err = json.Unmarshal(kv.Value, tag)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func GetAllPacts(ctx context.Context, tenant string) (map[int32]*Pact, error) {
+	key := util.StringJoin([]string{GetBrokerPactKey(tenant), ""}, "/")
+	resp, err := Store().Pact().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	pacts := make(map[int32]*Pact, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		pact := &Pact{}
+		err = json.Unmarshal(kv.Value, pact)
+		if err != nil {
+			return nil, err
+		}
+		pacts[pact.Id] = pact
+	}
+	return pacts, nil
+}
+
+func GetRetentionData(ctx context.Context, tenant string) (data *RetentionData, err error) {
+	data = &RetentionData{}
+	if data.Participants, err = GetAllParticipants(ctx, tenant); err != nil {
+		return nil, err
+	}
+	if data.Versions, err = GetAllVersions(ctx, tenant); err != nil {
+		return nil, err
+	}
+	if data.Tags, err = GetAllTags(ctx, tenant); err != nil {
+		return nil, err
+	}
+	if data.Pacts, err = GetAllPacts(ctx, tenant); err != nil {
+		return nil, err
+	}
+	if data.PactVersions, err = GetAllPactVersions(ctx, tenant); err != nil {
+		return nil, err
+	}
+	if data.Verifications, err = GetAllVerifications(ctx, tenant); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// PlanRetention returns the delete operations of the data expired by the policy.
+// The versions out of the latest ones of each participant are deleted with their
+// tags, pacts and verifications, unless they are tagged and the tagged versions
+// are kept. The verifications older than the max age are deleted too
+func PlanRetention(tenant string, policy *RetentionPolicy, now time.Time,
+	data *RetentionData) ([]registry.PluginOp, *RetentionReport, error) {
+	var maxAge time.Duration
+	if len(policy.VerificationMaxAge) > 0 {
+		d, err := time.ParseDuration(policy.VerificationMaxAge)
+		if err != nil {
+			return nil, nil, err
+		}
+		maxAge = d
+	}
+
+	var (
+		ops    []registry.PluginOp
+		report = &RetentionReport{Policy: policy}
+	)
+	del := func(key string) {
+		ops = append(ops, registry.OpDel(registry.WithStrKey(key)))
+	}
+
+	tagged := make(map[int32]bool)
+	for _, tag := range data.Tags {
+		tagged[tag.VersionId] = true
+	}
+	participantVersions := make(map[int32][]*Version)
+	for _, version := range data.Versions {
+		participantVersions[version.ParticipantId] = append(participantVersions[version.ParticipantId], version)
+	}
+	expired := make(map[int32]bool)
+	for _, versions := range participantVersions {
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].Order > versions[j].Order
+		})
+		for i, version := range versions {
+			if policy.KeepVersions <= 0 || i < int(policy.KeepVersions) ||
+				(policy.KeepTagged && tagged[version.Id]) {
+				continue
+			}
+			expired[version.Id] = true
+		}
+	}
+
+	expiredVersions := make([]*Version, 0, len(expired))
+	for id := range expired {
+		expiredVersions = append(expiredVersions, data.Versions[id])
+	}
+	sort.Slice(expiredVersions, func(i, j int) bool {
+		if expiredVersions[i].ParticipantId != expiredVersions[j].ParticipantId {
+			return expiredVersions[i].ParticipantId < expiredVersions[j].ParticipantId
+		}
+		return expiredVersions[i].Order < expiredVersions[j].Order
+	})
+	for _, version := range expiredVersions {
+		del(GenerateBrokerVersionKey(tenant, version.Number, version.ParticipantId))
+		report.Versions = append(report.Versions, &RetentionVersion{
+			Participant: data.Participants[version.ParticipantId],
+			Number:      version.Number,
+		})
+	}
+	for _, tag := range data.Tags {
+		if expired[tag.VersionId] {
+			del(GenerateBrokerVersionTagKey(tenant, tag.VersionId, tag.Name))
+			report.Tags++
+		}
+	}
+
+	referenced := make(map[int32]bool)
+	for _, pactVersion := range data.PactVersions {
+		verifications := data.Verifications[pactVersion.Id]
+		if !expired[pactVersion.VersionId] {
+			referenced[pactVersion.PactId] = true
+			if maxAge <= 0 {
+				continue
+			}
+			for _, verification := range verifications {
+				t, err := time.Parse(time.RFC3339, verification.VerificationDate)
+				if err != nil || now.Sub(t) <= maxAge {
+					continue
+				}
+				del(GenerateBrokerVerificationKey(tenant, pactVersion.Id, verification.Number))
+				report.Verifications++
+			}
+			continue
+		}
+		del(GenerateBrokerPactVersionKey(tenant, pactVersion.VersionId, pactVersion.PactId))
+		report.PactVersions++
+		for _, verification := range verifications {
+			del(GenerateBrokerVerificationKey(tenant, pactVersion.Id, verification.Number))
+			report.Verifications++
+		}
+	}
+
+	ids := make([]int, 0, len(data.Pacts))
+	for id := range data.Pacts {
+		if !referenced[id] {
+			ids = append(ids, int(id))
+		}
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		pact := data.Pacts[int32(id)]
+		del(GenerateBrokerPactKey(tenant, pact.ConsumerParticipantId, pact.ProviderParticipantId, pact.Sha))
+		ops = append(ops, registry.OpDel(registry.WithStrKey(util.StringJoin([]string{
+			GetBrokerStaticVerificationKey(tenant), strconv.Itoa(id), ""}, "/")), registry.WithPrefix()))
+		report.Pacts++
+	}
+	return ops, report, nil
+}
+
+// RunRetention deletes the data expired by the policy, nothing is deleted in the
+// dry run mode. The report is saved as the latest one
+func RunRetention(ctx context.Context, tenant string, policy *RetentionPolicy) (*RetentionReport, error) {
+	startedAt := time.Now()
+	data, err := GetRetentionData(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	ops, report, err := PlanRetention(tenant, policy, startedAt, data)
+	if err != nil {
+		return nil, err
+	}
+	report.StartedAt = startedAt.Format(time.RFC3339)
+	if !policy.DryRun && len(ops) > 0 {
+		if err := backend.BatchCommit(ctx, ops); err != nil {
+			report.Error = err.Error()
+		}
+	}
+	report.FinishedAt = time.Now().Format(time.RFC3339)
+
+	value, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+	if err := StoreData(ctx, GetBrokerLatestRetentionReportKey(), util.BytesToStringWithNoCopy(value)); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// GetLatestRetentionReport returns the report of the last retention run
+func GetLatestRetentionReport(ctx context.Context) (*RetentionReport, error) {
+	resp, err := Store().PactLatest().Search(ctx, registry.WithStrKey(GetBrokerLatestRetentionReportKey()))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	report := &RetentionReport{}
+	err = json.Unmarshal(resp.Kvs[0].Value, report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ import (
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/apache/incubator-servicecomb-service-center/server/rbac"
 	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	"golang.org/x/net/context"
 )
@@ -311,6 +313,14 @@ func (*BrokerService) GetAllProviderPacts(ctx context.Context,
 		PactLogger.Infof("[RetrieveProviderPacts] Version found : (%d, %s)", version.Id, version.Number)
 		versionObjects[version.Id] = *version
 	}
+	// Only the consumer versions with the tag if specified
+	var taggedVersionIds map[int32]bool
+	if len(in.Tag) > 0 {
+		taggedVersionIds, err = GetTaggedVersionIds(ctx, tenant, in.Tag)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// Get all pactversions and filter using the provider participant id
 	pactVersionKey := util.StringJoin([]string{GetBrokerPactVersionKey(tenant), ""}, "/")
 	pactVersions, err := Store().PactVersion().Search(ctx,
@@ -334,6 +344,9 @@ func (*BrokerService) GetAllProviderPacts(ctx context.Context,
 		if pactVersion.ProviderParticipantId != providerParticipant.Id {
 			continue
 		}
+		if taggedVersionIds != nil && !taggedVersionIds[pactVersion.VersionId] {
+			continue
+		}
 		PactLogger.Infof("[RetrieveProviderPacts] Pact version found: (%d, %d, %d, %d)", pactVersion.Id, pactVersion.VersionId, pactVersion.PactId, pactVersion.ProviderParticipantId)
 		vObj := versionObjects[pactVersion.VersionId]
 		if v1Obj, ok := participantToVersionObj[vObj.ParticipantId]; ok {
@@ -633,6 +646,7 @@ func (*BrokerService) PublishVerificationResults(ctx context.Context, in *Publis
 		}, err
 	}
 	pactExists := false
+	providerParticipantId := int32(0)
 	for i := 0; i < len(pacts.Kvs); i++ {
 		pact := &Pact{}
 		err = json.Unmarshal(pacts.Kvs[i].Value, &pact)
@@ -644,6 +658,7 @@ func (*BrokerService) PublishVerificationResults(ctx context.Context, in *Publis
 		}
 		if pact.Id == in.PactId {
 			pactExists = true
+			providerParticipantId = pact.ProviderParticipantId
 		}
 	}
 	if pactExists == false {
@@ -717,6 +732,18 @@ func (*BrokerService) PublishVerificationResults(ctx context.Context, in *Publis
 		Success:                    verification.Success,
 		VerificationDate:           verification.VerificationDate,
 	}
+	event := WEBHOOK_EVENT_VERIFICATION_FAILED
+	if verification.Success {
+		event = WEBHOOK_EVENT_VERIFICATION_SUCCEEDED
+	}
+	FireWebhooks(ctx, tenant, &WebhookEvent{
+		Name:                  event,
+		ConsumerParticipantId: consumerParticipant.Id,
+		ConsumerVersion:       version.Number,
+		ProviderParticipantId: providerParticipantId,
+		ProviderVersion:       verification.ProviderVersion,
+		PactUrl:               getPactUrl(in.BaseUrl, in.ProviderId, in.ConsumerId, version.Number),
+	})
 	PactLogger.Infof("Verification result published successfully ...")
 	return &PublishVerificationResponse{
 		Response:     pb.CreateResponse(pb.Response_SUCCESS, "Verification result published successfully."),
@@ -839,6 +866,7 @@ func (*BrokerService) PublishPact(ctx context.Context, in *PublishPactRequest) (
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, "pact cannot be searched."),
 		}, err
 	}
+	contentChanged := pact == nil
 	if pact == nil {
 		id, err := GetData(ctx, GetBrokerLatestPactIDKey())
 		pact = &Pact{Id: int32(id) + 1, ConsumerParticipantId: consumerParticipant.Id,
@@ -867,8 +895,723 @@ func (*BrokerService) PublishPact(ctx context.Context, in *PublishPactRequest) (
 		}
 	}
 	PactLogger.Infof("PactVersion found/create: (%d, %d, %d, %d)", pactVersion.Id, pactVersion.VersionId, pactVersion.PactId, pactVersion.ProviderParticipantId)
+	// the static verification only reports the result, it never rejects the pact
+	staticVerification, err := StaticVerifyPact(ctx, tenant, pact, provider)
+	if err != nil {
+		PactLogger.Errorf(err, "static verification of pact %d failed.", pact.Id)
+	}
+	if contentChanged {
+		FireWebhooks(ctx, tenant, &WebhookEvent{
+			Name:                  WEBHOOK_EVENT_CONTRACT_CONTENT_CHANGED,
+			ConsumerParticipantId: consumerParticipant.Id,
+			ConsumerVersion:       version.Number,
+			ProviderParticipantId: providerParticipant.Id,
+			PactUrl:               getPactUrl(in.BaseUrl, in.ProviderId, in.ConsumerId, version.Number),
+		})
+	}
 	PactLogger.Infof("Pact published successfully ...")
 	return &PublishPactResponse{
-		Response: pb.CreateResponse(pb.Response_SUCCESS, "Pact published successfully."),
+		Response:           pb.CreateResponse(pb.Response_SUCCESS, "Pact published successfully."),
+		StaticVerification: staticVerification,
+	}, nil
+}
+
+var versionTagRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-.]{1,64}$`)
+
+func (*BrokerService) PublishVersionTag(ctx context.Context, in *VersionTagRequest) (*VersionTagResponse, error) {
+	if in == nil || len(in.ServiceId) == 0 || len(in.Version) == 0 || !versionTagRegex.MatchString(in.Tag) {
+		PactLogger.Errorf(nil, "version tag publish request failed: invalid params.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	service, err := serviceUtil.GetService(ctx, tenant, in.ServiceId)
+	if err != nil {
+		PactLogger.Errorf(err, "version tag publish failed, serviceId is %s: query service failed.", in.ServiceId)
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "Query service failed."),
+		}, err
+	}
+	if service == nil {
+		PactLogger.Errorf(nil, "version tag publish failed, serviceId is %s: service not exist.", in.ServiceId)
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Service does not exist."),
+		}, nil
+	}
+	participant, err := GetOrCreateParticipant(ctx, tenant, service)
+	if err != nil {
+		PactLogger.Errorf(err, "version tag publish failed, participant cannot be created.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "participant cannot be created."),
+		}, err
+	}
+	version, err := GetOrCreateVersion(ctx, tenant, in.Version, participant.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "version tag publish failed, version cannot be created.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "version cannot be created."),
+		}, err
+	}
+	tagKey := GenerateBrokerVersionTagKey(tenant, version.Id, in.Tag)
+	err = CreateTag(ctx, tagKey, Tag{Name: in.Tag, VersionId: version.Id})
+	if err != nil {
+		PactLogger.Errorf(err, "version tag publish failed, tag cannot be created.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "tag cannot be created."),
+		}, err
+	}
+	tags, err := GetVersionTags(ctx, tenant, version.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "version tag publish failed, tags cannot be searched.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "tags cannot be searched."),
+		}, err
+	}
+	PactLogger.Infof("Tag %s published for version: (%d, %s, %d)", in.Tag, version.Id, version.Number, version.ParticipantId)
+	return &VersionTagResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Tag published successfully."),
+		Tags:     tags,
+	}, nil
+}
+
+func (*BrokerService) DeleteVersionTag(ctx context.Context, in *VersionTagRequest) (*VersionTagResponse, error) {
+	if in == nil || len(in.ServiceId) == 0 || len(in.Version) == 0 || len(in.Tag) == 0 {
+		PactLogger.Errorf(nil, "version tag delete request failed: invalid params.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	resp, version, err := getParticipantVersion(ctx, tenant, in.ServiceId, in.Version)
+	if resp != nil {
+		return &VersionTagResponse{Response: resp}, err
+	}
+	err = DeleteTag(ctx, GenerateBrokerVersionTagKey(tenant, version.Id, in.Tag))
+	if err != nil {
+		PactLogger.Errorf(err, "version tag delete failed, tag cannot be deleted.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "tag cannot be deleted."),
+		}, err
+	}
+	tags, err := GetVersionTags(ctx, tenant, version.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "version tag delete failed, tags cannot be searched.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "tags cannot be searched."),
+		}, err
+	}
+	PactLogger.Infof("Tag %s deleted for version: (%d, %s, %d)", in.Tag, version.Id, version.Number, version.ParticipantId)
+	return &VersionTagResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Tag deleted successfully."),
+		Tags:     tags,
+	}, nil
+}
+
+func (*BrokerService) GetVersionTags(ctx context.Context, in *VersionTagRequest) (*VersionTagResponse, error) {
+	if in == nil || len(in.ServiceId) == 0 || len(in.Version) == 0 {
+		PactLogger.Errorf(nil, "version tags retrieve request failed: invalid params.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	resp, version, err := getParticipantVersion(ctx, tenant, in.ServiceId, in.Version)
+	if resp != nil {
+		return &VersionTagResponse{Response: resp}, err
+	}
+	tags, err := GetVersionTags(ctx, tenant, version.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "version tags retrieve failed, tags cannot be searched.")
+		return &VersionTagResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "tags cannot be searched."),
+		}, err
+	}
+	return &VersionTagResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Tags retrieved successfully."),
+		Tags:     tags,
+	}, nil
+}
+
+func (*BrokerService) CanIDeploy(ctx context.Context, in *CanIDeployRequest) (*CanIDeployResponse, error) {
+	if in == nil || len(in.ServiceId) == 0 || len(in.Version) == 0 || len(in.To) == 0 {
+		PactLogger.Errorf(nil, "can-i-deploy request failed: invalid params.")
+		return &CanIDeployResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	resp, participant, err := getServiceParticipant(ctx, tenant, in.ServiceId)
+	if resp != nil {
+		PactLogger.Errorf(err, "can-i-deploy failed, serviceId is %s: %s", in.ServiceId, resp.Message)
+		return &CanIDeployResponse{Response: resp}, err
+	}
+	// an unknown participant or version must not be reported as deployable
+	if participant == nil {
+		PactLogger.Errorf(nil, "can-i-deploy failed, serviceId is %s: participant does not exist.", in.ServiceId)
+		return &CanIDeployResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "participant does not exist."),
+		}, nil
+	}
+	version, err := GetVersion(ctx, tenant, in.Version, participant.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "can-i-deploy failed, version cannot be searched.")
+		return &CanIDeployResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "version cannot be searched."),
+		}, err
+	}
+	if version == nil {
+		PactLogger.Errorf(nil, "can-i-deploy failed, version %s does not exist, serviceId is %s.",
+			in.Version, in.ServiceId)
+		return &CanIDeployResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "version does not exist."),
+		}, nil
+	}
+	matrix, err := GetDeploymentMatrix(ctx, tenant, participant, in.Version, in.To)
+	if err != nil {
+		PactLogger.Errorf(err, "can-i-deploy failed, verification matrix cannot be searched.")
+		return &CanIDeployResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "verification matrix cannot be searched."),
+		}, err
+	}
+	summary := SummarizeDeploymentMatrix(matrix)
+	PactLogger.Infof("can-i-deploy (%s, %s) to %s: %t, %s", in.ServiceId, in.Version, in.To,
+		summary.Deployable, summary.Reason)
+	return &CanIDeployResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "can-i-deploy query succeeded."),
+		Summary:  summary,
+		Matrix:   matrix,
+	}, nil
+}
+
+func getServiceParticipant(ctx context.Context, tenant string,
+	serviceId string) (*pb.Response, *Participant, error) {
+	service, err := serviceUtil.GetService(ctx, tenant, serviceId)
+	if err != nil {
+		PactLogger.Errorf(err, "query service failed, serviceId is %s.", serviceId)
+		return pb.CreateResponse(scerr.ErrInternal, "Query service failed."), nil, err
+	}
+	if service == nil {
+		PactLogger.Errorf(nil, "service not exist, serviceId is %s.", serviceId)
+		return pb.CreateResponse(scerr.ErrInvalidParams, "Service does not exist."), nil, nil
+	}
+	participant, err := GetParticipant(ctx, tenant, service.AppId, service.ServiceName)
+	if err != nil {
+		PactLogger.Errorf(err, "participant cannot be searched, serviceId is %s.", serviceId)
+		return pb.CreateResponse(scerr.ErrInternal, "participant cannot be searched."), nil, err
+	}
+	return nil, participant, nil
+}
+
+func getParticipantVersion(ctx context.Context, tenant string, serviceId string,
+	number string) (*pb.Response, *Version, error) {
+	resp, participant, err := getServiceParticipant(ctx, tenant, serviceId)
+	if resp != nil {
+		return resp, nil, err
+	}
+	if participant == nil {
+		PactLogger.Errorf(nil, "participant does not exist, serviceId is %s.", serviceId)
+		return pb.CreateResponse(scerr.ErrInvalidParams, "participant does not exist."), nil, nil
+	}
+	version, err := GetVersion(ctx, tenant, number, participant.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "version cannot be searched, serviceId is %s.", serviceId)
+		return pb.CreateResponse(scerr.ErrInternal, "version cannot be searched."), nil, err
+	}
+	if version == nil {
+		PactLogger.Errorf(nil, "version %s does not exist, serviceId is %s.", number, serviceId)
+		return pb.CreateResponse(scerr.ErrInvalidParams, "version does not exist."), nil, nil
+	}
+	return nil, version, nil
+}
+
+func getPactUrl(baseUrl *BaseBrokerRequest, providerId string, consumerId string, number string) string {
+	if baseUrl == nil || len(baseUrl.HostAddress) == 0 {
+		return ""
+	}
+	return GenerateBrokerAPIPath(baseUrl.Scheme, baseUrl.HostAddress,
+		BROKER_PUBLISH_URL,
+		strings.NewReplacer(":providerId", providerId,
+			":consumerId", consumerId,
+			":number", number))
+}
+
+func (*BrokerService) CreateWebhook(ctx context.Context, in *PutWebhookRequest) (*WebhookResponse, error) {
+	if in == nil {
+		PactLogger.Errorf(nil, "webhook create request failed: invalid params.")
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	id, err := GetData(ctx, GetBrokerLatestWebhookIDKey())
+	if err != nil {
+		PactLogger.Errorf(err, "webhook create failed, webhook id cannot be generated.")
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "webhook id cannot be generated."),
+		}, err
+	}
+	now := time.Now().Format(time.RFC3339)
+	webhook := &Webhook{Id: int32(id) + 1, CreatedAt: now, UpdatedAt: now}
+	resp, err := putWebhook(ctx, tenant, webhook, in)
+	if resp != nil {
+		return &WebhookResponse{Response: resp}, err
+	}
+	PactLogger.Infof("Webhook %d created.", webhook.Id)
+	return &WebhookResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Webhook created successfully."),
+		Webhook:  RedactWebhook(webhook),
+	}, nil
+}
+
+func (*BrokerService) UpdateWebhook(ctx context.Context, in *PutWebhookRequest) (*WebhookResponse, error) {
+	if in == nil || in.Id <= 0 {
+		PactLogger.Errorf(nil, "webhook update request failed: invalid params.")
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	webhook, err := GetWebhook(ctx, tenant, in.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook update failed, webhook %d cannot be searched.", in.Id)
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "webhook cannot be searched."),
+		}, err
+	}
+	if webhook == nil {
+		PactLogger.Errorf(nil, "webhook update failed, webhook %d does not exist.", in.Id)
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "webhook does not exist."),
+		}, nil
+	}
+	webhook.UpdatedAt = time.Now().Format(time.RFC3339)
+	restoreWebhookHeaders(in.Request, webhook.Request)
+	resp, err := putWebhook(ctx, tenant, webhook, in)
+	if resp != nil {
+		return &WebhookResponse{Response: resp}, err
+	}
+	PactLogger.Infof("Webhook %d updated.", webhook.Id)
+	return &WebhookResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Webhook updated successfully."),
+		Webhook:  RedactWebhook(webhook),
+	}, nil
+}
+
+// putWebhook returns nil response if the webhook is saved successfully
+func putWebhook(ctx context.Context, tenant string, webhook *Webhook, in *PutWebhookRequest) (*pb.Response, error) {
+	if err := ValidateWebhook(in); err != nil {
+		PactLogger.Errorf(err, "webhook put request failed: invalid params.")
+		return pb.CreateResponse(scerr.ErrInvalidParams, err.Error()), nil
+	}
+	resp, consumerParticipantId, err := getWebhookParticipantId(ctx, tenant, in.ConsumerId)
+	if resp != nil {
+		return resp, err
+	}
+	resp, providerParticipantId, err := getWebhookParticipantId(ctx, tenant, in.ProviderId)
+	if resp != nil {
+		return resp, err
+	}
+	webhook.ConsumerParticipantId = consumerParticipantId
+	webhook.ProviderParticipantId = providerParticipantId
+	webhook.Description = in.Description
+	webhook.Events = in.Events
+	webhook.Request = in.Request
+	if err := SaveWebhook(ctx, tenant, webhook); err != nil {
+		PactLogger.Errorf(err, "webhook put failed, webhook %d cannot be saved.", webhook.Id)
+		return pb.CreateResponse(scerr.ErrInternal, "webhook cannot be saved."), err
+	}
+	return nil, nil
+}
+
+// getWebhookParticipantId returns 0 if the serviceId is empty, which means
+// the webhook matches all the participants
+func getWebhookParticipantId(ctx context.Context, tenant string, serviceId string) (*pb.Response, int32, error) {
+	if len(serviceId) == 0 {
+		return nil, 0, nil
+	}
+	service, err := serviceUtil.GetService(ctx, tenant, serviceId)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook put failed, serviceId is %s: query service failed.", serviceId)
+		return pb.CreateResponse(scerr.ErrInternal, "Query service failed."), 0, err
+	}
+	if service == nil {
+		PactLogger.Errorf(nil, "webhook put failed, serviceId is %s: service not exist.", serviceId)
+		return pb.CreateResponse(scerr.ErrInvalidParams, "Service does not exist."), 0, nil
+	}
+	participant, err := GetOrCreateParticipant(ctx, tenant, service)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook put failed, participant cannot be created.")
+		return pb.CreateResponse(scerr.ErrInternal, "participant cannot be created."), 0, err
+	}
+	return nil, participant.Id, nil
+}
+
+func (*BrokerService) GetWebhook(ctx context.Context, in *GetWebhookRequest) (*WebhookResponse, error) {
+	if in == nil || in.Id <= 0 {
+		PactLogger.Errorf(nil, "webhook retrieve request failed: invalid params.")
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	webhook, err := GetWebhook(ctx, GetDefaultTenantProject(), in.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook retrieve failed, webhook %d cannot be searched.", in.Id)
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "webhook cannot be searched."),
+		}, err
+	}
+	if webhook == nil {
+		PactLogger.Errorf(nil, "webhook retrieve failed, webhook %d does not exist.", in.Id)
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "webhook does not exist."),
+		}, nil
+	}
+	return &WebhookResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Webhook retrieved successfully."),
+		Webhook:  RedactWebhook(webhook),
+	}, nil
+}
+
+func (*BrokerService) GetWebhooks(ctx context.Context, in *BaseBrokerRequest) (*GetWebhooksResponse, error) {
+	webhooks, err := GetAllWebhooks(ctx, GetDefaultTenantProject())
+	if err != nil {
+		PactLogger.Errorf(err, "webhooks retrieve failed, webhooks cannot be searched.")
+		return &GetWebhooksResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "webhooks cannot be searched."),
+		}, err
+	}
+	for i, webhook := range webhooks {
+		webhooks[i] = RedactWebhook(webhook)
+	}
+	return &GetWebhooksResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Webhooks retrieved successfully."),
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (*BrokerService) DeleteWebhook(ctx context.Context, in *GetWebhookRequest) (*WebhookResponse, error) {
+	resp, err := BrokerServiceAPI.GetWebhook(ctx, in)
+	if resp.Webhook == nil {
+		return resp, err
+	}
+	err = DeleteWebhook(ctx, GetDefaultTenantProject(), in.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook delete failed, webhook %d cannot be deleted.", in.Id)
+		return &WebhookResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "webhook cannot be deleted."),
+		}, err
+	}
+	PactLogger.Infof("Webhook %d deleted.", in.Id)
+	return &WebhookResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Webhook deleted successfully."),
+		Webhook:  resp.Webhook,
+	}, nil
+}
+
+func (*BrokerService) GetWebhookExecutions(ctx context.Context, in *GetWebhookRequest) (*GetWebhookExecutionsResponse, error) {
+	resp, err := BrokerServiceAPI.GetWebhook(ctx, in)
+	if resp.Webhook == nil {
+		return &GetWebhookExecutionsResponse{Response: resp.Response}, err
+	}
+	executions, err := GetWebhookExecutions(ctx, GetDefaultTenantProject(), in.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook executions retrieve failed, executions of webhook %d cannot be searched.", in.Id)
+		return &GetWebhookExecutionsResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "webhook executions cannot be searched."),
+		}, err
+	}
+	// the response bodies may contain the data of the requested hosts, they
+	// are shown to the ones who can configure the webhooks
+	allowed, err := rbac.Allowed(ctx, rbac.RESOURCE_WEBHOOK, rbac.VERB_UPDATE)
+	if err != nil {
+		PactLogger.Errorf(err, "webhook executions retrieve failed, the permission cannot be checked.")
+		return &GetWebhookExecutionsResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "the permission cannot be checked."),
+		}, err
+	}
+	if !allowed {
+		for _, execution := range executions {
+			execution.ResponseBody = ""
+		}
+	}
+	return &GetWebhookExecutionsResponse{
+		Response:   pb.CreateResponse(pb.Response_SUCCESS, "Webhook executions retrieved successfully."),
+		Executions: executions,
+	}, nil
+}
+
+func (*BrokerService) GetMatrix(ctx context.Context, in *MatrixRequest) (*MatrixResponse, error) {
+	if in == nil || len(in.ProviderId) == 0 || len(in.ConsumerId) == 0 {
+		PactLogger.Errorf(nil, "matrix request failed: invalid params.")
+		return &MatrixResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	resp, provider, err := getServiceParticipant(ctx, tenant, in.ProviderId)
+	if resp != nil {
+		return &MatrixResponse{Response: resp}, err
+	}
+	resp, consumer, err := getServiceParticipant(ctx, tenant, in.ConsumerId)
+	if resp != nil {
+		return &MatrixResponse{Response: resp}, err
+	}
+	matrix := make([]*MatrixRow, 0)
+	if provider != nil && consumer != nil {
+		matrix, err = GetMatrix(ctx, tenant, consumer, provider)
+		if err != nil {
+			PactLogger.Errorf(err, "matrix query failed, verification matrix cannot be searched.")
+			return &MatrixResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, "verification matrix cannot be searched."),
+			}, err
+		}
+	}
+	for _, row := range matrix {
+		if href := getPactUrl(in.BaseUrl, in.ProviderId, in.ConsumerId, row.ConsumerVersion); len(href) > 0 {
+			row.XLinks = map[string]*BrokerAPIInfoEntry{
+				"pb:pact": {Href: href, Title: "Pact"},
+			}
+		}
+	}
+	return &MatrixResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Matrix query succeeded."),
+		Matrix:   matrix,
+		XLinks: getSelfLinks(in.BaseUrl, BROKER_MATRIX_URL,
+			strings.NewReplacer(":providerId", in.ProviderId, ":consumerId", in.ConsumerId)),
+	}, nil
+}
+
+func (*BrokerService) DiffPacts(ctx context.Context, in *DiffPactsRequest) (*DiffPactsResponse, error) {
+	if in == nil || len(in.ProviderId) == 0 || len(in.ConsumerId) == 0 ||
+		len(in.Version) == 0 || len(in.OtherVersion) == 0 {
+		PactLogger.Errorf(nil, "pact diff request failed: invalid params.")
+		return &DiffPactsResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	resp, provider, err := getServiceParticipant(ctx, tenant, in.ProviderId)
+	if resp != nil {
+		return &DiffPactsResponse{Response: resp}, err
+	}
+	if provider == nil {
+		PactLogger.Errorf(nil, "pact diff failed, provider participant does not exist.")
+		return &DiffPactsResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "provider participant does not exist."),
+		}, nil
+	}
+	resp, newPact, err := getVersionPact(ctx, tenant, provider, in.ConsumerId, in.Version)
+	if resp != nil {
+		return &DiffPactsResponse{Response: resp}, err
+	}
+	resp, oldPact, err := getVersionPact(ctx, tenant, provider, in.ConsumerId, in.OtherVersion)
+	if resp != nil {
+		return &DiffPactsResponse{Response: resp}, err
+	}
+	links := getSelfLinks(in.BaseUrl, BROKER_PACT_DIFF_URL,
+		strings.NewReplacer(":providerId", in.ProviderId,
+			":consumerId", in.ConsumerId,
+			":number", in.Version,
+			":otherNumber", in.OtherVersion))
+	if links != nil {
+		links["pb:pact"] = &BrokerAPIInfoEntry{
+			Href:  getPactUrl(in.BaseUrl, in.ProviderId, in.ConsumerId, in.Version),
+			Title: "Pact",
+		}
+		links["pb:other-pact"] = &BrokerAPIInfoEntry{
+			Href:  getPactUrl(in.BaseUrl, in.ProviderId, in.ConsumerId, in.OtherVersion),
+			Title: "Pact compared with",
+		}
+	}
+	return &DiffPactsResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Diff pacts succeeded."),
+		Diffs:    DiffPactContents(oldPact.Content, newPact.Content),
+		XLinks:   links,
+	}, nil
+}
+
+func getVersionPact(ctx context.Context, tenant string, provider *Participant,
+	consumerId string, number string) (*pb.Response, *Pact, error) {
+	resp, version, err := getParticipantVersion(ctx, tenant, consumerId, number)
+	if resp != nil {
+		return resp, nil, err
+	}
+	pact, err := GetLatestPactOfVersion(ctx, tenant, version, provider.Id)
+	if err != nil {
+		PactLogger.Errorf(err, "pact of version %s cannot be searched.", number)
+		return pb.CreateResponse(scerr.ErrInternal, "pact cannot be searched."), nil, err
+	}
+	if pact == nil {
+		PactLogger.Errorf(nil, "pact of version %s does not exist.", number)
+		return pb.CreateResponse(scerr.ErrInvalidParams, "pact does not exist."), nil, nil
+	}
+	return nil, pact, nil
+}
+
+func (*BrokerService) GetParticipants(ctx context.Context, in *BaseBrokerRequest) (*GetParticipantsResponse, error) {
+	participants, err := GetAllParticipants(ctx, GetDefaultTenantProject())
+	if err != nil {
+		PactLogger.Errorf(err, "get participants failed.")
+		return &GetParticipantsResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "participants cannot be searched."),
+		}, err
+	}
+	return &GetParticipantsResponse{
+		Response:     pb.CreateResponse(pb.Response_SUCCESS, "Get participants succeeded."),
+		Participants: SortParticipants(participants),
+		XLinks:       getSelfLinks(in, BROKER_PARTICIPANTS_URL, nil),
+	}, nil
+}
+
+func (*BrokerService) GetLatestPacts(ctx context.Context, in *BaseBrokerRequest) (*GetLatestPactsResponse, error) {
+	pacts, err := GetLatestPacts(ctx, GetDefaultTenantProject())
+	if err != nil {
+		PactLogger.Errorf(err, "get latest pacts failed.")
+		return &GetLatestPactsResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "latest pacts cannot be searched."),
+		}, err
+	}
+	return &GetLatestPactsResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Get latest pacts succeeded."),
+		Pacts:    pacts,
+		XLinks:   getSelfLinks(in, BROKER_PACTS_LATEST_URL, nil),
+	}, nil
+}
+
+func getSelfLinks(baseUrl *BaseBrokerRequest, apiPath string,
+	replacer *strings.Replacer) map[string]*BrokerAPIInfoEntry {
+	if baseUrl == nil || len(baseUrl.HostAddress) == 0 {
+		return nil
+	}
+	return map[string]*BrokerAPIInfoEntry{
+		"self": {Href: GenerateBrokerAPIPath(baseUrl.Scheme, baseUrl.HostAddress, apiPath, replacer)},
+		"index": {
+			Href:  GenerateBrokerAPIPath(baseUrl.Scheme, baseUrl.HostAddress, BROKER_HOME_URL, nil),
+			Title: brokerAPILinksTitles["self"],
+		},
+	}
+}
+
+func (*BrokerService) RetrieveStaticVerification(ctx context.Context,
+	in *GetProviderConsumerVersionPactRequest) (*StaticVerificationResponse, error) {
+	return staticVerify(ctx, in, false)
+}
+
+func (*BrokerService) RerunStaticVerification(ctx context.Context,
+	in *GetProviderConsumerVersionPactRequest) (*StaticVerificationResponse, error) {
+	return staticVerify(ctx, in, true)
+}
+
+// staticVerify returns the static verification of the pact published by the consumer
+// version against the provider version, the pact is verified if it has never been
+func staticVerify(ctx context.Context, in *GetProviderConsumerVersionPactRequest,
+	rerun bool) (*StaticVerificationResponse, error) {
+	if in == nil || len(in.ProviderId) == 0 || len(in.ConsumerId) == 0 || len(in.Version) == 0 {
+		PactLogger.Errorf(nil, "static verification request failed: invalid params.")
+		return &StaticVerificationResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	tenant := GetDefaultTenantProject()
+	provider, err := serviceUtil.GetService(ctx, tenant, in.ProviderId)
+	if err != nil {
+		PactLogger.Errorf(err, "static verification failed, providerId is %s: query provider failed.", in.ProviderId)
+		return &StaticVerificationResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "Query provider failed."),
+		}, err
+	}
+	if provider == nil {
+		PactLogger.Errorf(nil, "static verification failed, providerId is %s: provider not exist.", in.ProviderId)
+		return &StaticVerificationResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Provider does not exist."),
+		}, nil
+	}
+	resp, providerParticipant, err := getServiceParticipant(ctx, tenant, in.ProviderId)
+	if resp != nil {
+		return &StaticVerificationResponse{Response: resp}, err
+	}
+	if providerParticipant == nil {
+		PactLogger.Errorf(nil, "static verification failed, provider participant does not exist.")
+		return &StaticVerificationResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "provider participant does not exist."),
+		}, nil
+	}
+	resp, pact, err := getVersionPact(ctx, tenant, providerParticipant, in.ConsumerId, in.Version)
+	if resp != nil {
+		return &StaticVerificationResponse{Response: resp}, err
+	}
+
+	var result *StaticVerification
+	if !rerun {
+		result, err = GetStaticVerification(ctx, tenant, pact.Id, provider.Version)
+		if err != nil {
+			PactLogger.Errorf(err, "static verification of pact %d cannot be searched.", pact.Id)
+			return &StaticVerificationResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, "static verification cannot be searched."),
+			}, err
+		}
+	}
+	if result == nil {
+		result, err = StaticVerifyPact(ctx, tenant, pact, provider)
+		if err != nil {
+			PactLogger.Errorf(err, "static verification of pact %d failed.", pact.Id)
+			return &StaticVerificationResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, "static verification failed."),
+			}, err
+		}
+	}
+	return &StaticVerificationResponse{
+		Response:     pb.CreateResponse(pb.Response_SUCCESS, "Get static verification succeeded."),
+		Verification: result,
+	}, nil
+}
+
+func (*BrokerService) RunRetention(ctx context.Context, in *RunRetentionRequest) (*RetentionResponse, error) {
+	policy := GetConfiguredRetentionPolicy()
+	if in != nil && in.Policy != nil {
+		policy = in.Policy
+	}
+	if policy.KeepVersions < 0 {
+		PactLogger.Errorf(nil, "run retention failed: invalid keep versions %d.", policy.KeepVersions)
+		return &RetentionResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
+		}, nil
+	}
+	report, err := RunRetention(ctx, GetDefaultTenantProject(), policy)
+	if err != nil {
+		PactLogger.Errorf(err, "run retention failed.")
+		return &RetentionResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+	if len(report.Error) > 0 {
+		return &RetentionResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "delete expired data failed."),
+			Report:   report,
+		}, nil
+	}
+	return &RetentionResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Run retention succeeded."),
+		Report:   report,
+	}, nil
+}
+
+func (*BrokerService) GetRetentionReport(ctx context.Context, in *BaseBrokerRequest) (*RetentionResponse, error) {
+	report, err := GetLatestRetentionReport(ctx)
+	if err != nil {
+		PactLogger.Errorf(err, "get retention report failed.")
+		return &RetentionResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, "retention report cannot be searched."),
+		}, err
+	}
+	if report == nil {
+		return &RetentionResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "retention has never run."),
+		}, nil
+	}
+	return &RetentionResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Get retention report succeeded."),
+		Report:   report,
 	}, nil
 }
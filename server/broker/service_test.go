@@ -18,10 +18,17 @@ package broker
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
 
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
 	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"github.com/astaxie/beego"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"golang.org/x/net/context"
@@ -150,7 +157,9 @@ var _ = Describe("BrokerController", func() {
 				})
 
 				Expect(respGetHome).NotTo(BeNil())
-
+				Expect(respGetHome.XLinks["pb:matrix"]).NotTo(BeNil())
+				Expect(respGetHome.XLinks["pb:matrix"].Templated).To(BeTrue())
+				Expect(respGetHome.XLinks["pb:can-i-deploy"]).NotTo(BeNil())
 			})
 
 			It("GetBrokerAllProviderPacts", func() {
@@ -185,6 +194,429 @@ var _ = Describe("BrokerController", func() {
 				Expect(respGetAllProviderPacts.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
 			})
 
+			It("PublishVersionTag", func() {
+				fmt.Println("UT===========PublishVersionTag")
+
+				respTag, err := brokerResource.PublishVersionTag(getContext(), &VersionTagRequest{
+					ServiceId: consumerServiceId,
+					Version:   TEST_BROKER_CONSUMER_VERSION,
+					Tag:       "prod",
+				})
+				Expect(err).To(BeNil())
+				Expect(respTag.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respTag.Tags).To(Equal([]string{"prod"}))
+
+				respTag, _ = brokerResource.PublishVersionTag(getContext(), &VersionTagRequest{
+					ServiceId: consumerServiceId,
+					Version:   TEST_BROKER_CONSUMER_VERSION,
+					Tag:       "invalid tag",
+				})
+				Expect(respTag.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+
+				respTag, _ = brokerResource.PublishVersionTag(getContext(), &VersionTagRequest{
+					ServiceId: TEST_BROKER_NO_SERVICE_ID,
+					Version:   TEST_BROKER_CONSUMER_VERSION,
+					Tag:       "prod",
+				})
+				Expect(respTag.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+			})
+
+			It("GetBrokerTaggedProviderPacts", func() {
+				fmt.Println("UT===========GetBrokerTaggedProviderPacts")
+
+				respGetAllProviderPacts, _ := brokerResource.GetAllProviderPacts(getContext(),
+					&GetAllProviderPactsRequest{
+						ProviderId: providerServiceId,
+						Tag:        "prod",
+						BaseUrl: &BaseBrokerRequest{
+							HostAddress: "localhost",
+							Scheme:      "http",
+						}})
+				Expect(respGetAllProviderPacts.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respGetAllProviderPacts.XLinks.Pacts)).To(Equal(1))
+
+				respGetAllProviderPacts, _ = brokerResource.GetAllProviderPacts(getContext(),
+					&GetAllProviderPactsRequest{
+						ProviderId: providerServiceId,
+						Tag:        "test",
+						BaseUrl: &BaseBrokerRequest{
+							HostAddress: "localhost",
+							Scheme:      "http",
+						}})
+				Expect(respGetAllProviderPacts.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respGetAllProviderPacts.XLinks.Pacts)).To(Equal(0))
+			})
+
+			It("CanIDeploy", func() {
+				fmt.Println("UT===========CanIDeploy")
+
+				By("the provider is not deployed")
+				respCanIDeploy, err := brokerResource.CanIDeploy(getContext(), &CanIDeployRequest{
+					ServiceId: consumerServiceId,
+					Version:   TEST_BROKER_CONSUMER_VERSION,
+					To:        "prod",
+				})
+				Expect(err).To(BeNil())
+				Expect(respCanIDeploy.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respCanIDeploy.Summary.Deployable).To(BeFalse())
+				Expect(respCanIDeploy.Summary.Unknown).To(Equal(int32(1)))
+
+				By("the pact is verified by the deployed provider")
+				_, pactId, err := RetrieveProviderConsumerPact(getContext(),
+					&GetProviderConsumerVersionPactRequest{
+						ProviderId: providerServiceId,
+						ConsumerId: consumerServiceId,
+						Version:    TEST_BROKER_CONSUMER_VERSION,
+					})
+				Expect(err).To(BeNil())
+				respVerification, err := brokerResource.PublishVerificationResults(getContext(),
+					&PublishVerificationRequest{
+						ProviderId:                 providerServiceId,
+						ConsumerId:                 consumerServiceId,
+						PactId:                     pactId,
+						Success:                    true,
+						ProviderApplicationVersion: TEST_BROKER_PROVIDER_VERSION,
+					})
+				Expect(err).To(BeNil())
+				Expect(respVerification.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				respTag, err := brokerResource.PublishVersionTag(getContext(), &VersionTagRequest{
+					ServiceId: providerServiceId,
+					Version:   TEST_BROKER_PROVIDER_VERSION,
+					Tag:       "prod",
+				})
+				Expect(err).To(BeNil())
+				Expect(respTag.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+
+				respCanIDeploy, _ = brokerResource.CanIDeploy(getContext(), &CanIDeployRequest{
+					ServiceId: consumerServiceId,
+					Version:   TEST_BROKER_CONSUMER_VERSION,
+					To:        "prod",
+				})
+				Expect(respCanIDeploy.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respCanIDeploy.Summary.Deployable).To(BeTrue())
+				Expect(len(respCanIDeploy.Matrix)).To(Equal(1))
+				Expect(respCanIDeploy.Matrix[0].ProviderVersion).To(Equal(TEST_BROKER_PROVIDER_VERSION))
+
+				By("the version does not exist")
+				respCanIDeploy, _ = brokerResource.CanIDeploy(getContext(), &CanIDeployRequest{
+					ServiceId: consumerServiceId,
+					Version:   TEST_BROKER_NO_VERSION,
+					To:        "prod",
+				})
+				Expect(respCanIDeploy.GetResponse().Code).To(Equal(scerr.ErrInvalidParams))
+				Expect(respCanIDeploy.Summary).To(BeNil())
+
+				By("delete the tag")
+				respTag, _ = brokerResource.DeleteVersionTag(getContext(), &VersionTagRequest{
+					ServiceId: providerServiceId,
+					Version:   TEST_BROKER_PROVIDER_VERSION,
+					Tag:       "prod",
+				})
+				Expect(respTag.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respTag.Tags)).To(Equal(0))
+
+				respCanIDeploy, _ = brokerResource.CanIDeploy(getContext(), &CanIDeployRequest{
+					ServiceId: TEST_BROKER_NO_SERVICE_ID,
+					Version:   TEST_BROKER_CONSUMER_VERSION,
+					To:        "prod",
+				})
+				Expect(respCanIDeploy.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+			})
+
+			It("Webhooks", func() {
+				fmt.Println("UT===========Webhooks")
+
+				received := make(chan string, 1)
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body, _ := ioutil.ReadAll(r.Body)
+					received <- r.Header.Get("X-Token") + ":" + string(body)
+					w.Write([]byte("internal data"))
+				}))
+				defer server.Close()
+
+				By("invalid webhooks")
+				respWebhook, _ := brokerResource.CreateWebhook(getContext(), &PutWebhookRequest{
+					Events: []string{"unknown"},
+					Request: &WebhookRequest{
+						Method: "POST",
+						Url:    server.URL,
+					},
+				})
+				Expect(respWebhook.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+				respWebhook, _ = brokerResource.CreateWebhook(getContext(), &PutWebhookRequest{
+					Events: []string{WEBHOOK_EVENT_CONTRACT_CONTENT_CHANGED},
+					Request: &WebhookRequest{
+						Method: "POST",
+						Url:    "invalid url",
+					},
+				})
+				Expect(respWebhook.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+
+				By("forbidden hosts")
+				for _, u := range []string{server.URL, "http://169.254.169.254/latest/meta-data",
+					"http://10.0.0.1/", "http://192.168.1.1/", "http://[fd00::1]/"} {
+					respWebhook, _ = brokerResource.CreateWebhook(getContext(), &PutWebhookRequest{
+						Events: []string{WEBHOOK_EVENT_CONTRACT_CONTENT_CHANGED},
+						Request: &WebhookRequest{
+							Method: "POST",
+							Url:    u,
+						},
+					})
+					Expect(respWebhook.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+				}
+				_, _, err := doWebhookRequest(&WebhookRequest{Method: "GET", Url: server.URL})
+				Expect(err).ToNot(BeNil())
+				// the host name resolving to the loopback address
+				_, _, err = doWebhookRequest(&WebhookRequest{Method: "GET",
+					Url: strings.Replace(server.URL, "127.0.0.1", "localhost", 1)})
+				Expect(err).ToNot(BeNil())
+
+				beego.AppConfig.Set("broker_webhook_allowed_hosts", "127.0.0.1")
+				defer beego.AppConfig.Set("broker_webhook_allowed_hosts", "")
+
+				By("create a webhook of the provider")
+				respWebhook, err = brokerResource.CreateWebhook(getContext(), &PutWebhookRequest{
+					ProviderId: providerServiceId,
+					Events:     []string{WEBHOOK_EVENT_CONTRACT_CONTENT_CHANGED},
+					Request: &WebhookRequest{
+						Method:  "POST",
+						Url:     server.URL,
+						Headers: map[string]string{"X-Token": "secret"},
+						Body:    "${pactbroker.consumerName}:${pactbroker.consumerVersionNumber}",
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respWebhook.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respWebhook.Webhook.Request.Headers["X-Token"]).To(Equal(WEBHOOK_HEADER_MASK))
+				webhookId := respWebhook.Webhook.Id
+
+				respWebhooks, _ := brokerResource.GetWebhooks(getContext(), &BaseBrokerRequest{})
+				Expect(respWebhooks.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respWebhooks.Webhooks)).To(Equal(1))
+				Expect(respWebhooks.Webhooks[0].Request.Headers["X-Token"]).To(Equal(WEBHOOK_HEADER_MASK))
+
+				By("put back the redacted webhook")
+				respWebhook, _ = brokerResource.GetWebhook(getContext(), &GetWebhookRequest{Id: webhookId})
+				Expect(respWebhook.Webhook.Request.Headers["X-Token"]).To(Equal(WEBHOOK_HEADER_MASK))
+				respWebhook, _ = brokerResource.UpdateWebhook(getContext(), &PutWebhookRequest{
+					Id:         webhookId,
+					ProviderId: providerServiceId,
+					Events:     respWebhook.Webhook.Events,
+					Request:    respWebhook.Webhook.Request,
+				})
+				Expect(respWebhook.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+
+				By("the pact content changed")
+				respPublishPact, err := brokerResource.PublishPact(getContext(),
+					&PublishPactRequest{
+						ProviderId: providerServiceId,
+						ConsumerId: consumerServiceId,
+						Version:    TEST_BROKER_CONSUMER_VERSION,
+						Pact:       []byte("hello webhook"),
+					})
+				Expect(err).To(BeNil())
+				Expect(respPublishPact.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Eventually(received, 5*time.Second).Should(Receive(Equal(
+					"secret:" + TEST_BROKER_CONSUMER_NAME + ":" + TEST_BROKER_CONSUMER_VERSION)))
+				Eventually(func() int {
+					respExecutions, _ := brokerResource.GetWebhookExecutions(getContext(),
+						&GetWebhookRequest{Id: webhookId})
+					return len(respExecutions.Executions)
+				}, 5*time.Second).Should(Equal(1))
+				respExecutions, _ := brokerResource.GetWebhookExecutions(getContext(),
+					&GetWebhookRequest{Id: webhookId})
+				// the rbac is disabled, everyone can configure the webhook
+				Expect(respExecutions.Executions[0].ResponseBody).To(Equal("internal data"))
+
+				By("delete the webhook")
+				respWebhook, _ = brokerResource.DeleteWebhook(getContext(), &GetWebhookRequest{Id: webhookId})
+				Expect(respWebhook.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				respWebhook, _ = brokerResource.GetWebhook(getContext(), &GetWebhookRequest{Id: webhookId})
+				Expect(respWebhook.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+			})
+
+			It("MatrixAndPactDiff", func() {
+				fmt.Println("UT===========MatrixAndPactDiff")
+
+				By("publish the pacts of two consumer versions")
+				resp, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						ServiceName: TEST_BROKER_CONSUMER_NAME,
+						AppId:       TEST_BROKER_CONSUMER_APP,
+						Version:     "4.0.1",
+						Level:       "FRONT",
+						Status:      "UP",
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				newConsumerServiceId := resp.ServiceId
+
+				respPublishPact, err := brokerResource.PublishPact(getContext(), &PublishPactRequest{
+					ProviderId: providerServiceId,
+					ConsumerId: consumerServiceId,
+					Version:    TEST_BROKER_CONSUMER_VERSION,
+					Pact:       []byte(`{"interactions":[{"response":{"status":200}}]}`),
+				})
+				Expect(err).To(BeNil())
+				Expect(respPublishPact.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				respPublishPact, err = brokerResource.PublishPact(getContext(), &PublishPactRequest{
+					ProviderId: providerServiceId,
+					ConsumerId: newConsumerServiceId,
+					Version:    "4.0.1",
+					Pact:       []byte(`{"interactions":[{"response":{"status":201}}]}`),
+				})
+				Expect(err).To(BeNil())
+				Expect(respPublishPact.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+
+				By("the matrix lists the newest consumer version first")
+				respMatrix, err := brokerResource.GetMatrix(getContext(), &MatrixRequest{
+					ProviderId: providerServiceId,
+					ConsumerId: consumerServiceId,
+					BaseUrl: &BaseBrokerRequest{
+						HostAddress: "localhost",
+						Scheme:      "http",
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respMatrix.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respMatrix.Matrix)).To(Equal(2))
+				Expect(respMatrix.Matrix[0].ConsumerVersion).To(Equal("4.0.1"))
+				Expect(respMatrix.Matrix[0].Status).To(Equal(VERIFICATION_STATUS_UNKNOWN))
+				Expect(respMatrix.Matrix[0].XLinks["pb:pact"]).NotTo(BeNil())
+				Expect(respMatrix.XLinks["self"]).NotTo(BeNil())
+
+				By("diff the pacts")
+				respDiff, err := brokerResource.DiffPacts(getContext(), &DiffPactsRequest{
+					ProviderId:   providerServiceId,
+					ConsumerId:   consumerServiceId,
+					Version:      "4.0.1",
+					OtherVersion: TEST_BROKER_CONSUMER_VERSION,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDiff.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respDiff.Diffs)).To(Equal(1))
+				Expect(respDiff.Diffs[0].Path).To(Equal("/interactions/0/response/status"))
+				Expect(respDiff.Diffs[0].Type).To(Equal(pb.SCHEMA_DIFF_CHANGED))
+
+				respDiff, _ = brokerResource.DiffPacts(getContext(), &DiffPactsRequest{
+					ProviderId:   providerServiceId,
+					ConsumerId:   consumerServiceId,
+					Version:      TEST_BROKER_NO_VERSION,
+					OtherVersion: TEST_BROKER_CONSUMER_VERSION,
+				})
+				Expect(respDiff.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+
+				By("browse the participants and the latest pacts")
+				respParticipants, err := brokerResource.GetParticipants(getContext(), &BaseBrokerRequest{})
+				Expect(err).To(BeNil())
+				Expect(respParticipants.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respParticipants.Participants)).To(BeNumerically(">=", 2))
+
+				respLatestPacts, err := brokerResource.GetLatestPacts(getContext(), &BaseBrokerRequest{})
+				Expect(err).To(BeNil())
+				Expect(respLatestPacts.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respLatestPacts.Pacts)).To(Equal(1))
+				Expect(respLatestPacts.Pacts[0].ConsumerVersion).To(Equal("4.0.1"))
+			})
+
+			It("StaticVerification", func() {
+				fmt.Println("UT===========StaticVerification")
+
+				respSchema, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: providerServiceId,
+					SchemaId:  "xxxxxxxx",
+					Schema: `{"swagger":"2.0","basePath":"/orders","paths":{"/{id}":{"get":{
+"parameters":[{"name":"id","in":"path","required":true,"type":"integer"}],
+"responses":{"200":{"description":"ok","schema":{"type":"object","properties":{"id":{"type":"integer"}}}}}}}}}`,
+				})
+				Expect(err).To(BeNil())
+				Expect(respSchema.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+
+				By("the pact matches the schema")
+				respPublishPact, err := brokerResource.PublishPact(getContext(), &PublishPactRequest{
+					ProviderId: providerServiceId,
+					ConsumerId: consumerServiceId,
+					Version:    TEST_BROKER_CONSUMER_VERSION,
+					Pact: []byte(`{"interactions":[{"description":"get order",
+"request":{"method":"GET","path":"/orders/1"},"response":{"status":200,"body":{"id":1}}}]}`),
+				})
+				Expect(err).To(BeNil())
+				Expect(respPublishPact.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respPublishPact.StaticVerification.Status).To(Equal(VERIFICATION_STATUS_SUCCESS))
+
+				By("the pact does not match the schema")
+				respPublishPact, err = brokerResource.PublishPact(getContext(), &PublishPactRequest{
+					ProviderId: providerServiceId,
+					ConsumerId: consumerServiceId,
+					Version:    TEST_BROKER_CONSUMER_VERSION,
+					Pact: []byte(`{"interactions":[{"description":"get order",
+"request":{"method":"GET","path":"/orders/a"},"response":{"status":200,"body":{"id":"1"}}},
+{"description":"delete order","request":{"method":"DELETE","path":"/orders/1"},"response":{"status":204}}]}`),
+				})
+				Expect(err).To(BeNil())
+				Expect(respPublishPact.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respPublishPact.StaticVerification.Status).To(Equal(VERIFICATION_STATUS_FAILED))
+				Expect(len(respPublishPact.StaticVerification.Issues)).To(Equal(2))
+				Expect(len(respPublishPact.StaticVerification.Issues[0].Errors)).To(Equal(2))
+
+				respStatic, err := brokerResource.RetrieveStaticVerification(getContext(),
+					&GetProviderConsumerVersionPactRequest{
+						ProviderId: providerServiceId,
+						ConsumerId: consumerServiceId,
+						Version:    TEST_BROKER_CONSUMER_VERSION,
+					})
+				Expect(err).To(BeNil())
+				Expect(respStatic.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respStatic.Verification.Status).To(Equal(VERIFICATION_STATUS_FAILED))
+			})
+
+			It("Retention", func() {
+				fmt.Println("UT===========Retention")
+
+				By("the dry run only reports the expired versions")
+				respRetention, err := brokerResource.RunRetention(getContext(), &RunRetentionRequest{
+					Policy: &RetentionPolicy{KeepVersions: 1, DryRun: true},
+				})
+				Expect(err).To(BeNil())
+				Expect(respRetention.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respRetention.Report.Versions)).To(BeNumerically(">=", 1))
+				Expect(respRetention.Report.Pacts).To(BeNumerically(">=", 1))
+
+				respReport, err := brokerResource.GetRetentionReport(getContext(), &BaseBrokerRequest{})
+				Expect(err).To(BeNil())
+				Expect(respReport.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respReport.Report.Policy.DryRun).To(BeTrue())
+
+				respDiff, err := brokerResource.DiffPacts(getContext(), &DiffPactsRequest{
+					ProviderId:   providerServiceId,
+					ConsumerId:   consumerServiceId,
+					Version:      "4.0.1",
+					OtherVersion: TEST_BROKER_CONSUMER_VERSION,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDiff.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+
+				By("delete the expired versions")
+				respRetention, err = brokerResource.RunRetention(getContext(), &RunRetentionRequest{
+					Policy: &RetentionPolicy{KeepVersions: 1},
+				})
+				Expect(err).To(BeNil())
+				Expect(respRetention.GetResponse().Code).To(Equal(pb.Response_SUCCESS))
+
+				respDiff, _ = brokerResource.DiffPacts(getContext(), &DiffPactsRequest{
+					ProviderId:   providerServiceId,
+					ConsumerId:   consumerServiceId,
+					Version:      "4.0.1",
+					OtherVersion: TEST_BROKER_CONSUMER_VERSION,
+				})
+				Expect(respDiff.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+
+				respRetention, _ = brokerResource.RunRetention(getContext(), &RunRetentionRequest{
+					Policy: &RetentionPolicy{KeepVersions: -1},
+				})
+				Expect(respRetention.GetResponse().Code).ToNot(Equal(pb.Response_SUCCESS))
+			})
 		})
 	})
 })
@@ -0,0 +1,251 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package broker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/apache/incubator-servicecomb-service-center/server/mock"
+	"golang.org/x/net/context"
+)
+
+// pactDocument is the part of the pact checked by the static verification,
+// both the pact specification v2 and v3 are supported
+type pactDocument struct {
+	Interactions []*pactInteraction `json:"interactions"`
+}
+
+type pactInteraction struct {
+	Description string       `json:"description"`
+	Request     pactRequest  `json:"request"`
+	Response    pactResponse `json:"response"`
+}
+
+type pactRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Query is a string in v2 and a map of the values in v3
+	Query   interface{}            `json:"query"`
+	Headers map[string]interface{} `json:"headers"`
+	Body    json.RawMessage        `json:"body"`
+}
+
+type pactResponse struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+func asStrings(v interface{}) []string {
+	switch value := v.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(value)}
+	}
+}
+
+// pactBody returns the content of the body, the text body is a json string in the pact
+func pactBody(raw json.RawMessage) []byte {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return util.StringToBytesWithNoCopy(s)
+	}
+	return raw
+}
+
+func (r *pactRequest) mockRequest() *mock.Request {
+	req := &mock.Request{
+		Method: r.Method,
+		Path:   r.Path,
+		Query:  url.Values{},
+		Header: http.Header{},
+		Body:   pactBody(r.Body),
+	}
+	switch query := r.Query.(type) {
+	case string:
+		req.Query, _ = url.ParseQuery(query)
+	case map[string]interface{}:
+		for name, value := range query {
+			req.Query[name] = asStrings(value)
+		}
+	}
+	for name, value := range r.Headers {
+		for _, v := range asStrings(value) {
+			req.Header.Add(name, v)
+		}
+	}
+	return req
+}
+
+// VerifyPactStatically checks the interactions of the pact against the OpenAPI/Swagger
+// schemas of the provider, an interaction passes if any schema declares the operation
+// and accepts the request and the response
+func VerifyPactStatically(schemas []string, content []byte) *StaticVerification {
+	result := &StaticVerification{
+		Status:           VERIFICATION_STATUS_UNKNOWN,
+		VerificationDate: time.Now().Format(time.RFC3339),
+	}
+	doc := &pactDocument{}
+	if err := json.Unmarshal(content, doc); err != nil || len(doc.Interactions) == 0 {
+		result.Reason = "The pact is not a json document with interactions."
+		return result
+	}
+
+	openAPI := false
+	for _, interaction := range doc.Interactions {
+		req := interaction.Request.mockRequest()
+		expect := &mock.Expectation{
+			StatusCode: interaction.Response.Status,
+			Body:       pactBody(interaction.Response.Body),
+		}
+		var (
+			declared bool
+			errs     mock.ValidationError
+		)
+		for _, schema := range schemas {
+			err := mock.Verify(schema, req, expect)
+			if err == mock.ErrNotOpenAPI {
+				continue
+			}
+			openAPI = true
+			if _, ok := err.(*mock.NotFoundError); ok {
+				continue
+			}
+			if err == nil {
+				declared, errs = true, nil
+				break
+			}
+			if !declared {
+				declared = true
+				errs, _ = err.(mock.ValidationError)
+			}
+		}
+		if !declared {
+			errs = mock.ValidationError{fmt.Sprintf("operation %s %s is not declared in the schemas",
+				req.Method, req.Path)}
+		}
+		if len(errs) > 0 {
+			result.Issues = append(result.Issues, &StaticVerificationIssue{
+				Interaction: interaction.Description,
+				Method:      req.Method,
+				Path:        req.Path,
+				Errors:      errs,
+			})
+		}
+	}
+
+	switch {
+	case !openAPI:
+		result.Issues = nil
+		result.Reason = "The provider does not have any OpenAPI/Swagger schema."
+	case len(result.Issues) > 0:
+		result.Status = VERIFICATION_STATUS_FAILED
+		result.Reason = "One or more interactions do not match the provider schemas."
+	default:
+		result.Status = VERIFICATION_STATUS_SUCCESS
+		result.Reason = "All interactions match the provider schemas."
+	}
+	return result
+}
+
+// GetProviderSchemas returns the contents of the schemas registered by the provider
+func GetProviderSchemas(ctx context.Context, providerId string) ([]string, error) {
+	resp, err := core.ServiceAPI.GetAllSchemaInfo(ctx, &pb.GetAllSchemaRequest{
+		ServiceId:  providerId,
+		WithSchema: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Response.Code != pb.Response_SUCCESS {
+		return nil, fmt.Errorf("get schemas of provider %s failed, %s", providerId, resp.Response.Message)
+	}
+	schemas := make([]string, 0, len(resp.Schemas))
+	for _, schema := range resp.Schemas {
+		if len(schema.Schema) > 0 {
+			schemas = append(schemas, schema.Schema)
+		}
+	}
+	return schemas, nil
+}
+
+// StaticVerifyPact checks the pact against the schemas of the provider version and
+// stores the result
+func StaticVerifyPact(ctx context.Context, tenant string, pact *Pact,
+	provider *pb.MicroService) (*StaticVerification, error) {
+	schemas, err := GetProviderSchemas(ctx, provider.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	result := VerifyPactStatically(schemas, pact.Content)
+	result.PactId = pact.Id
+	result.ProviderVersion = provider.Version
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(GenerateBrokerStaticVerificationKey(tenant, pact.Id, provider.Version)),
+		registry.WithValue(data))
+	if err != nil {
+		return nil, err
+	}
+	PactLogger.Infof("static verification of pact %d against provider %s version %s: %s",
+		pact.Id, provider.ServiceName, provider.Version, result.Status)
+	return result, nil
+}
+
+// GetStaticVerification returns the stored static verification of the pact
+// against the provider version
+func GetStaticVerification(ctx context.Context, tenant string, pactId int32,
+	providerVersion string) (*StaticVerification, error) {
+	resp, err := Store().StaticVerification().Search(ctx,
+		registry.WithStrKey(GenerateBrokerStaticVerificationKey(tenant, pactId, providerVersion)))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	result := &StaticVerification{}
+	err = json.Unmarshal(resp.Kvs[0].Value, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
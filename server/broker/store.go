@@ -21,13 +21,16 @@ import (
 )
 
 var (
-	PARTICIPANT  backend.StoreType
-	VERSION      backend.StoreType
-	PACT         backend.StoreType
-	PACT_VERSION backend.StoreType
-	PACT_TAG     backend.StoreType
-	VERIFICATION backend.StoreType
-	PACT_LATEST  backend.StoreType
+	PARTICIPANT         backend.StoreType
+	VERSION             backend.StoreType
+	PACT                backend.StoreType
+	PACT_VERSION        backend.StoreType
+	PACT_TAG            backend.StoreType
+	VERIFICATION        backend.StoreType
+	PACT_LATEST         backend.StoreType
+	WEBHOOK             backend.StoreType
+	WEBHOOK_EXEC        backend.StoreType
+	STATIC_VERIFICATION backend.StoreType
 )
 
 var brokerKvStore = &BKvStore{}
@@ -40,6 +43,9 @@ func init() {
 	PACT_TAG = backend.Store().MustInstall(backend.NewEntity("PACT_TAG", GetBrokerTagKey("")))
 	VERIFICATION = backend.Store().MustInstall(backend.NewEntity("VERIFICATION", GetBrokerVerificationKey("")))
 	PACT_LATEST = backend.Store().MustInstall(backend.NewEntity("PACT_LATEST", GetBrokerLatestKey("")))
+	WEBHOOK = backend.Store().MustInstall(backend.NewEntity("WEBHOOK", GetBrokerWebhookKey("")))
+	WEBHOOK_EXEC = backend.Store().MustInstall(backend.NewEntity("WEBHOOK_EXEC", GetBrokerWebhookExecutionKey("")))
+	STATIC_VERIFICATION = backend.Store().MustInstall(backend.NewEntity("STATIC_VERIFICATION", GetBrokerStaticVerificationKey("")))
 
 }
 
@@ -74,6 +80,18 @@ func (s *BKvStore) PactLatest() *backend.Indexer {
 	return backend.Store().Entity(PACT_LATEST)
 }
 
+func (s *BKvStore) Webhook() *backend.Indexer {
+	return backend.Store().Entity(WEBHOOK)
+}
+
+func (s *BKvStore) WebhookExecution() *backend.Indexer {
+	return backend.Store().Entity(WEBHOOK_EXEC)
+}
+
+func (s *BKvStore) StaticVerification() *backend.Indexer {
+	return backend.Store().Entity(STATIC_VERIFICATION)
+}
+
 func Store() *BKvStore {
 	return brokerKvStore
 }
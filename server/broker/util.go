@@ -22,6 +22,7 @@ import (
 	"errors"
 	"math"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -53,11 +54,24 @@ const (
 
 	BROKER_PUBLISH_URL              = "/pacts/provider/:providerId/consumer/:consumerId/version/:number"
 	BROKER_PUBLISH_VERIFICATION_URL = "/pacts/provider/:providerId/consumer/:consumerId/pact-version/:pact/verification-results"
+	BROKER_LATEST_VERIFICATION_URL  = "/verification-results/consumer/:consumerId/version/:consumerVersion/latest"
+	BROKER_PACT_DIFF_URL            = "/pacts/provider/:providerId/consumer/:consumerId/version/:number/diff/version/:otherNumber"
+	BROKER_STATIC_VERIFICATION_URL  = "/pacts/provider/:providerId/consumer/:consumerId/version/:number/static-verification"
+	BROKER_VERSION_TAG_URL          = "/participants/:serviceId/versions/:version/tags/:tag"
+	BROKER_VERSION_TAGS_URL         = "/participants/:serviceId/versions/:version/tags"
+	BROKER_MATRIX_URL               = "/matrix/provider/:providerId/consumer/:consumerId"
+	BROKER_CAN_I_DEPLOY_URL         = "/can-i-deploy"
 	BROKER_WEBHOOHS_URL             = "/webhooks"
 
 	BROKER_CURIES_URL = "/doc/:rel"
 )
 
+const (
+	VERIFICATION_STATUS_SUCCESS = "SUCCESS"
+	VERIFICATION_STATUS_FAILED  = "FAILED"
+	VERIFICATION_STATUS_UNKNOWN = "UNKNOWN"
+)
+
 var brokerAPILinksValues = map[string]string{
 	"self":                              BROKER_HOME_URL,
 	"pb:publish-pact":                   BROKER_PUBLISH_URL,
@@ -66,6 +80,12 @@ var brokerAPILinksValues = map[string]string{
 	"pb:latest-provider-pacts":          BROKER_PROVIDER_LATEST_PACTS_URL,
 	"pb:latest-provider-pacts-with-tag": BROKER_PROVIDER_LATEST_PACTS_TAG_URL,
 	"pb:webhooks":                       BROKER_WEBHOOHS_URL,
+	"pb:latest-verification-results":    BROKER_LATEST_VERIFICATION_URL,
+	"pb:pact-diff":                      BROKER_PACT_DIFF_URL,
+	"pb:pacticipant-version-tag":        BROKER_VERSION_TAG_URL,
+	"pb:matrix":                         BROKER_MATRIX_URL,
+	"pb:can-i-deploy":                   BROKER_CAN_I_DEPLOY_URL,
+	"pb:static-verification":            BROKER_STATIC_VERIFICATION_URL,
 }
 
 var brokerAPILinksTempl = map[string]bool{
@@ -76,6 +96,12 @@ var brokerAPILinksTempl = map[string]bool{
 	"pb:latest-provider-pacts":          true,
 	"pb:latest-provider-pacts-with-tag": true,
 	"pb:webhooks":                       false,
+	"pb:latest-verification-results":    true,
+	"pb:pact-diff":                      true,
+	"pb:pacticipant-version-tag":        true,
+	"pb:matrix":                         true,
+	"pb:can-i-deploy":                   false,
+	"pb:static-verification":            true,
 }
 
 var brokerAPILinksTitles = map[string]string{
@@ -86,6 +112,12 @@ var brokerAPILinksTitles = map[string]string{
 	"pb:latest-provider-pacts":          "Latest pacts by provider",
 	"pb:latest-provider-pacts-with-tag": "Latest pacts by provider with a specified tag",
 	"pb:webhooks":                       "Webhooks",
+	"pb:latest-verification-results":    "Latest verification results of a consumer version",
+	"pb:pact-diff":                      "Differences between the pacts of two consumer versions",
+	"pb:pacticipant-version-tag":        "Tag a pacticipant version",
+	"pb:matrix":                         "Verification matrix between a consumer and a provider",
+	"pb:can-i-deploy":                   "Check if a version can be deployed, queried by serviceId, version and to",
+	"pb:static-verification":            "Static verification of a pact against the provider schemas",
 }
 
 func init() {
@@ -128,9 +160,19 @@ func GetBrokerHomeLinksAPIS(scheme string, host string, apiKey string) string {
 		strings.NewReplacer(":providerId", "{provider}",
 			":consumerId", "{consumer}",
 			":number", "{consumerApplicationVersion}",
+			":consumerVersion", "{consumerApplicationVersion}",
+			":otherNumber", "{otherConsumerApplicationVersion}",
+			":serviceId", "{pacticipant}",
+			":version", "{version}",
 			":tag", "{tag}"))
 }
 
+//GetBrokerLinkTitle returns the title of the relation documented by the curies
+func GetBrokerLinkTitle(rel string) (string, bool) {
+	title, ok := brokerAPILinksTitles[util.StringJoin([]string{"pb", rel}, ":")]
+	return title, ok
+}
+
 //CreateBrokerHomeResponse create the templated broker home response
 func CreateBrokerHomeResponse(host string, scheme string) *BrokerHomeResponse {
 
@@ -686,3 +728,565 @@ func DeletePactData(ctx context.Context,
 	}
 	return pb.CreateResponse(pb.Response_SUCCESS, "deleting pacts Succeed."), nil
 }
+
+//GetLatestPactOfVersion returns the last pact published by the consumer version to the provider
+func GetLatestPactOfVersion(ctx context.Context, tenant string, version *Version,
+	providerParticipantId int32) (*Pact, error) {
+	key := util.StringJoin([]string{GetBrokerPactVersionKey(tenant), strconv.Itoa(int(version.Id)), ""}, "/")
+	pactVersions, err := Store().PactVersion().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	var latest *PactVersion
+	for _, kv := range pactVersions.Kvs {
+		pactVersion := &PactVersion{}
+		err = json.Unmarshal(kv.Value, pactVersion)
+		if err != nil {
+			return nil, err
+		}
+		if pactVersion.ProviderParticipantId != providerParticipantId {
+			continue
+		}
+		if latest == nil || pactVersion.Id > latest.Id {
+			latest = pactVersion
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	key = util.StringJoin([]string{GetBrokerPactKey(tenant),
+		strconv.Itoa(int(version.ParticipantId)), strconv.Itoa(int(providerParticipantId)), ""}, "/")
+	pacts, err := Store().Pact().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range pacts.Kvs {
+		pact := &Pact{}
+		err = json.Unmarshal(kv.Value, pact)
+		if err != nil {
+			return nil, err
+		}
+		if pact.Id == latest.PactId {
+			return pact, nil
+		}
+	}
+	return nil, nil
+}
+
+//GetVersionTags returns the sorted tag names of the version
+func GetVersionTags(ctx context.Context, tenant string, versionId int32) ([]string, error) {
+	key := util.StringJoin([]string{GenerateBrokerTagKey(tenant, versionId), ""}, "/")
+	resp, err := Store().PactTag().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		tag := &Tag{}
+		err = json.Unmarshal(kv.Value, tag)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag.Name)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+//GetTaggedVersionIds returns the ids of all the versions with the tag
+func GetTaggedVersionIds(ctx context.Context, tenant string, name string) (map[int32]bool, error) {
+	key := util.StringJoin([]string{GetBrokerTagKey(tenant), ""}, "/")
+	resp, err := Store().PactTag().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	versionIds := make(map[int32]bool)
+	for _, kv := range resp.Kvs {
+		tag := &Tag{}
+		err = json.Unmarshal(kv.Value, tag)
+		if err != nil {
+			return nil, err
+		}
+		if tag.Name == name {
+			versionIds[tag.VersionId] = true
+		}
+	}
+	return versionIds, nil
+}
+
+func CreateTag(ctx context.Context, tagKey string, tag Tag) error {
+	data, err := json.Marshal(tag)
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(tagKey),
+		registry.WithValue(data))
+	if err != nil {
+		return err
+	}
+	PactLogger.Infof("Tag created for key: %s", tagKey)
+	return nil
+}
+
+func DeleteTag(ctx context.Context, tagKey string) error {
+	_, err := backend.Registry().Do(ctx, registry.DEL,
+		registry.WithStrKey(tagKey))
+	if err != nil {
+		return err
+	}
+	PactLogger.Infof("Tag deleted for key: %s", tagKey)
+	return nil
+}
+
+//GetOrCreateParticipant returns the participant of the microservice,
+//the participant is created if it does not exist
+func GetOrCreateParticipant(ctx context.Context, tenant string,
+	microservice *pb.MicroService) (*Participant, error) {
+	participant, err := GetParticipant(ctx, tenant, microservice.AppId, microservice.ServiceName)
+	if err != nil || participant != nil {
+		return participant, err
+	}
+	id, err := GetData(ctx, GetBrokerLatestParticipantIDKey())
+	if err != nil {
+		return nil, err
+	}
+	participant = &Participant{Id: int32(id) + 1, AppId: microservice.AppId, ServiceName: microservice.ServiceName}
+	participantKey := GenerateBrokerParticipantKey(tenant, microservice.AppId, microservice.ServiceName)
+	_, err = CreateParticipant(PactLogger, ctx, participantKey, *participant)
+	if err != nil {
+		return nil, err
+	}
+	return participant, nil
+}
+
+//GetOrCreateVersion returns the version of the participant,
+//the version is created as the latest one if it does not exist
+func GetOrCreateVersion(ctx context.Context, tenant string, number string,
+	participantId int32) (*Version, error) {
+	version, err := GetVersion(ctx, tenant, number, participantId)
+	if err != nil || version != nil {
+		return version, err
+	}
+	order := GetLastestVersionNumberForParticipant(ctx, tenant, participantId)
+	order++
+	id, err := GetData(ctx, GetBrokerLatestVersionIDKey())
+	if err != nil {
+		return nil, err
+	}
+	version = &Version{Id: int32(id) + 1, Number: number, ParticipantId: participantId, Order: order}
+	versionKey := GenerateBrokerVersionKey(tenant, number, participantId)
+	_, err = CreateVersion(PactLogger, ctx, versionKey, *version)
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+func GetAllParticipants(ctx context.Context, tenant string) (map[int32]*Participant, error) {
+	key := util.StringJoin([]string{GetBrokerParticipantKey(tenant), ""}, "/")
+	resp, err := Store().Participant().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	participants := make(map[int32]*Participant, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		participant := &Participant{}
+		err = json.Unmarshal(kv.Value, participant)
+		if err != nil {
+			return nil, err
+		}
+		participants[participant.Id] = participant
+	}
+	return participants, nil
+}
+
+func GetAllVersions(ctx context.Context, tenant string) (map[int32]*Version, error) {
+	key := util.StringJoin([]string{GetBrokerVersionKey(tenant), ""}, "/")
+	resp, err := Store().Version().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[int32]*Version, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		version := &Version{}
+		err = json.Unmarshal(kv.Value, version)
+		if err != nil {
+			return nil, err
+		}
+		versions[version.Id] = version
+	}
+	return versions, nil
+}
+
+func GetAllPactVersions(ctx context.Context, tenant string) ([]*PactVersion, error) {
+	key := util.StringJoin([]string{GetBrokerPactVersionKey(tenant), ""}, "/")
+	resp, err := Store().PactVersion().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	pactVersions := make([]*PactVersion, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		pactVersion := &PactVersion{}
+		err = json.Unmarshal(kv.Value, pactVersion)
+		if err != nil {
+			return nil, err
+		}
+		pactVersions = append(pactVersions, pactVersion)
+	}
+	return pactVersions, nil
+}
+
+//GetAllVerifications returns the verifications grouped by the pact version id
+func GetAllVerifications(ctx context.Context, tenant string) (map[int32][]*Verification, error) {
+	key := util.StringJoin([]string{GetBrokerVerificationKey(tenant), ""}, "/")
+	resp, err := Store().Verification().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	verifications := make(map[int32][]*Verification)
+	for _, kv := range resp.Kvs {
+		verification := &Verification{}
+		err = json.Unmarshal(kv.Value, verification)
+		if err != nil {
+			return nil, err
+		}
+		verifications[verification.PactVersionId] = append(
+			verifications[verification.PactVersionId], verification)
+	}
+	return verifications, nil
+}
+
+//LatestTaggedVersions returns the latest tagged version of each participant,
+//keyed by the participant id
+func LatestTaggedVersions(versions map[int32]*Version, tagged map[int32]bool) map[int32]*Version {
+	latest := make(map[int32]*Version)
+	for id := range tagged {
+		version, ok := versions[id]
+		if !ok {
+			continue
+		}
+		if v, ok := latest[version.ParticipantId]; ok && v.Order >= version.Order {
+			continue
+		}
+		latest[version.ParticipantId] = version
+	}
+	return latest
+}
+
+//LatestVerification returns the last verification published by the provider version
+func LatestVerification(verifications []*Verification, providerVersion string) *Verification {
+	var latest *Verification
+	for _, verification := range verifications {
+		if verification.ProviderVersion != providerVersion {
+			continue
+		}
+		if latest == nil || verification.Number > latest.Number {
+			latest = verification
+		}
+	}
+	return latest
+}
+
+//GetDeploymentMatrix returns the pacts to be verified before deploying the version of
+//the participant to the environment with the tag: the pacts of the version against
+//the deployed providers, and the pacts of the deployed consumers against the version
+func GetDeploymentMatrix(ctx context.Context, tenant string, participant *Participant,
+	number string, tag string) ([]*MatrixRow, error) {
+	participants, err := GetAllParticipants(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := GetAllVersions(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	tagged, err := GetTaggedVersionIds(ctx, tenant, tag)
+	if err != nil {
+		return nil, err
+	}
+	pactVersions, err := GetAllPactVersions(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	verifications, err := GetAllVerifications(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return BuildDeploymentMatrix(participants, versions, LatestTaggedVersions(versions, tagged),
+		pactVersions, verifications, participant, number), nil
+}
+
+//BuildDeploymentMatrix only checks the last published pact between a consumer version and a provider
+func BuildDeploymentMatrix(participants map[int32]*Participant, versions map[int32]*Version,
+	deployed map[int32]*Version, pactVersions []*PactVersion, verifications map[int32][]*Verification,
+	participant *Participant, number string) []*MatrixRow {
+	type pactKey struct {
+		versionId  int32
+		providerId int32
+	}
+	lastPactVersions := make(map[pactKey]*PactVersion)
+	for _, pactVersion := range pactVersions {
+		k := pactKey{pactVersion.VersionId, pactVersion.ProviderParticipantId}
+		if pv, ok := lastPactVersions[k]; ok && pv.Id > pactVersion.Id {
+			continue
+		}
+		lastPactVersions[k] = pactVersion
+	}
+
+	matrix := make([]*MatrixRow, 0)
+	for _, pactVersion := range lastPactVersions {
+		consumerVersion, ok := versions[pactVersion.VersionId]
+		if !ok {
+			continue
+		}
+		consumer, provider := participants[consumerVersion.ParticipantId], participants[pactVersion.ProviderParticipantId]
+		if consumer == nil || provider == nil {
+			continue
+		}
+		result := &MatrixRow{
+			Consumer:        consumer,
+			ConsumerVersion: consumerVersion.Number,
+			Provider:        provider,
+			PactId:          pactVersion.PactId,
+			Status:          VERIFICATION_STATUS_UNKNOWN,
+		}
+		switch {
+		case consumer.Id == participant.Id && consumerVersion.Number == number:
+			// the version is the consumer, check the deployed provider
+			providerVersion, ok := deployed[provider.Id]
+			if !ok {
+				matrix = append(matrix, result)
+				continue
+			}
+			result.ProviderVersion = providerVersion.Number
+		case provider.Id == participant.Id:
+			// the version is the provider, check the deployed consumers
+			if v, ok := deployed[consumer.Id]; !ok || v.Id != consumerVersion.Id {
+				continue
+			}
+			result.ProviderVersion = number
+		default:
+			continue
+		}
+		if verification := LatestVerification(verifications[pactVersion.Id], result.ProviderVersion); verification != nil {
+			result.Status = VERIFICATION_STATUS_FAILED
+			if verification.Success {
+				result.Status = VERIFICATION_STATUS_SUCCESS
+			}
+			result.VerificationDate = verification.VerificationDate
+		}
+		matrix = append(matrix, result)
+	}
+	sort.Slice(matrix, func(i, j int) bool {
+		if matrix[i].Consumer.Id != matrix[j].Consumer.Id {
+			return matrix[i].Consumer.Id < matrix[j].Consumer.Id
+		}
+		return matrix[i].Provider.Id < matrix[j].Provider.Id
+	})
+	return matrix
+}
+
+func SummarizeDeploymentMatrix(matrix []*MatrixRow) *CanIDeploySummary {
+	summary := &CanIDeploySummary{}
+	for _, result := range matrix {
+		switch result.Status {
+		case VERIFICATION_STATUS_SUCCESS:
+			summary.Success++
+		case VERIFICATION_STATUS_FAILED:
+			summary.Failed++
+		default:
+			summary.Unknown++
+		}
+	}
+	summary.Deployable = summary.Failed == 0 && summary.Unknown == 0
+	switch {
+	case summary.Failed > 0:
+		summary.Reason = "One or more verifications have failed."
+	case summary.Unknown > 0:
+		summary.Reason = "Missing one or more verification results."
+	case len(matrix) == 0:
+		summary.Reason = "There are no pacts to be verified."
+	default:
+		summary.Reason = "All required verification results are published and successful."
+	}
+	return summary
+}
+
+//GetMatrix returns the verification matrix between the consumer and the provider
+func GetMatrix(ctx context.Context, tenant string, consumer *Participant,
+	provider *Participant) ([]*MatrixRow, error) {
+	versions, err := GetAllVersions(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	pactVersions, err := GetAllPactVersions(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	verifications, err := GetAllVerifications(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return BuildMatrix(consumer, provider, versions, pactVersions, verifications), nil
+}
+
+//BuildMatrix returns a row for each provider version which verified the last pact
+//published by a consumer version, the consumer versions without any verification
+//are listed with the unknown status. The newest consumer versions come first
+func BuildMatrix(consumer *Participant, provider *Participant, versions map[int32]*Version,
+	pactVersions []*PactVersion, verifications map[int32][]*Verification) []*MatrixRow {
+	lastPactVersions := make(map[int32]*PactVersion)
+	for _, pactVersion := range pactVersions {
+		if pactVersion.ProviderParticipantId != provider.Id {
+			continue
+		}
+		if version, ok := versions[pactVersion.VersionId]; !ok || version.ParticipantId != consumer.Id {
+			continue
+		}
+		if pv, ok := lastPactVersions[pactVersion.VersionId]; ok && pv.Id > pactVersion.Id {
+			continue
+		}
+		lastPactVersions[pactVersion.VersionId] = pactVersion
+	}
+
+	matrix := make([]*MatrixRow, 0, len(lastPactVersions))
+	orders := make(map[*MatrixRow]int32, len(lastPactVersions))
+	for versionId, pactVersion := range lastPactVersions {
+		consumerVersion := versions[versionId]
+		latest := make(map[string]*Verification)
+		for _, verification := range verifications[pactVersion.Id] {
+			if v, ok := latest[verification.ProviderVersion]; ok && v.Number > verification.Number {
+				continue
+			}
+			latest[verification.ProviderVersion] = verification
+		}
+		if len(latest) == 0 {
+			row := &MatrixRow{
+				Consumer:        consumer,
+				ConsumerVersion: consumerVersion.Number,
+				Provider:        provider,
+				PactId:          pactVersion.PactId,
+				Status:          VERIFICATION_STATUS_UNKNOWN,
+			}
+			orders[row] = consumerVersion.Order
+			matrix = append(matrix, row)
+			continue
+		}
+		for providerVersion, verification := range latest {
+			row := &MatrixRow{
+				Consumer:         consumer,
+				ConsumerVersion:  consumerVersion.Number,
+				Provider:         provider,
+				ProviderVersion:  providerVersion,
+				PactId:           pactVersion.PactId,
+				Status:           VERIFICATION_STATUS_FAILED,
+				VerificationDate: verification.VerificationDate,
+			}
+			if verification.Success {
+				row.Status = VERIFICATION_STATUS_SUCCESS
+			}
+			orders[row] = consumerVersion.Order
+			matrix = append(matrix, row)
+		}
+	}
+	sort.Slice(matrix, func(i, j int) bool {
+		if orders[matrix[i]] != orders[matrix[j]] {
+			return orders[matrix[i]] > orders[matrix[j]]
+		}
+		return matrix[i].ProviderVersion < matrix[j].ProviderVersion
+	})
+	return matrix
+}
+
+//GetLatestPacts returns the pact of the latest version of each consumer for every provider
+func GetLatestPacts(ctx context.Context, tenant string) ([]*LatestPact, error) {
+	participants, err := GetAllParticipants(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := GetAllVersions(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	pactVersions, err := GetAllPactVersions(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return BuildLatestPacts(participants, versions, pactVersions), nil
+}
+
+//BuildLatestPacts picks the last pact published by the latest consumer version
+//of each consumer and provider pair
+func BuildLatestPacts(participants map[int32]*Participant, versions map[int32]*Version,
+	pactVersions []*PactVersion) []*LatestPact {
+	type pairKey struct {
+		consumerId int32
+		providerId int32
+	}
+	latest := make(map[pairKey]*PactVersion)
+	for _, pactVersion := range pactVersions {
+		version, ok := versions[pactVersion.VersionId]
+		if !ok {
+			continue
+		}
+		k := pairKey{version.ParticipantId, pactVersion.ProviderParticipantId}
+		if pv, ok := latest[k]; ok {
+			v := versions[pv.VersionId]
+			if v.Order > version.Order || (v.Order == version.Order && pv.Id > pactVersion.Id) {
+				continue
+			}
+		}
+		latest[k] = pactVersion
+	}
+
+	pacts := make([]*LatestPact, 0, len(latest))
+	for k, pactVersion := range latest {
+		consumer, provider := participants[k.consumerId], participants[k.providerId]
+		if consumer == nil || provider == nil {
+			continue
+		}
+		pacts = append(pacts, &LatestPact{
+			Consumer:        consumer,
+			ConsumerVersion: versions[pactVersion.VersionId].Number,
+			Provider:        provider,
+			PactId:          pactVersion.PactId,
+		})
+	}
+	sort.Slice(pacts, func(i, j int) bool {
+		if pacts[i].Consumer.Id != pacts[j].Consumer.Id {
+			return pacts[i].Consumer.Id < pacts[j].Consumer.Id
+		}
+		return pacts[i].Provider.Id < pacts[j].Provider.Id
+	})
+	return pacts
+}
+
+//SortParticipants returns the participants ordered by id
+func SortParticipants(participants map[int32]*Participant) []*Participant {
+	sorted := make([]*Participant, 0, len(participants))
+	for _, participant := range participants {
+		sorted = append(sorted, participant)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+	return sorted
+}
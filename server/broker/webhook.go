@@ -0,0 +1,503 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/astaxie/beego"
+)
+
+const (
+	WEBHOOK_EVENT_CONTRACT_CONTENT_CHANGED = "contract_content_changed"
+	WEBHOOK_EVENT_VERIFICATION_SUCCEEDED   = "provider_verification_succeeded"
+	WEBHOOK_EVENT_VERIFICATION_FAILED      = "provider_verification_failed"
+
+	WEBHOOK_MAX_ATTEMPTS           = 4
+	WEBHOOK_REQUEST_TIMEOUT        = 30 * time.Second
+	WEBHOOK_EXECUTION_LOG_SIZE     = 20
+	WEBHOOK_MAX_RESPONSE_BODY_SIZE = 1024
+
+	// the header values are replaced by the mask in the responses, they are
+	// usually the credentials of the requested hosts
+	WEBHOOK_HEADER_MASK = "******"
+)
+
+var webhookEvents = map[string]bool{
+	WEBHOOK_EVENT_CONTRACT_CONTENT_CHANGED: true,
+	WEBHOOK_EVENT_VERIFICATION_SUCCEEDED:   true,
+	WEBHOOK_EVENT_VERIFICATION_FAILED:      true,
+}
+
+var webhookMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodPost:   true,
+	http.MethodPut:    true,
+	http.MethodPatch:  true,
+	http.MethodDelete: true,
+}
+
+// the delay before retrying a failed webhook request
+var webhookBackoff util.Backoff = &util.PowerBackoff{
+	MaxDelay:  30 * time.Second,
+	InitDelay: 1 * time.Second,
+	Factor:    2,
+}
+
+var webhookClient = &http.Client{
+	Transport: newWebhookTransport(),
+	Timeout:   WEBHOOK_REQUEST_TIMEOUT,
+}
+
+// the private networks which are forbidden besides the special addresses
+// checked by isForbiddenWebhookIP
+var forbiddenWebhookNets = parseCIDRs(
+	"10.0.0.0/8",     // RFC1918
+	"172.16.0.0/12",  // RFC1918
+	"192.168.0.0/16", // RFC1918
+	"100.64.0.0/10",  // RFC6598 shared address space
+	"fc00::/7",       // RFC4193 unique local address
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+// newWebhookTransport returns the transport refusing to connect the forbidden
+// addresses. Every dial resolves the host and checks the addresses before
+// connecting, then connects the checked address rather than the host, so the
+// host which resolves or rebinds to an internal address is refused whatever
+// the url looks like
+func newWebhookTransport() *http.Transport {
+	transport := rest.NewTransport()
+	dialer := rest.NewDialer()
+	transport.Dial = func(network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		if isWebhookHostAllowed(host) {
+			return dialer.Dial(network, addr)
+		}
+		ips, err := net.LookupIP(host)
+		if err != nil {
+			return nil, err
+		}
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("no address of webhook host '%s'", host)
+		}
+		for _, ip := range ips {
+			if isForbiddenWebhookIP(ip) {
+				return nil, fmt.Errorf("webhook host '%s' resolves to the forbidden address %s", host, ip)
+			}
+		}
+		conn, err := dialer.Dial(network, net.JoinHostPort(ips[0].String(), port))
+		if err != nil {
+			return nil, err
+		}
+		// the connected address is checked again in case of the proxy
+		if tcpAddr, ok := conn.RemoteAddr().(*net.TCPAddr); ok && isForbiddenWebhookIP(tcpAddr.IP) {
+			conn.Close()
+			return nil, fmt.Errorf("webhook host '%s' connects to the forbidden address %s", host, tcpAddr.IP)
+		}
+		return conn, nil
+	}
+	return transport
+}
+
+// isWebhookHostAllowed returns true if the host is in the broker_webhook_allowed_hosts,
+// the allowed hosts are requested whatever the addresses they resolve to
+func isWebhookHostAllowed(host string) bool {
+	for _, allowed := range strings.Split(beego.AppConfig.String("broker_webhook_allowed_hosts"), ",") {
+		if allowed = strings.TrimSpace(allowed); len(allowed) > 0 && strings.EqualFold(allowed, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// isForbiddenWebhookIP returns true if the ip is the loopback, link-local,
+// unspecified, multicast or private address, which may expose the internal
+// services
+func isForbiddenWebhookIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() {
+		return true
+	}
+	for _, ipNet := range forbiddenWebhookNets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactWebhook returns the copy of the webhook whose header values are
+// masked, the stored headers are only used to fire the webhook
+func RedactWebhook(webhook *Webhook) *Webhook {
+	if webhook == nil || webhook.Request == nil || len(webhook.Request.Headers) == 0 {
+		return webhook
+	}
+	redacted, request := *webhook, *webhook.Request
+	request.Headers = make(map[string]string, len(webhook.Request.Headers))
+	for k := range webhook.Request.Headers {
+		request.Headers[k] = WEBHOOK_HEADER_MASK
+	}
+	redacted.Request = &request
+	return &redacted
+}
+
+// restoreWebhookHeaders keeps the stored values of the headers which are
+// updated with the mask, the client puts back the redacted webhook it read
+func restoreWebhookHeaders(request *WebhookRequest, old *WebhookRequest) {
+	if request == nil || old == nil {
+		return
+	}
+	for k, v := range request.Headers {
+		if stored, ok := old.Headers[k]; ok && v == WEBHOOK_HEADER_MASK {
+			request.Headers[k] = stored
+		}
+	}
+}
+
+// WebhookEvent describes what triggers the webhooks, the fields are available
+// in the request templates as the ${pactbroker.xxx} parameters
+type WebhookEvent struct {
+	Name                  string
+	ConsumerParticipantId int32
+	ConsumerVersion       string
+	ProviderParticipantId int32
+	ProviderVersion       string
+	PactUrl               string
+}
+
+func (e *WebhookEvent) Match(webhook *Webhook) bool {
+	if webhook.ConsumerParticipantId != 0 && webhook.ConsumerParticipantId != e.ConsumerParticipantId {
+		return false
+	}
+	if webhook.ProviderParticipantId != 0 && webhook.ProviderParticipantId != e.ProviderParticipantId {
+		return false
+	}
+	for _, name := range webhook.Events {
+		if name == e.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *WebhookEvent) Replacer(consumer, provider *Participant) *strings.Replacer {
+	return strings.NewReplacer(
+		"${pactbroker.eventName}", e.Name,
+		"${pactbroker.consumerName}", consumer.GetServiceName(),
+		"${pactbroker.consumerVersionNumber}", e.ConsumerVersion,
+		"${pactbroker.providerName}", provider.GetServiceName(),
+		"${pactbroker.providerVersionNumber}", e.ProviderVersion,
+		"${pactbroker.pactUrl}", e.PactUrl,
+	)
+}
+
+// RenderWebhookRequest replaces the template parameters in the url, headers and body
+func RenderWebhookRequest(request *WebhookRequest, replacer *strings.Replacer) *WebhookRequest {
+	rendered := &WebhookRequest{
+		Method: request.Method,
+		Url:    replacer.Replace(request.Url),
+		Body:   replacer.Replace(request.Body),
+	}
+	if len(request.Headers) > 0 {
+		rendered.Headers = make(map[string]string, len(request.Headers))
+		for k, v := range request.Headers {
+			rendered.Headers[k] = replacer.Replace(v)
+		}
+	}
+	return rendered
+}
+
+func ValidateWebhook(in *PutWebhookRequest) error {
+	if len(in.Events) == 0 {
+		return errors.New("events are required")
+	}
+	for _, name := range in.Events {
+		if !webhookEvents[name] {
+			return fmt.Errorf("unknown event '%s'", name)
+		}
+	}
+	if in.Request == nil {
+		return errors.New("request is required")
+	}
+	in.Request.Method = strings.ToUpper(in.Request.Method)
+	if !webhookMethods[in.Request.Method] {
+		return fmt.Errorf("unsupported method '%s'", in.Request.Method)
+	}
+	u, err := url.Parse(in.Request.Url)
+	if err != nil {
+		return fmt.Errorf("invalid url '%s'", in.Request.Url)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("invalid url '%s'", in.Request.Url)
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil && isForbiddenWebhookIP(ip) && !isWebhookHostAllowed(host) {
+		return fmt.Errorf("forbidden webhook host '%s'", host)
+	}
+	for k := range in.Request.Headers {
+		if len(k) == 0 {
+			return errors.New("empty header name")
+		}
+	}
+	return nil
+}
+
+// FireWebhooks executes the webhooks matching the event asynchronously
+func FireWebhooks(ctx context.Context, tenant string, event *WebhookEvent) {
+	webhooks, err := GetAllWebhooks(ctx, tenant)
+	if err != nil {
+		PactLogger.Errorf(err, "fire webhooks failed, event is %s: webhooks cannot be searched.", event.Name)
+		return
+	}
+	var participants map[int32]*Participant
+	for _, webhook := range webhooks {
+		if webhook.Request == nil || !event.Match(webhook) {
+			continue
+		}
+		if participants == nil {
+			participants, err = GetAllParticipants(ctx, tenant)
+			if err != nil {
+				PactLogger.Errorf(err, "fire webhooks failed, event is %s: participants cannot be searched.", event.Name)
+				return
+			}
+		}
+		webhookId, eventName := webhook.Id, event.Name
+		request := RenderWebhookRequest(webhook.Request, event.Replacer(
+			participants[event.ConsumerParticipantId], participants[event.ProviderParticipantId]))
+		util.Go(func(ctx context.Context) {
+			execution := ExecuteWebhook(ctx, request)
+			execution.WebhookId = webhookId
+			execution.Event = eventName
+			if err := SaveWebhookExecution(ctx, tenant, execution); err != nil {
+				PactLogger.Errorf(err, "save the execution of webhook %d failed.", webhookId)
+			}
+		})
+	}
+}
+
+// ExecuteWebhook sends the request, and retries until the response status is 2xx
+// or reaches the max attempts
+func ExecuteWebhook(ctx context.Context, request *WebhookRequest) *WebhookExecution {
+	execution := &WebhookExecution{
+		Id:  fmt.Sprintf("%020d", time.Now().UnixNano()),
+		Url: request.Url,
+	}
+	for execution.Attempts < WEBHOOK_MAX_ATTEMPTS {
+		if execution.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				execution.Error = ctx.Err().Error()
+				execution.ExecutedAt = time.Now().Format(time.RFC3339)
+				return execution
+			case <-time.After(webhookBackoff.Delay(int(execution.Attempts) - 1)):
+			}
+		}
+		execution.Attempts++
+		statusCode, body, err := doWebhookRequest(request)
+		execution.StatusCode = int32(statusCode)
+		execution.ResponseBody = body
+		execution.Error = ""
+		if err != nil {
+			execution.Error = err.Error()
+			PactLogger.Warnf(err, "webhook request %s %s failed, attempts: %d",
+				request.Method, request.Url, execution.Attempts)
+			continue
+		}
+		if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+			execution.Success = true
+			break
+		}
+		PactLogger.Warnf(nil, "webhook request %s %s responded %d, attempts: %d",
+			request.Method, request.Url, statusCode, execution.Attempts)
+	}
+	execution.ExecutedAt = time.Now().Format(time.RFC3339)
+	return execution
+}
+
+func doWebhookRequest(request *WebhookRequest) (int, string, error) {
+	var body io.Reader
+	if len(request.Body) > 0 {
+		body = strings.NewReader(request.Body)
+	}
+	req, err := http.NewRequest(request.Method, request.Url, body)
+	if err != nil {
+		return 0, "", err
+	}
+	for k, v := range request.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, WEBHOOK_MAX_RESPONSE_BODY_SIZE))
+	if err != nil {
+		return resp.StatusCode, "", err
+	}
+	return resp.StatusCode, string(respBody), nil
+}
+
+func GetWebhook(ctx context.Context, tenant string, webhookId int32) (*Webhook, error) {
+	resp, err := Store().Webhook().Search(ctx,
+		registry.WithStrKey(GenerateBrokerWebhookKey(tenant, webhookId)))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	webhook := &Webhook{}
+	err = json.Unmarshal(resp.Kvs[0].Value, webhook)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func GetAllWebhooks(ctx context.Context, tenant string) ([]*Webhook, error) {
+	key := util.StringJoin([]string{GetBrokerWebhookKey(tenant), ""}, "/")
+	resp, err := Store().Webhook().Search(ctx,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	webhooks := make([]*Webhook, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		webhook := &Webhook{}
+		err = json.Unmarshal(kv.Value, webhook)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, nil
+}
+
+func SaveWebhook(ctx context.Context, tenant string, webhook *Webhook) error {
+	data, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(GenerateBrokerWebhookKey(tenant, webhook.Id)),
+		registry.WithValue(data))
+	if err != nil {
+		return err
+	}
+	return StoreData(ctx, GetBrokerLatestWebhookIDKey(), strconv.Itoa(int(webhook.Id)))
+}
+
+// DeleteWebhook deletes the webhook and its execution logs
+func DeleteWebhook(ctx context.Context, tenant string, webhookId int32) error {
+	_, err := backend.Registry().Do(ctx, registry.DEL,
+		registry.WithStrKey(GenerateBrokerWebhookKey(tenant, webhookId)))
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.DEL,
+		registry.WithStrKey(GenerateBrokerWebhookExecutionKey(tenant, webhookId, "")),
+		registry.WithPrefix())
+	return err
+}
+
+// GetWebhookExecutions returns the execution logs of the webhook, the latest first
+func GetWebhookExecutions(ctx context.Context, tenant string, webhookId int32) ([]*WebhookExecution, error) {
+	resp, err := Store().WebhookExecution().Search(ctx,
+		registry.WithStrKey(GenerateBrokerWebhookExecutionKey(tenant, webhookId, "")),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*WebhookExecution, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		execution := &WebhookExecution{}
+		err = json.Unmarshal(kv.Value, execution)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].Id > executions[j].Id
+	})
+	return executions, nil
+}
+
+// SaveWebhookExecution saves the execution log, only the latest
+// WEBHOOK_EXECUTION_LOG_SIZE logs of each webhook are kept
+func SaveWebhookExecution(ctx context.Context, tenant string, execution *WebhookExecution) error {
+	data, err := json.Marshal(execution)
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(GenerateBrokerWebhookExecutionKey(tenant, execution.WebhookId, execution.Id)),
+		registry.WithValue(data))
+	if err != nil {
+		return err
+	}
+	PactLogger.Infof("webhook %d executed, event: %s, success: %t, attempts: %d",
+		execution.WebhookId, execution.Event, execution.Success, execution.Attempts)
+
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(GenerateBrokerWebhookExecutionKey(tenant, execution.WebhookId, "")),
+		registry.WithPrefix(),
+		registry.WithKeyOnly(),
+		registry.WithAscendOrder())
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(resp.Kvs)-WEBHOOK_EXECUTION_LOG_SIZE; i++ {
+		_, err = backend.Registry().Do(ctx, registry.DEL, registry.WithKey(resp.Kvs[i].Key))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -47,7 +47,7 @@ func New() (registry.Registry, error) {
 		return nil, err
 	case <-instance.Ready():
 	}
-	return instance, nil
+	return NewEncryptedRegistry(instance), nil
 }
 
 func Registry() registry.Registry {
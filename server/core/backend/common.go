@@ -48,10 +48,11 @@ const (
 	DEPENDENCY
 	DEPENDENCY_RULE
 	DEPENDENCY_QUEUE
-	SCHEMA // big data should not be stored in memory.
+	SCHEMA // the schema keys refer to the contents stored by address, so they are small enough to cache.
 	SCHEMA_SUMMARY
 	INSTANCE
 	LEASE
+	QUOTA
 	typeEnd // end of the base store types
 )
 
@@ -71,6 +72,7 @@ var TypeNames = []string{
 	SCHEMA_SUMMARY:   "SCHEMA_SUMMARY",
 	INSTANCE:         "INSTANCE",
 	LEASE:            "LEASE",
+	QUOTA:            "QUOTA",
 	typeEnd:          "TYPEEND",
 }
 
@@ -90,13 +92,14 @@ var TypeRoots = map[StoreType]string{
 	DEPENDENCY_RULE:  apt.GetServiceDependencyRuleRootKey(""),
 	DEPENDENCY_QUEUE: apt.GetServiceDependencyQueueRootKey(""),
 	PROJECT:          apt.GetProjectRootKey(""),
+	QUOTA:            apt.GetQuotaRootKey() + "/",
 }
 
 var TypeInitSize = map[StoreType]int{
 	SERVICE:          500,
 	INSTANCE:         1000,
 	DOMAIN:           100,
-	SCHEMA:           0,
+	SCHEMA:           100,
 	SCHEMA_SUMMARY:   100,
 	RULE:             100,
 	LEASE:            1000,
@@ -108,6 +111,7 @@ var TypeInitSize = map[StoreType]int{
 	DEPENDENCY_RULE:  100,
 	DEPENDENCY_QUEUE: 100,
 	PROJECT:          100,
+	QUOTA:            100,
 }
 
 const (
@@ -0,0 +1,256 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package backend
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/security"
+	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"golang.org/x/net/context"
+)
+
+// EncryptedRegistry encrypts the fields selected by the policy before the
+// values are put into the backend, and decrypts them in the responses and
+// the watch events, so the caches and the services only see plain text
+type EncryptedRegistry struct {
+	registry.Registry
+	Encrypter *FieldEncrypter
+}
+
+// encryptPolicy is the policy of the encryption at rest, nil if disabled
+var encryptPolicy *EncryptPolicy
+
+// EncryptPolicyInUse returns the policy of the encryption at rest, nil if disabled
+func EncryptPolicyInUse() *EncryptPolicy {
+	return encryptPolicy
+}
+
+// the cipher plugin is looked up at each call, it can be reloaded
+type pluginCipher struct{}
+
+func (c pluginCipher) Encrypt(src string) (string, error) {
+	return plugin.Plugins().Cipher().Encrypt(src)
+}
+
+func (c pluginCipher) Decrypt(src string) (string, error) {
+	return plugin.Plugins().Cipher().Decrypt(src)
+}
+
+func (c pluginCipher) IsStale(src string) bool {
+	if rc, ok := plugin.Plugins().Cipher().(security.RotatableCipher); ok {
+		return rc.IsStale(src)
+	}
+	return false
+}
+
+// NewEncryptedRegistry returns the registry encrypting the values by the
+// configured policy, or the registry itself if the encryption is disabled
+func NewEncryptedRegistry(r registry.Registry) registry.Registry {
+	cfg := apt.ServerInfo.Config
+	if !cfg.EncryptEnabled {
+		return r
+	}
+	policy, err := ParseEncryptPolicy(cfg.EncryptFields, cfg.EncryptKeyVersion)
+	if err != nil {
+		// never store the secrets in plain text by mistake
+		util.Logger().Fatalf(err, "invalid encryption config")
+	}
+	util.Logger().Infof("enabled the encryption at rest, fields: %s, key version: %s",
+		cfg.EncryptFields, policy.KeyVersion)
+	encryptPolicy = policy
+	return &EncryptedRegistry{
+		Registry:  r,
+		Encrypter: &FieldEncrypter{Policy: policy, Cipher: pluginCipher{}},
+	}
+}
+
+func (r *EncryptedRegistry) encryptOps(ops []registry.PluginOp) ([]registry.PluginOp, error) {
+	if len(ops) == 0 {
+		return ops, nil
+	}
+	encrypted := make([]registry.PluginOp, len(ops))
+	for i, op := range ops {
+		if op.Action == registry.Put {
+			value, err := r.Encrypter.Encrypt(op.Key, op.Value)
+			if err != nil {
+				util.Logger().Errorf(err, "encrypt value of key %s failed", op.Key)
+				return nil, err
+			}
+			op.Value = value
+		}
+		encrypted[i] = op
+	}
+	return encrypted, nil
+}
+
+func (r *EncryptedRegistry) decryptResponse(resp *registry.PluginResponse) {
+	if resp == nil {
+		return
+	}
+	for _, kv := range resp.Kvs {
+		value, err := r.Encrypter.Decrypt(kv.Key, kv.Value)
+		if err != nil {
+			// keep the value as it is, the key is still listed
+			util.Logger().Errorf(err, "decrypt value of key %s failed", kv.Key)
+			continue
+		}
+		kv.Value = value
+	}
+}
+
+func (r *EncryptedRegistry) PutNoOverride(ctx context.Context, opts ...registry.PluginOpOption) (bool, error) {
+	op := registry.OptionsToOp(opts...)
+	value, err := r.Encrypter.Encrypt(op.Key, op.Value)
+	if err != nil {
+		util.Logger().Errorf(err, "encrypt value of key %s failed", op.Key)
+		return false, err
+	}
+	return r.Registry.PutNoOverride(ctx, append(opts, registry.WithValue(value))...)
+}
+
+func (r *EncryptedRegistry) Do(ctx context.Context, opts ...registry.PluginOpOption) (*registry.PluginResponse, error) {
+	op := registry.OptionsToOp(opts...)
+	if op.Action == registry.Put {
+		value, err := r.Encrypter.Encrypt(op.Key, op.Value)
+		if err != nil {
+			util.Logger().Errorf(err, "encrypt value of key %s failed", op.Key)
+			return nil, err
+		}
+		opts = append(opts, registry.WithValue(value))
+	}
+	resp, err := r.Registry.Do(ctx, opts...)
+	if err == nil {
+		r.decryptResponse(resp)
+	}
+	return resp, err
+}
+
+func (r *EncryptedRegistry) Txn(ctx context.Context, ops []registry.PluginOp) (*registry.PluginResponse, error) {
+	ops, err := r.encryptOps(ops)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.Registry.Txn(ctx, ops)
+	if err == nil {
+		r.decryptResponse(resp)
+	}
+	return resp, err
+}
+
+// TxnWithCmp can not compare the values of the encrypted fields, as the
+// cipher text is different every time
+func (r *EncryptedRegistry) TxnWithCmp(ctx context.Context, success []registry.PluginOp,
+	cmp []registry.CompareOp, fail []registry.PluginOp) (*registry.PluginResponse, error) {
+	success, err := r.encryptOps(success)
+	if err != nil {
+		return nil, err
+	}
+	fail, err = r.encryptOps(fail)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.Registry.TxnWithCmp(ctx, success, cmp, fail)
+	if err == nil {
+		r.decryptResponse(resp)
+	}
+	return resp, err
+}
+
+func (r *EncryptedRegistry) Watch(ctx context.Context, opts ...registry.PluginOpOption) error {
+	op := registry.OptionsToOp(opts...)
+	if op.WatchCallback != nil {
+		cb := op.WatchCallback
+		opts = append(opts, registry.WithWatchCallback(func(message string, evt *registry.PluginResponse) error {
+			r.decryptResponse(evt)
+			return cb(message, evt)
+		}))
+	}
+	return r.Registry.Watch(ctx, opts...)
+}
+
+// ReencryptReport is the result of the re-encryption of the backend
+type ReencryptReport struct {
+	Checked     int
+	Reencrypted int
+	Failed      int
+}
+
+func reencryptRootKeys() []string {
+	return []string{
+		apt.GetServiceRootKey(""),
+		apt.GetInstanceRootKey(""),
+		apt.GetSchemaContentRootKey(""),
+		apt.GetServiceSchemaRootKey(""),
+		apt.GetServiceSchemaRevisionRootKey(""),
+	}
+}
+
+// Reencrypt rewrites the stored values which are not encrypted as the
+// current policy, e.g. encrypted by the old key version or the old key of
+// the rotatable cipher, or stored before the fields are selected. The value
+// modified during the job is skipped, as it is encrypted by the current
+// policy when put
+func (r *EncryptedRegistry) Reencrypt(ctx context.Context) (*ReencryptReport, error) {
+	report := &ReencryptReport{}
+	for _, root := range reencryptRootKeys() {
+		resp, err := r.Registry.Do(ctx, registry.GET, registry.WithStrKey(root), registry.WithPrefix())
+		if err != nil {
+			return report, err
+		}
+		for _, kv := range resp.Kvs {
+			report.Checked++
+			value, changed, err := r.Encrypter.Reencrypt(kv.Key, kv.Value)
+			if err != nil {
+				util.Logger().Errorf(err, "re-encrypt value of key %s failed", kv.Key)
+				report.Failed++
+				continue
+			}
+			if !changed {
+				continue
+			}
+			put := []registry.PluginOpOption{registry.WithKey(kv.Key), registry.WithValue(value)}
+			if kv.Lease > 0 {
+				put = append(put, registry.WithLease(kv.Lease))
+			}
+			key := util.BytesToStringWithNoCopy(kv.Key)
+			resp, err := r.Registry.TxnWithCmp(ctx,
+				[]registry.PluginOp{registry.OpPut(put...)},
+				[]registry.CompareOp{registry.OpCmp(registry.CmpStrModRev(key), registry.CMP_EQUAL, kv.ModRevision)},
+				nil)
+			if err != nil {
+				util.Logger().Errorf(err, "re-encrypt value of key %s failed", kv.Key)
+				report.Failed++
+				continue
+			}
+			if resp.Succeeded {
+				report.Reencrypted++
+			}
+		}
+	}
+	return report, nil
+}
+
+// Reencrypt re-encrypts the values in backend if the encryption is enabled
+func Reencrypt(ctx context.Context) (*ReencryptReport, error) {
+	r, ok := Registry().(*EncryptedRegistry)
+	if !ok {
+		return &ReencryptReport{}, nil
+	}
+	return r.Reencrypt(ctx)
+}
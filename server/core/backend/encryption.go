@@ -0,0 +1,340 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/security"
+	"path"
+	"strings"
+)
+
+const (
+	ENCRYPT_STORE_SERVICE  = "service"
+	ENCRYPT_STORE_INSTANCE = "instance"
+	ENCRYPT_STORE_SCHEMA   = "schema"
+
+	// the encrypted value is stored as '{cipher:<key version>}<cipher text>'
+	ENCRYPTED_VALUE_PREFIX = "{cipher:"
+	ENCRYPTED_VALUE_SUFFIX = "}"
+
+	DEFAULT_ENCRYPT_KEY_VERSION = "1"
+
+	// REDACTED_VALUE replaces the selected values shown out of the registry, e.g. in the audit log
+	REDACTED_VALUE = "******"
+)
+
+var encryptedValuePrefix = []byte(ENCRYPTED_VALUE_PREFIX)
+
+// EncryptPolicy decides which stored values are encrypted
+type EncryptPolicy struct {
+	// the key version marked in the new encrypted values, the values marked
+	// with other versions are re-encrypted by the background job
+	KeyVersion string
+	// the property key patterns of services and instances
+	Properties map[string][]string
+	// encrypt the schema contents or not
+	Schema bool
+}
+
+// ParseEncryptPolicy parses the comma separated rules in format
+// '<store>[:<pattern>]', the store is one of service, instance and schema,
+// and the pattern matches the property keys of services or instances in
+// shell glob, all the properties are encrypted if the pattern is omitted.
+// The schema contents are always encrypted as a whole
+func ParseEncryptPolicy(rules, keyVersion string) (*EncryptPolicy, error) {
+	if len(keyVersion) == 0 {
+		keyVersion = DEFAULT_ENCRYPT_KEY_VERSION
+	}
+	if strings.ContainsAny(keyVersion, ENCRYPTED_VALUE_SUFFIX+"\"\\") {
+		return nil, fmt.Errorf("invalid encrypt key version %s", keyVersion)
+	}
+	policy := &EncryptPolicy{
+		KeyVersion: keyVersion,
+		Properties: make(map[string][]string),
+	}
+	for _, rule := range strings.Split(rules, ",") {
+		rule = strings.TrimSpace(rule)
+		if len(rule) == 0 {
+			continue
+		}
+		store, pattern := rule, ""
+		if i := strings.Index(rule, ":"); i >= 0 {
+			store, pattern = strings.TrimSpace(rule[:i]), strings.TrimSpace(rule[i+1:])
+		}
+		switch store {
+		case ENCRYPT_STORE_SERVICE, ENCRYPT_STORE_INSTANCE:
+			if len(pattern) == 0 {
+				pattern = "*"
+			}
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid encrypt rule %s: %s", rule, err.Error())
+			}
+			policy.Properties[store] = append(policy.Properties[store], pattern)
+		case ENCRYPT_STORE_SCHEMA:
+			if len(pattern) > 0 && pattern != "*" {
+				return nil, fmt.Errorf("invalid encrypt rule %s: schema content can not be matched by pattern", rule)
+			}
+			policy.Schema = true
+		default:
+			return nil, fmt.Errorf("invalid encrypt rule %s: unknown store %s", rule, store)
+		}
+	}
+	return policy, nil
+}
+
+// MatchProperty returns true if the property of the service or instance
+// should be encrypted
+func (p *EncryptPolicy) MatchProperty(store, key string) bool {
+	for _, pattern := range p.Properties[store] {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactProperties returns a copy of the properties of the service or
+// instance with the values selected by the policy redacted, or the
+// properties themselves if the policy is nil or selects none of them
+func (p *EncryptPolicy) RedactProperties(store string, properties map[string]string) map[string]string {
+	if p == nil || len(p.Properties[store]) == 0 {
+		return properties
+	}
+	var redacted map[string]string
+	for k := range properties {
+		if !p.MatchProperty(store, k) {
+			continue
+		}
+		if redacted == nil {
+			redacted = make(map[string]string, len(properties))
+			for k, v := range properties {
+				redacted[k] = v
+			}
+		}
+		redacted[k] = REDACTED_VALUE
+	}
+	if redacted == nil {
+		return properties
+	}
+	return redacted
+}
+
+// IsEncryptedValue returns true if the value is in encrypted format
+func IsEncryptedValue(value string) bool {
+	_, _, ok := splitEncryptedValue(value)
+	return ok
+}
+
+func splitEncryptedValue(value string) (version, cipherText string, ok bool) {
+	if !strings.HasPrefix(value, ENCRYPTED_VALUE_PREFIX) {
+		return
+	}
+	value = value[len(ENCRYPTED_VALUE_PREFIX):]
+	i := strings.Index(value, ENCRYPTED_VALUE_SUFFIX)
+	if i < 0 {
+		return
+	}
+	return value[:i], value[i+len(ENCRYPTED_VALUE_SUFFIX):], true
+}
+
+// FieldEncrypter encrypts and decrypts the fields of the stored values
+// selected by the policy
+type FieldEncrypter struct {
+	Policy *EncryptPolicy
+	Cipher security.Cipher
+}
+
+type fieldFunc func(value string, matched bool) (string, bool, error)
+
+// Encrypt returns the value to store, the matched fields in plain text are
+// encrypted with the current key version
+func (e *FieldEncrypter) Encrypt(key, value []byte) ([]byte, error) {
+	v, _, err := e.transform(key, value, e.encryptField)
+	return v, err
+}
+
+// Decrypt returns the value with all the encrypted fields in plain text
+func (e *FieldEncrypter) Decrypt(key, value []byte) ([]byte, error) {
+	if !bytes.Contains(value, encryptedValuePrefix) {
+		return value, nil
+	}
+	v, _, err := e.transform(key, value, func(value string, _ bool) (string, bool, error) {
+		return e.decryptField(value)
+	})
+	return v, err
+}
+
+// Reencrypt returns the value with the fields encrypted as the current
+// policy, and whether the value is changed. The matched fields in plain
+// text, encrypted with other key versions or not by the primary key of the
+// rotatable cipher are encrypted again, and the encrypted fields no longer
+// matched are decrypted
+func (e *FieldEncrypter) Reencrypt(key, value []byte) ([]byte, bool, error) {
+	return e.transform(key, value, func(value string, matched bool) (string, bool, error) {
+		version, cipherText, ok := splitEncryptedValue(value)
+		switch {
+		case ok && matched && version == e.Policy.KeyVersion && !e.isStale(cipherText):
+			return value, false, nil
+		case !ok && !matched:
+			return value, false, nil
+		}
+		plain, _, err := e.decryptField(value)
+		if err != nil || !matched {
+			return plain, ok, err
+		}
+		return e.encryptField(plain, true)
+	})
+}
+
+func (e *FieldEncrypter) isStale(cipherText string) bool {
+	rc, ok := e.Cipher.(security.RotatableCipher)
+	return ok && rc.IsStale(cipherText)
+}
+
+func (e *FieldEncrypter) encryptField(value string, matched bool) (string, bool, error) {
+	if !matched || len(value) == 0 || IsEncryptedValue(value) {
+		return value, false, nil
+	}
+	cipherText, err := e.Cipher.Encrypt(value)
+	if err != nil {
+		return "", false, err
+	}
+	return ENCRYPTED_VALUE_PREFIX + e.Policy.KeyVersion + ENCRYPTED_VALUE_SUFFIX + cipherText, true, nil
+}
+
+func (e *FieldEncrypter) decryptField(value string) (string, bool, error) {
+	_, cipherText, ok := splitEncryptedValue(value)
+	if !ok {
+		return value, false, nil
+	}
+	plain, err := e.Cipher.Decrypt(cipherText)
+	if err != nil {
+		return "", false, err
+	}
+	return plain, true, nil
+}
+
+// transform applies f to the fields of the value by the store type of the key
+func (e *FieldEncrypter) transform(key, value []byte, f fieldFunc) ([]byte, bool, error) {
+	if len(value) == 0 {
+		return value, false, nil
+	}
+	k := util.BytesToStringWithNoCopy(key)
+	switch {
+	case strings.HasPrefix(k, apt.GetServiceRootKey("")):
+		return e.transformProperties(ENCRYPT_STORE_SERVICE, value, f)
+	case strings.HasPrefix(k, apt.GetInstanceRootKey("")):
+		return e.transformProperties(ENCRYPT_STORE_INSTANCE, value, f)
+	case strings.HasPrefix(k, apt.GetSchemaContentRootKey("")):
+		return e.transformContent(value, f)
+	case strings.HasPrefix(k, apt.GetServiceSchemaRootKey("")):
+		// the schema key refers to the content by address since the
+		// content addressed storage, or stores the content by old versions
+		if bytes.HasPrefix(value, []byte("sha256:")) {
+			return value, false, nil
+		}
+		return e.transformContent(value, f)
+	case strings.HasPrefix(k, apt.GetServiceSchemaRevisionRootKey("")):
+		return e.transformJSONField("schema", value, f)
+	}
+	return value, false, nil
+}
+
+func (e *FieldEncrypter) transformContent(value []byte, f fieldFunc) ([]byte, bool, error) {
+	v, changed, err := f(util.BytesToStringWithNoCopy(value), e.Policy.Schema)
+	if err != nil || !changed {
+		return value, false, err
+	}
+	return util.StringToBytesWithNoCopy(v), true, nil
+}
+
+func (e *FieldEncrypter) transformJSONField(field string, value []byte, f fieldFunc) ([]byte, bool, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(value, &obj); err != nil {
+		return value, false, err
+	}
+	raw, ok := obj[field]
+	if !ok {
+		return value, false, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return value, false, err
+	}
+	if strings.HasPrefix(s, "sha256:") {
+		// the revision refers to the content by address
+		return value, false, nil
+	}
+	v, changed, err := f(s, e.Policy.Schema)
+	if err != nil || !changed {
+		return value, false, err
+	}
+	if obj[field], err = json.Marshal(v); err != nil {
+		return value, false, err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return value, false, err
+	}
+	return data, true, nil
+}
+
+func (e *FieldEncrypter) transformProperties(store string, value []byte, f fieldFunc) ([]byte, bool, error) {
+	if len(e.Policy.Properties[store]) == 0 && !bytes.Contains(value, encryptedValuePrefix) {
+		return value, false, nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(value, &obj); err != nil {
+		return value, false, err
+	}
+	raw, ok := obj["properties"]
+	if !ok {
+		return value, false, nil
+	}
+	var properties map[string]string
+	if err := json.Unmarshal(raw, &properties); err != nil {
+		return value, false, err
+	}
+	changed := false
+	for k, v := range properties {
+		nv, c, err := f(v, e.Policy.MatchProperty(store, k))
+		if err != nil {
+			return value, false, fmt.Errorf("property %s: %s", k, err.Error())
+		}
+		if c {
+			properties[k] = nv
+			changed = true
+		}
+	}
+	if !changed {
+		return value, false, nil
+	}
+	var err error
+	if obj["properties"], err = json.Marshal(properties); err != nil {
+		return value, false, err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return value, false, err
+	}
+	return data, true, nil
+}
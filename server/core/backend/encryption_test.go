@@ -0,0 +1,247 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/cipher"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"strings"
+	"testing"
+)
+
+type mockCipher struct{}
+
+func (c mockCipher) Encrypt(src string) (string, error) {
+	return "x" + src, nil
+}
+
+func (c mockCipher) Decrypt(src string) (string, error) {
+	if !strings.HasPrefix(src, "x") {
+		return "", errors.New("bad cipher text")
+	}
+	return src[1:], nil
+}
+
+func newMockEncrypter(t *testing.T, rules, version string) *FieldEncrypter {
+	policy, err := ParseEncryptPolicy(rules, version)
+	if err != nil {
+		t.Fatalf("ParseEncryptPolicy %s failed, %s", rules, err)
+	}
+	return &FieldEncrypter{Policy: policy, Cipher: mockCipher{}}
+}
+
+func TestParseEncryptPolicy(t *testing.T) {
+	policy, err := ParseEncryptPolicy("service:pass*, instance ,schema", "")
+	if err != nil {
+		t.Fatalf("TestParseEncryptPolicy failed, %s", err)
+	}
+	if policy.KeyVersion != DEFAULT_ENCRYPT_KEY_VERSION || !policy.Schema {
+		t.Fatalf("TestParseEncryptPolicy failed, %v", policy)
+	}
+	if !policy.MatchProperty(ENCRYPT_STORE_SERVICE, "password") ||
+		policy.MatchProperty(ENCRYPT_STORE_SERVICE, "user") ||
+		!policy.MatchProperty(ENCRYPT_STORE_INSTANCE, "user") {
+		t.Fatalf("TestParseEncryptPolicy match property failed, %v", policy.Properties)
+	}
+
+	for _, rules := range []string{"rule", "service:[", "schema:a*"} {
+		if _, err := ParseEncryptPolicy(rules, "1"); err == nil {
+			t.Fatalf("TestParseEncryptPolicy %s should fail", rules)
+		}
+	}
+	if _, err := ParseEncryptPolicy("schema", "1}"); err == nil {
+		t.Fatalf("TestParseEncryptPolicy invalid version should fail")
+	}
+}
+
+func TestEncryptPolicy_RedactProperties(t *testing.T) {
+	properties := map[string]string{"password": "secret", "user": "admin"}
+	var nilPolicy *EncryptPolicy
+	if redacted := nilPolicy.RedactProperties(ENCRYPT_STORE_SERVICE, properties); redacted["password"] != "secret" {
+		t.Fatalf("TestEncryptPolicy_RedactProperties nil policy failed, %v", redacted)
+	}
+
+	policy, _ := ParseEncryptPolicy("service:pass*", "")
+	redacted := policy.RedactProperties(ENCRYPT_STORE_SERVICE, properties)
+	if redacted["password"] != REDACTED_VALUE || redacted["user"] != "admin" {
+		t.Fatalf("TestEncryptPolicy_RedactProperties failed, %v", redacted)
+	}
+	if properties["password"] != "secret" {
+		t.Fatalf("TestEncryptPolicy_RedactProperties changed the properties, %v", properties)
+	}
+	if redacted := policy.RedactProperties(ENCRYPT_STORE_INSTANCE, properties); redacted["password"] != "secret" {
+		t.Fatalf("TestEncryptPolicy_RedactProperties instance failed, %v", redacted)
+	}
+}
+
+func TestFieldEncrypter_Properties(t *testing.T) {
+	e := newMockEncrypter(t, "service:password", "1")
+	key := []byte(apt.GenerateServiceKey("default/default", "1"))
+	data, _ := json.Marshal(&pb.MicroService{
+		ServiceId:  "1",
+		Properties: map[string]string{"password": "a", "user": "b"},
+	})
+
+	encrypted, err := e.Encrypt(key, data)
+	if err != nil {
+		t.Fatalf("TestFieldEncrypter_Properties encrypt failed, %s", err)
+	}
+	service := &pb.MicroService{}
+	json.Unmarshal(encrypted, service)
+	if service.ServiceId != "1" || service.Properties["password"] != "{cipher:1}xa" ||
+		service.Properties["user"] != "b" {
+		t.Fatalf("TestFieldEncrypter_Properties encrypt failed, %v", service)
+	}
+
+	// put again the value already encrypted
+	again, _ := e.Encrypt(key, encrypted)
+	if string(again) != string(encrypted) {
+		t.Fatalf("TestFieldEncrypter_Properties encrypt twice failed, %s", again)
+	}
+
+	decrypted, err := e.Decrypt(key, encrypted)
+	if err != nil {
+		t.Fatalf("TestFieldEncrypter_Properties decrypt failed, %s", err)
+	}
+	service = &pb.MicroService{}
+	json.Unmarshal(decrypted, service)
+	if service.Properties["password"] != "a" || service.Properties["user"] != "b" {
+		t.Fatalf("TestFieldEncrypter_Properties decrypt failed, %v", service)
+	}
+
+	// the instance is not selected
+	key = []byte(apt.GenerateInstanceKey("default/default", "1", "2"))
+	data, _ = json.Marshal(&pb.MicroServiceInstance{
+		InstanceId: "2",
+		Properties: map[string]string{"password": "a"},
+	})
+	if encrypted, _ := e.Encrypt(key, data); string(encrypted) != string(data) {
+		t.Fatalf("TestFieldEncrypter_Properties encrypt instance failed, %s", encrypted)
+	}
+}
+
+func TestFieldEncrypter_Schema(t *testing.T) {
+	e := newMockEncrypter(t, "schema", "1")
+
+	key := []byte(apt.GenerateSchemaContentKey("default/default", "hash"))
+	encrypted, _ := e.Encrypt(key, []byte("content"))
+	if string(encrypted) != "{cipher:1}xcontent" {
+		t.Fatalf("TestFieldEncrypter_Schema encrypt content failed, %s", encrypted)
+	}
+	decrypted, _ := e.Decrypt(key, encrypted)
+	if string(decrypted) != "content" {
+		t.Fatalf("TestFieldEncrypter_Schema decrypt content failed, %s", decrypted)
+	}
+
+	// the reference to the content
+	key = []byte(apt.GenerateServiceSchemaKey("default/default", "1", "s"))
+	if encrypted, _ := e.Encrypt(key, []byte("sha256:hash")); string(encrypted) != "sha256:hash" {
+		t.Fatalf("TestFieldEncrypter_Schema encrypt reference failed, %s", encrypted)
+	}
+
+	key = []byte(apt.GenerateServiceSchemaRevisionKey("default/default", "1", "s", "1"))
+	data, _ := json.Marshal(&pb.SchemaRevision{Revision: "1", Schema: "content"})
+	encrypted, _ = e.Encrypt(key, data)
+	revision := &pb.SchemaRevision{}
+	json.Unmarshal(encrypted, revision)
+	if revision.Revision != "1" || revision.Schema != "{cipher:1}xcontent" {
+		t.Fatalf("TestFieldEncrypter_Schema encrypt revision failed, %v", revision)
+	}
+	data, _ = json.Marshal(&pb.SchemaRevision{Revision: "1", Schema: "sha256:hash"})
+	if encrypted, _ := e.Encrypt(key, data); string(encrypted) != string(data) {
+		t.Fatalf("TestFieldEncrypter_Schema encrypt revision reference failed, %s", encrypted)
+	}
+
+	// other keys are never encrypted
+	key = []byte(apt.GenerateServiceSchemaSummaryKey("default/default", "1", "s"))
+	if encrypted, _ := e.Encrypt(key, []byte("summary")); string(encrypted) != "summary" {
+		t.Fatalf("TestFieldEncrypter_Schema encrypt summary failed, %s", encrypted)
+	}
+}
+
+func TestFieldEncrypter_Reencrypt(t *testing.T) {
+	key := []byte(apt.GenerateServiceKey("default/default", "1"))
+	data, _ := json.Marshal(&pb.MicroService{
+		ServiceId:  "1",
+		Properties: map[string]string{"password": "a", "user": "b", "token": "c"},
+	})
+	old := newMockEncrypter(t, "service:password,service:token", "1")
+	encrypted, _ := old.Encrypt(key, data)
+
+	// rotate the key version and select the other properties
+	e := newMockEncrypter(t, "service:password,service:user", "2")
+	reencrypted, changed, err := e.Reencrypt(key, encrypted)
+	if err != nil || !changed {
+		t.Fatalf("TestFieldEncrypter_Reencrypt failed, %v, %s", changed, err)
+	}
+	service := &pb.MicroService{}
+	json.Unmarshal(reencrypted, service)
+	if service.Properties["password"] != "{cipher:2}xa" ||
+		service.Properties["user"] != "{cipher:2}xb" ||
+		service.Properties["token"] != "c" {
+		t.Fatalf("TestFieldEncrypter_Reencrypt failed, %v", service.Properties)
+	}
+
+	if _, changed, _ := e.Reencrypt(key, reencrypted); changed {
+		t.Fatalf("TestFieldEncrypter_Reencrypt should not change the encrypted value")
+	}
+
+	// the cipher can not decrypt the value
+	key = []byte(apt.GenerateSchemaContentKey("default/default", "hash"))
+	e = newMockEncrypter(t, "schema", "2")
+	if _, _, err := e.Reencrypt(key, []byte("{cipher:1}content")); err == nil {
+		t.Fatalf("TestFieldEncrypter_Reencrypt should fail")
+	}
+}
+
+func TestFieldEncrypter_ReencryptRotateKey(t *testing.T) {
+	k1, _ := cipher.GenerateKey()
+	k2, _ := cipher.GenerateKey()
+	keyring := cipher.NewKeyring()
+	if err := keyring.Parse("k1=" + k1 + "\nk2=" + k2); err != nil {
+		t.Fatalf("TestFieldEncrypter_ReencryptRotateKey parse keyring failed, %s", err)
+	}
+	keyring.SetPrimary("k1")
+	policy, _ := ParseEncryptPolicy("schema", "1")
+	e := &FieldEncrypter{Policy: policy, Cipher: keyring}
+
+	key := []byte(apt.GenerateSchemaContentKey("default/default", "hash"))
+	encrypted, _ := e.Encrypt(key, []byte("content"))
+	if _, changed, _ := e.Reencrypt(key, encrypted); changed {
+		t.Fatalf("TestFieldEncrypter_ReencryptRotateKey should not change the value encrypted by the primary key")
+	}
+
+	// only the key id is changed, the key version is the same
+	keyring.SetPrimary("k2")
+	reencrypted, changed, err := e.Reencrypt(key, encrypted)
+	if err != nil || !changed {
+		t.Fatalf("TestFieldEncrypter_ReencryptRotateKey failed, %v, %s", changed, err)
+	}
+	_, cipherText, _ := splitEncryptedValue(string(reencrypted))
+	if id, _ := cipher.KeyIdOf(cipherText); id != "k2" {
+		t.Fatalf("TestFieldEncrypter_ReencryptRotateKey encrypted by key %s", id)
+	}
+	if plain, _ := e.Decrypt(key, reencrypted); string(plain) != "content" {
+		t.Fatalf("TestFieldEncrypter_ReencryptRotateKey decrypt failed, %s", plain)
+	}
+	if _, changed, _ := e.Reencrypt(key, reencrypted); changed {
+		t.Fatalf("TestFieldEncrypter_ReencryptRotateKey should not change the re-encrypted value")
+	}
+}
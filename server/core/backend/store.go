@@ -96,14 +96,8 @@ func (s *KvStore) store(ctx context.Context) {
 	}
 
 	for t := StoreType(0); t != typeEnd; t++ {
-		switch t {
-		case SCHEMA:
-			util.Logger().Infof("service center will not cache '%s'", SCHEMA)
-			continue
-		default:
-			s.indexers[t].Stop() // release the exist indexer
-			s.newIndexBuilder(t, NewKvCacher(t.String(), s.getKvCacherCfgOptions(t)...))
-		}
+		s.indexers[t].Stop() // release the exist indexer
+		s.newIndexBuilder(t, NewKvCacher(t.String(), s.getKvCacherCfgOptions(t)...))
 	}
 	for t := StoreType(0); t != typeEnd; t++ {
 		s.indexers[t].Run()
@@ -211,6 +205,10 @@ func (s *KvStore) Project() *Indexer {
 	return s.indexers[PROJECT]
 }
 
+func (s *KvStore) Quota() *Indexer {
+	return s.indexers[QUOTA]
+}
+
 func (s *KvStore) KeepAlive(ctx context.Context, opts ...registry.PluginOpOption) (int64, error) {
 	op := registry.OpPut(opts...)
 
@@ -0,0 +1,48 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package core
+
+import (
+	"errors"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/cipher"
+	"os"
+)
+
+const ENV_CIPHER_KEYRING = "SC_CIPHER_KEYRING"
+
+// LoadCipherKeyring returns the keyring of the aes cipher, the keys are
+// loaded from the keyring file and then the environment variable
+func LoadCipherKeyring() (*cipher.Keyring, error) {
+	keyring := cipher.NewKeyring()
+	if path := ServerInfo.Config.CipherKeyringFile; len(path) > 0 {
+		if err := keyring.Load(os.ExpandEnv(path)); err != nil {
+			return nil, err
+		}
+	}
+	if err := keyring.Parse(os.Getenv(ENV_CIPHER_KEYRING)); err != nil {
+		return nil, err
+	}
+	if keyring.Len() == 0 {
+		return nil, errors.New("no key is configured in cipher_keyring_file or " + ENV_CIPHER_KEYRING)
+	}
+	if id := ServerInfo.Config.CipherKeyId; len(id) > 0 {
+		if err := keyring.SetPrimary(id); err != nil {
+			return nil, err
+		}
+	}
+	return keyring, nil
+}
@@ -0,0 +1,117 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package core
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/cipher"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// runCommand runs the command line mode and returns the exit code
+func runCommand(name string, args []string) int {
+	var err error
+	switch name {
+	case "encrypt":
+		err = encryptCommand(args)
+	case "genkey":
+		err = genKeyCommand(args)
+	default:
+		err = fmt.Errorf("unknown command '%s', support encrypt, genkey", name)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	return 0
+}
+
+// encryptCommand prints the cipher text of the aes cipher, the plain text is
+// read from the argument or the stdin
+func encryptCommand(args []string) error {
+	fs := flag.NewFlagSet("encrypt", flag.ContinueOnError)
+	keyId := fs.String("key-id", "", "The key to encrypt, override the cipher_key_id.")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: service-center encrypt [-key-id <key id>] [plain text]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	keyring, err := LoadCipherKeyring()
+	if err != nil {
+		return err
+	}
+	if len(*keyId) > 0 {
+		if err := keyring.SetPrimary(*keyId); err != nil {
+			return err
+		}
+	}
+
+	var plain string
+	switch fs.NArg() {
+	case 0:
+		// avoid leaving the secret in the shell history
+		data, err := ioutil.ReadAll(bufio.NewReader(os.Stdin))
+		if err != nil {
+			return err
+		}
+		plain = strings.TrimRight(string(data), "\r\n")
+	case 1:
+		plain = fs.Arg(0)
+	default:
+		fs.Usage()
+		return fmt.Errorf("too many arguments")
+	}
+
+	cipherText, err := keyring.Encrypt(plain)
+	if err != nil {
+		return err
+	}
+	fmt.Println(cipherText)
+	return nil
+}
+
+// genKeyCommand prints a random key in the keyring format
+func genKeyCommand(args []string) error {
+	fs := flag.NewFlagSet("genkey", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: service-center genkey [key id]")
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	id := time.Now().Format("20060102150405")
+	if fs.NArg() > 0 {
+		id = fs.Arg(0)
+	}
+	key, err := cipher.GenerateKey()
+	if err != nil {
+		return err
+	}
+	if err := cipher.NewKeyring().Parse(id + "=" + key); err != nil {
+		return err
+	}
+	fmt.Printf("%s=%s\n", id, key)
+	return nil
+}
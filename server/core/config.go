@@ -47,16 +47,35 @@ func newInfo() *pb.ServerInformation {
 			LimitConnections: int64(beego.AppConfig.DefaultInt("limit_conns", 0)),
 			LimitIPLookup: beego.AppConfig.DefaultString("limit_iplookups",
 				"RemoteAddr,X-Forwarded-For,X-Real-IP"),
+			RateLimitPolicy: beego.AppConfig.String("rate_limit_policy"),
 
-			SslEnabled:    beego.AppConfig.DefaultInt("ssl_mode", 1) != 0,
-			SslMinVersion: beego.AppConfig.DefaultString("ssl_min_version", "TLSv1.2"),
-			SslVerifyPeer: beego.AppConfig.DefaultInt("ssl_verify_client", 1) != 0,
-			SslCiphers:    beego.AppConfig.String("ssl_ciphers"),
+			SslEnabled:             beego.AppConfig.DefaultInt("ssl_mode", 1) != 0,
+			SslMinVersion:          beego.AppConfig.DefaultString("ssl_min_version", "TLSv1.2"),
+			SslVerifyPeer:          beego.AppConfig.DefaultInt("ssl_verify_client", 1) != 0,
+			SslCiphers:             beego.AppConfig.String("ssl_ciphers"),
+			SslClientIdentity:      beego.AppConfig.DefaultInt("ssl_client_identity", 0) != 0,
+			SslClientIdentityRules: beego.AppConfig.String("ssl_client_identity_rules"),
+			SslCrlFile:             beego.AppConfig.String("ssl_crl_file"),
+			SslReloadInterval:      beego.AppConfig.DefaultString("ssl_reload_interval", "1m"),
 
 			AutoSyncInterval:  beego.AppConfig.DefaultString("auto_sync_interval", "30s"),
 			CompactIndexDelta: beego.AppConfig.DefaultInt64("compact_index_delta", 100),
 			CompactInterval:   beego.AppConfig.String("compact_interval"),
 
+			SchemaCompatibilityPolicy: beego.AppConfig.DefaultString("schema_compatibility_policy",
+				"production:reject,acceptance:warn,testing:warn,development:warn"),
+			SchemaRevisionLimit: beego.AppConfig.DefaultInt64("schema_revision_limit", 20),
+
+			ServiceOwnership: beego.AppConfig.DefaultInt("service_ownership", 0) != 0,
+
+			EncryptEnabled:           beego.AppConfig.DefaultInt("encrypt_mode", 0) != 0,
+			EncryptFields:            beego.AppConfig.String("encrypt_fields"),
+			EncryptKeyVersion:        beego.AppConfig.DefaultString("encrypt_key_version", "1"),
+			EncryptReencryptInterval: beego.AppConfig.String("encrypt_reencrypt_interval"),
+
+			CipherKeyringFile: beego.AppConfig.String("cipher_keyring_file"),
+			CipherKeyId:       beego.AppConfig.String("cipher_key_id"),
+
 			LoggerName:     beego.AppConfig.String("component_name"),
 			LogRotateSize:  maxLogFileSize,
 			LogBackupCount: maxLogBackupCount,
@@ -53,7 +53,7 @@ func initCommandLine() {
 	var printVer bool
 	flag.BoolVar(&printVer, "v", false, "Print the version and exit.")
 	flag.CommandLine.Init(os.Args[0], flag.ContinueOnError)
-	flag.CommandLine.Parse(os.Args[1:])
+	err := flag.CommandLine.Parse(os.Args[1:])
 
 	if printVer {
 		fmt.Printf("ServiceCenter version: %s\n", version.Ver().Version)
@@ -62,6 +62,11 @@ func initCommandLine() {
 		fmt.Printf("Go OS/Arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
 		os.Exit(0)
 	}
+
+	// the command line mode, e.g. service-center encrypt
+	if err == nil && flag.NArg() > 0 {
+		os.Exit(runCommand(flag.Arg(0), flag.Args()[1:]))
+	}
 }
 
 func printVersion() {
@@ -36,6 +36,12 @@ const (
 	REGISTRY_TAG_KEY            = "tags"
 	REGISTRY_SCHEMA_KEY         = "schemas"
 	REGISTRY_SCHEMA_SUMMARY_KEY = "schema-sum"
+	REGISTRY_SCHEMA_REV_KEY     = "schema-revs"
+	REGISTRY_SCHEMA_TYPE_KEY    = "schema-type"
+	REGISTRY_SCHEMA_CONTENT_KEY = "schema-contents"
+	REGISTRY_SCHEMA_REF_KEY     = "schema-refs"
+	REGISTRY_CREDENTIAL_KEY     = "credentials"
+	REGISTRY_QUOTA_KEY          = "quotas"
 	REGISTRY_LEASE_KEY          = "leases"
 	REGISTRY_DEPENDENCY_KEY     = "deps"
 	REGISTRY_DEPS_RULE_KEY      = "dep-rules"
@@ -206,6 +212,88 @@ func GenerateServiceSchemaSummaryKey(domainProject string, serviceId string, sch
 	}, "/")
 }
 
+func GenerateServiceSchemaRevisionKey(domainProject string, serviceId string, schemaId string, revision string) string {
+	return util.StringJoin([]string{
+		GetServiceSchemaRevisionRootKey(domainProject),
+		serviceId,
+		schemaId,
+		revision,
+	}, "/")
+}
+
+func GenerateServiceSchemaTypeKey(domainProject string, serviceId string, schemaId string) string {
+	return util.StringJoin([]string{
+		GetServiceSchemaTypeRootKey(domainProject),
+		serviceId,
+		schemaId,
+	}, "/")
+}
+
+func GetServiceSchemaTypeRootKey(domainProject string) string {
+	return util.StringJoin([]string{
+		GetRootKey(),
+		REGISTRY_SERVICE_KEY,
+		REGISTRY_SCHEMA_TYPE_KEY,
+		domainProject,
+	}, "/")
+}
+
+func GenerateSchemaContentKey(domainProject string, hash string) string {
+	return util.StringJoin([]string{
+		GetSchemaContentRootKey(domainProject),
+		hash,
+	}, "/")
+}
+
+func GetSchemaContentRootKey(domainProject string) string {
+	return util.StringJoin([]string{
+		GetRootKey(),
+		REGISTRY_SERVICE_KEY,
+		REGISTRY_SCHEMA_CONTENT_KEY,
+		domainProject,
+	}, "/")
+}
+
+func GenerateSchemaRefKey(domainProject string, hash string, serviceId string, schemaId string) string {
+	return util.StringJoin([]string{
+		GetSchemaRefRootKey(domainProject),
+		hash,
+		serviceId,
+		schemaId,
+	}, "/")
+}
+
+// GenerateSchemaRevisionRefKey returns the key of the reference of the
+// schema revision to the content, it is under the reference of the schema
+// so the content is kept until all the schemas and revisions release it
+func GenerateSchemaRevisionRefKey(domainProject string, hash string, serviceId string, schemaId string, revision string) string {
+	return util.StringJoin([]string{
+		GetSchemaRefRootKey(domainProject),
+		hash,
+		serviceId,
+		schemaId,
+		revision,
+	}, "/")
+}
+
+func GetSchemaRefRootKey(domainProject string) string {
+	return util.StringJoin([]string{
+		GetRootKey(),
+		REGISTRY_SERVICE_KEY,
+		REGISTRY_SCHEMA_REF_KEY,
+		domainProject,
+	}, "/")
+}
+
+func GetServiceSchemaRevisionRootKey(domainProject string) string {
+	return util.StringJoin([]string{
+		GetRootKey(),
+		REGISTRY_SERVICE_KEY,
+		REGISTRY_SCHEMA_REV_KEY,
+		domainProject,
+	}, "/")
+}
+
 func GetServiceSchemaSummaryRootKey(domainProject string) string {
 	return util.StringJoin([]string{
 		GetRootKey(),
@@ -361,3 +449,68 @@ func GenerateProjectKey(domain, project string) string {
 		project,
 	}, "/")
 }
+
+func GenerateServiceCredentialKey(domainProject string, serviceId string) string {
+	return util.StringJoin([]string{
+		GetServiceCredentialRootKey(domainProject),
+		serviceId,
+	}, "/")
+}
+
+func GetServiceCredentialRootKey(domainProject string) string {
+	return util.StringJoin([]string{
+		GetRootKey(),
+		REGISTRY_SERVICE_KEY,
+		REGISTRY_CREDENTIAL_KEY,
+		domainProject,
+	}, "/")
+}
+
+func GetQuotaRootKey() string {
+	return util.StringJoin([]string{
+		GetRootKey(),
+		REGISTRY_QUOTA_KEY,
+	}, "/")
+}
+
+// GenerateQuotaKey returns the key of the quota record of the domain if the
+// project is empty, otherwise the record of the project
+func GenerateQuotaKey(domain, project string) string {
+	if len(project) == 0 {
+		return util.StringJoin([]string{
+			GetQuotaRootKey(),
+			domain,
+		}, "/")
+	}
+	return util.StringJoin([]string{
+		GetQuotaRootKey(),
+		domain,
+		project,
+	}, "/")
+}
+
+// GetDomainProjectRootKeys returns the root keys of all the resources stored
+// by the domainProject, or by all the projects of the domain if domainProject
+// is a domain
+func GetDomainProjectRootKeys(domainProject string) []string {
+	return []string{
+		GetServiceRootKey(domainProject),
+		GetServiceIndexRootKey(domainProject),
+		GetServiceAliasRootKey(domainProject),
+		GetServiceRuleRootKey(domainProject),
+		GetServiceRuleIndexRootKey(domainProject),
+		GetServiceTagRootKey(domainProject),
+		GetServiceSchemaRootKey(domainProject),
+		GetServiceSchemaSummaryRootKey(domainProject),
+		GetServiceSchemaRevisionRootKey(domainProject),
+		GetServiceSchemaTypeRootKey(domainProject),
+		GetSchemaContentRootKey(domainProject),
+		GetSchemaRefRootKey(domainProject),
+		GetServiceCredentialRootKey(domainProject),
+		GetServiceDependencyRootKey(domainProject),
+		GetServiceDependencyRuleRootKey(domainProject),
+		GetServiceDependencyQueueRootKey(domainProject),
+		GetInstanceRootKey(domainProject),
+		GetInstanceLeaseRootKey(domainProject),
+	}
+}
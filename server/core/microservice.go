@@ -26,7 +26,7 @@ import (
 )
 
 var (
-	ServiceAPI         pb.ServiceCtrlServer
+	ServiceAPI         pb.ServiceCtrlServerEx
 	InstanceAPI        pb.SerivceInstanceCtrlServerEx
 	Service            *pb.MicroService
 	Instance           *pb.MicroServiceInstance
@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package proto
+
+const (
+	SCHEMA_POLICY_REJECT = "reject"
+	SCHEMA_POLICY_WARN   = "warn"
+	SCHEMA_POLICY_ALLOW  = "allow"
+
+	SCHEMA_CHANGE_OPERATION_REMOVED      = "OPERATION_REMOVED"
+	SCHEMA_CHANGE_OPERATION_ADDED        = "OPERATION_ADDED"
+	SCHEMA_CHANGE_PARAMETER_REQUIRED     = "PARAMETER_REQUIRED"
+	SCHEMA_CHANGE_PARAMETER_TYPE_CHANGED = "PARAMETER_TYPE_CHANGED"
+	SCHEMA_CHANGE_PARAMETER_REMOVED      = "PARAMETER_REMOVED"
+	SCHEMA_CHANGE_REQUEST_BODY_REQUIRED  = "REQUEST_BODY_REQUIRED"
+	SCHEMA_CHANGE_RESPONSE_REMOVED       = "RESPONSE_REMOVED"
+	SCHEMA_CHANGE_RESPONSE_TYPE_CHANGED  = "RESPONSE_TYPE_CHANGED"
+)
+
+type SchemaChange struct {
+	Type      string `json:"type"`
+	Operation string `json:"operation"`
+	Breaking  bool   `json:"breaking"`
+	Message   string `json:"message,omitempty"`
+}
+
+type SchemaCompatibilityReport struct {
+	SchemaId    string `json:"schemaId"`
+	Environment string `json:"environment,omitempty"`
+	Policy      string `json:"policy"`
+	// Analyzed is false if the old or the new schema is not an OpenAPI/Swagger document
+	Analyzed   bool            `json:"analyzed"`
+	Compatible bool            `json:"compatible"`
+	Changes    []*SchemaChange `json:"changes,omitempty"`
+	Consumers  []*MicroService `json:"consumers,omitempty"`
+}
+
+func (r *SchemaCompatibilityReport) BreakingChanges() (changes []*SchemaChange) {
+	for _, change := range r.Changes {
+		if change.Breaking {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+type CheckSchemaCompatibilityRequest struct {
+	ServiceId string `json:"serviceId,omitempty"`
+	SchemaId  string `json:"schemaId,omitempty"`
+	Schema    string `json:"schema,omitempty"`
+}
+
+type CheckSchemaCompatibilityResponse struct {
+	Response *Response                  `json:"response,omitempty"`
+	Report   *SchemaCompatibilityReport `json:"report,omitempty"`
+}
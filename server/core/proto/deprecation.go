@@ -0,0 +1,41 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package proto
+
+type UpdateDeprecationRequest struct {
+	ServiceId   string       `json:"serviceId,omitempty"`
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+}
+
+type UpdateDeprecationResponse struct {
+	Response *Response `json:"response,omitempty"`
+}
+
+type GetDeprecatedServicesRequest struct {
+	AppId       string `json:"appId,omitempty"`
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+type DeprecatedService struct {
+	MicroService *MicroService   `json:"microService"`
+	Consumers    []*MicroService `json:"consumers,omitempty"`
+}
+
+type GetDeprecatedServicesResponse struct {
+	Response *Response            `json:"response,omitempty"`
+	Services []*DeprecatedService `json:"services,omitempty"`
+}
@@ -0,0 +1,46 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package proto
+
+type ExplainRulesRequest struct {
+	ProviderServiceId string `json:"providerServiceId,omitempty"`
+	ConsumerServiceId string `json:"consumerServiceId,omitempty"`
+	// Consumer and Tags describe a hypothetical consumer which is not registered
+	Consumer   *MicroServiceKey  `json:"consumer,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	InstanceIP string            `json:"instanceIP,omitempty"`
+	// Rules, if not empty, is the proposed rule set evaluated instead of the stored one
+	Rules []*AddOrUpdateServiceRule `json:"rules,omitempty"`
+}
+
+type RuleMatchResult struct {
+	Rule     *ServiceRule `json:"rule"`
+	Active   bool         `json:"active"`
+	Value    string       `json:"value,omitempty"`
+	Matched  bool         `json:"matched"`
+	Decisive bool         `json:"decisive"`
+	Message  string       `json:"message,omitempty"`
+}
+
+type ExplainRulesResponse struct {
+	Response               *Response          `json:"response,omitempty"`
+	AcrossDimensionAllowed bool               `json:"acrossDimensionAllowed"`
+	AcrossDimensionMessage string             `json:"acrossDimensionMessage,omitempty"`
+	Rules                  []*RuleMatchResult `json:"rules,omitempty"`
+	Allowed                bool               `json:"allowed"`
+	Reason                 string             `json:"reason,omitempty"`
+}
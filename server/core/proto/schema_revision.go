@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package proto
+
+const (
+	SCHEMA_REVISION_MODIFY   = "MODIFY"
+	SCHEMA_REVISION_ROLLBACK = "ROLLBACK"
+
+	SCHEMA_DIFF_ADDED   = "ADDED"
+	SCHEMA_DIFF_REMOVED = "REMOVED"
+	SCHEMA_DIFF_CHANGED = "CHANGED"
+)
+
+// SchemaRevision is an immutable record of a schema change
+type SchemaRevision struct {
+	Revision string `json:"revision"`
+	SchemaId string `json:"schemaId"`
+	Summary  string `json:"summary,omitempty"`
+	Schema   string `json:"schema,omitempty"`
+	// SchemaType is the detected type of the schema, empty if unknown
+	SchemaType string `json:"schemaType,omitempty"`
+	Author     string `json:"author,omitempty"`
+	Timestamp  string `json:"timestamp"`
+	Action     string `json:"action"`
+	// RollbackFrom is the revision restored by a rollback
+	RollbackFrom string `json:"rollbackFrom,omitempty"`
+}
+
+type SchemaDiff struct {
+	// Path is a json pointer of the changed node
+	Path string      `json:"path"`
+	Type string      `json:"type"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+type GetSchemaRevisionsRequest struct {
+	ServiceId  string `json:"serviceId,omitempty"`
+	SchemaId   string `json:"schemaId,omitempty"`
+	WithSchema bool   `json:"withSchema,omitempty"`
+}
+
+type GetSchemaRevisionsResponse struct {
+	Response  *Response         `json:"response,omitempty"`
+	Revisions []*SchemaRevision `json:"revisions,omitempty"`
+}
+
+type DiffSchemaRevisionsRequest struct {
+	ServiceId string `json:"serviceId,omitempty"`
+	SchemaId  string `json:"schemaId,omitempty"`
+	Base      string `json:"base,omitempty"`
+	// Target is the latest revision if empty
+	Target string `json:"target,omitempty"`
+}
+
+type DiffSchemaRevisionsResponse struct {
+	Response *Response       `json:"response,omitempty"`
+	Base     *SchemaRevision `json:"base,omitempty"`
+	Target   *SchemaRevision `json:"target,omitempty"`
+	Diffs    []*SchemaDiff   `json:"diffs,omitempty"`
+	// Changes is the compatibility analysis of OpenAPI/Swagger schemas
+	Changes []*SchemaChange `json:"changes,omitempty"`
+}
+
+type RollbackSchemaRequest struct {
+	ServiceId string `json:"serviceId,omitempty"`
+	SchemaId  string `json:"schemaId,omitempty"`
+	Revision  string `json:"revision,omitempty"`
+}
+
+type RollbackSchemaResponse struct {
+	Response *Response `json:"response,omitempty"`
+}
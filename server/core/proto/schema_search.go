@@ -0,0 +1,56 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package proto
+
+// SchemaOperation is an operation declared in an OpenAPI/Swagger schema
+type SchemaOperation struct {
+	Method      string   `json:"method"`
+	Path        string   `json:"path"`
+	OperationId string   `json:"operationId,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// SchemaSearchResult is a schema or the paths of a service which match the
+// search criteria, only the matched operations, models and paths are listed
+type SchemaSearchResult struct {
+	ServiceId   string `json:"serviceId"`
+	Environment string `json:"environment,omitempty"`
+	AppId       string `json:"appId"`
+	ServiceName string `json:"serviceName"`
+	Version     string `json:"version"`
+	// SchemaId is empty if the result is the paths of the service
+	SchemaId   string             `json:"schemaId,omitempty"`
+	Operations []*SchemaOperation `json:"operations,omitempty"`
+	Models     []string           `json:"models,omitempty"`
+	Paths      []*ServicePath     `json:"paths,omitempty"`
+}
+
+type SearchSchemasRequest struct {
+	// Keyword matches any indexed text, all the words must be matched
+	Keyword     string `json:"keyword,omitempty"`
+	Method      string `json:"method,omitempty"`
+	Path        string `json:"path,omitempty"`
+	OperationId string `json:"operationId,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	Model       string `json:"model,omitempty"`
+}
+
+type SearchSchemasResponse struct {
+	Response *Response             `json:"response,omitempty"`
+	Results  []*SchemaSearchResult `json:"results,omitempty"`
+}
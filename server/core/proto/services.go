@@ -38,6 +38,9 @@ const (
 	MS_UP      string    = "UP"
 	MS_DOWN    string    = "DOWN"
 
+	MS_DEPRECATED string = "DEPRECATED"
+	MS_RETIRED    string = "RETIRED"
+
 	MSI_UP           string = "UP"
 	MSI_DOWN         string = "DOWN"
 	MSI_STARTING     string = "STARTING"
@@ -49,7 +52,18 @@ const (
 	EXISTENCE_MS     string = "microservice"
 	EXISTENCE_SCHEMA string = "schema"
 
-	PROP_ALLOW_CROSS_APP = "allowCrossApp"
+	PROP_ALLOW_CROSS_APP     = "allowCrossApp"
+	PROP_EXPORT_APPS         = "exportApps"
+	PROP_EXPORT_ENVIRONMENTS = "exportEnvironments"
+	PROP_EXPORT_DOMAINS      = "exportDomains"
+
+	RULE_WHITE string = "WHITE"
+	RULE_BLACK string = "BLACK"
+
+	RULE_PATTERN_REGEX string = "REGEX"
+	RULE_PATTERN_CIDR  string = "CIDR"
+
+	RULE_ATTR_INSTANCE_IP string = "InstanceIP"
 
 	Response_SUCCESS int32 = 0
 
@@ -66,6 +80,26 @@ const (
 	VERSION = "0.0.1"
 )
 
+type ServiceCtrlServerEx interface {
+	ServiceCtrlServer
+
+	ExplainRules(ctx context.Context, in *ExplainRulesRequest) (*ExplainRulesResponse, error)
+	UpdateDeprecation(ctx context.Context, in *UpdateDeprecationRequest) (*UpdateDeprecationResponse, error)
+	CheckSchemaCompatibility(ctx context.Context, in *CheckSchemaCompatibilityRequest) (*CheckSchemaCompatibilityResponse, error)
+	GetSchemaRevisions(ctx context.Context, in *GetSchemaRevisionsRequest) (*GetSchemaRevisionsResponse, error)
+	DiffSchemaRevisions(ctx context.Context, in *DiffSchemaRevisionsRequest) (*DiffSchemaRevisionsResponse, error)
+	RollbackSchema(ctx context.Context, in *RollbackSchemaRequest) (*RollbackSchemaResponse, error)
+	SearchSchemas(ctx context.Context, in *SearchSchemasRequest) (*SearchSchemasResponse, error)
+	RotateServiceCredential(ctx context.Context, in *RotateServiceCredentialRequest) (*RotateServiceCredentialResponse, error)
+
+	CreateDomain(ctx context.Context, in *CreateDomainRequest) (*CreateDomainResponse, error)
+	GetDomains(ctx context.Context, in *GetDomainsRequest) (*GetDomainsResponse, error)
+	DeleteDomain(ctx context.Context, in *DeleteDomainRequest) (*DeleteDomainResponse, error)
+	CreateProject(ctx context.Context, in *CreateProjectRequest) (*CreateProjectResponse, error)
+	GetProjects(ctx context.Context, in *GetProjectsRequest) (*GetProjectsResponse, error)
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest) (*DeleteProjectResponse, error)
+}
+
 type SerivceInstanceCtrlServerEx interface {
 	ServiceInstanceCtrlServer
 
@@ -76,6 +110,8 @@ type SerivceInstanceCtrlServerEx interface {
 
 type GovernServiceCtrlServerEx interface {
 	GovernServiceCtrlServer
+
+	GetDeprecatedServices(ctx context.Context, in *GetDeprecatedServicesRequest) (*GetDeprecatedServicesResponse, error)
 }
 
 type MicroServiceDependency struct {
@@ -94,16 +130,34 @@ type ServerConfig struct {
 	LimitTTLUnit     string `json:"limitTTLUnit"`
 	LimitConnections int64  `json:"limitConnections"`
 	LimitIPLookup    string `json:"limitIPLookup"`
+	RateLimitPolicy  string `json:"rateLimitPolicy"`
 
-	SslEnabled    bool   `json:"sslEnabled,string"`
-	SslMinVersion string `json:"sslMinVersion"`
-	SslVerifyPeer bool   `json:"sslVerifyPeer,string"`
-	SslCiphers    string `json:"sslCiphers"`
+	SslEnabled             bool   `json:"sslEnabled,string"`
+	SslMinVersion          string `json:"sslMinVersion"`
+	SslVerifyPeer          bool   `json:"sslVerifyPeer,string"`
+	SslCiphers             string `json:"sslCiphers"`
+	SslClientIdentity      bool   `json:"sslClientIdentity,string"`
+	SslClientIdentityRules string `json:"-"`
+	SslCrlFile             string `json:"-"`
+	SslReloadInterval      string `json:"sslReloadInterval"`
 
 	AutoSyncInterval  string `json:"autoSyncInterval"`
 	CompactIndexDelta int64  `json:"compactIndexDelta"`
 	CompactInterval   string `json:"compactInterval"`
 
+	SchemaCompatibilityPolicy string `json:"schemaCompatibilityPolicy"`
+	SchemaRevisionLimit       int64  `json:"schemaRevisionLimit"`
+
+	ServiceOwnership bool `json:"serviceOwnership,string"`
+
+	EncryptEnabled           bool   `json:"encryptEnabled,string"`
+	EncryptFields            string `json:"encryptFields"`
+	EncryptKeyVersion        string `json:"encryptKeyVersion"`
+	EncryptReencryptInterval string `json:"encryptReencryptInterval"`
+
+	CipherKeyringFile string `json:"-"`
+	CipherKeyId       string `json:"-"`
+
 	EnablePProf bool `json:"-"`
 	EnableCache bool `json:"-"`
 
@@ -127,6 +181,15 @@ type ServerInformation struct {
 	Config  *ServerConfig `json:"-"`
 }
 
+func IsDeprecated(service *MicroService) bool {
+	state := service.GetDeprecation().GetState()
+	return state == MS_DEPRECATED || state == MS_RETIRED
+}
+
+func IsRetired(service *MicroService) bool {
+	return service.GetDeprecation().GetState() == MS_RETIRED
+}
+
 func CreateResponse(code int32, message string) *Response {
 	resp := &Response{
 		Code:    code,
@@ -250,6 +313,18 @@ func GetInfoFromSchemaKV(kv *mvccpb.KeyValue) (schemaId string, data []byte) {
 	return keys[l-1], data
 }
 
+func GetInfoFromServiceSchemaKV(kv *mvccpb.KeyValue) (serviceId, schemaId, domainProject string, data []byte) {
+	keys, data := KvToResponse(kv)
+	l := len(keys)
+	if l < 4 {
+		return
+	}
+	serviceId = keys[l-2]
+	schemaId = keys[l-1]
+	domainProject = fmt.Sprintf("%s/%s", keys[l-4], keys[l-3])
+	return
+}
+
 func GetInfoFromDependencyQueueKV(kv *mvccpb.KeyValue) (consumerId, domainProject string, data []byte) {
 	keys, data := KvToResponse(kv)
 	l := len(keys)
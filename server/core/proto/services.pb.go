@@ -27,6 +27,7 @@ It is generated from these files:
 It has these top-level messages:
 	ModifySchemasRequest
 	Schema
+	SchemaRef
 	ModifySchemasResponse
 	HeartbeatSetRequest
 	HeartbeatSetElement
@@ -40,6 +41,7 @@ It has these top-level messages:
 	GetServicesInfoResponse
 	MicroServiceKey
 	MicroService
+	Deprecation
 	FrameWorkProperty
 	ServiceRule
 	AddOrUpdateServiceRule
@@ -166,9 +168,12 @@ func (m *ModifySchemasRequest) GetSchemas() []*Schema {
 }
 
 type Schema struct {
-	SchemaId string `protobuf:"bytes,1,opt,name=schemaId" json:"schemaId,omitempty"`
-	Summary  string `protobuf:"bytes,2,opt,name=summary" json:"summary,omitempty"`
-	Schema   string `protobuf:"bytes,3,opt,name=schema" json:"schema,omitempty"`
+	SchemaId   string `protobuf:"bytes,1,opt,name=schemaId" json:"schemaId,omitempty"`
+	Summary    string `protobuf:"bytes,2,opt,name=summary" json:"summary,omitempty"`
+	Schema     string `protobuf:"bytes,3,opt,name=schema" json:"schema,omitempty"`
+	SchemaType string `protobuf:"bytes,4,opt,name=schemaType" json:"schemaType,omitempty"`
+	// SharedWith is the other schemas which have the same content
+	SharedWith []*SchemaRef `protobuf:"bytes,5,rep,name=sharedWith" json:"sharedWith,omitempty"`
 }
 
 func (m *Schema) Reset()                    { *m = Schema{} }
@@ -197,6 +202,44 @@ func (m *Schema) GetSchema() string {
 	return ""
 }
 
+func (m *Schema) GetSchemaType() string {
+	if m != nil {
+		return m.SchemaType
+	}
+	return ""
+}
+
+func (m *Schema) GetSharedWith() []*SchemaRef {
+	if m != nil {
+		return m.SharedWith
+	}
+	return nil
+}
+
+type SchemaRef struct {
+	ServiceId string `protobuf:"bytes,1,opt,name=serviceId" json:"serviceId,omitempty"`
+	SchemaId  string `protobuf:"bytes,2,opt,name=schemaId" json:"schemaId,omitempty"`
+}
+
+func (m *SchemaRef) Reset()                    { *m = SchemaRef{} }
+func (m *SchemaRef) String() string            { return proto1.CompactTextString(m) }
+func (*SchemaRef) ProtoMessage()               {}
+func (*SchemaRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *SchemaRef) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *SchemaRef) GetSchemaId() string {
+	if m != nil {
+		return m.SchemaId
+	}
+	return ""
+}
+
 type ModifySchemasResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 }
@@ -204,7 +247,7 @@ type ModifySchemasResponse struct {
 func (m *ModifySchemasResponse) Reset()                    { *m = ModifySchemasResponse{} }
 func (m *ModifySchemasResponse) String() string            { return proto1.CompactTextString(m) }
 func (*ModifySchemasResponse) ProtoMessage()               {}
-func (*ModifySchemasResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*ModifySchemasResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *ModifySchemasResponse) GetResponse() *Response {
 	if m != nil {
@@ -220,7 +263,7 @@ type HeartbeatSetRequest struct {
 func (m *HeartbeatSetRequest) Reset()                    { *m = HeartbeatSetRequest{} }
 func (m *HeartbeatSetRequest) String() string            { return proto1.CompactTextString(m) }
 func (*HeartbeatSetRequest) ProtoMessage()               {}
-func (*HeartbeatSetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*HeartbeatSetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *HeartbeatSetRequest) GetInstances() []*HeartbeatSetElement {
 	if m != nil {
@@ -237,7 +280,7 @@ type HeartbeatSetElement struct {
 func (m *HeartbeatSetElement) Reset()                    { *m = HeartbeatSetElement{} }
 func (m *HeartbeatSetElement) String() string            { return proto1.CompactTextString(m) }
 func (*HeartbeatSetElement) ProtoMessage()               {}
-func (*HeartbeatSetElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*HeartbeatSetElement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *HeartbeatSetElement) GetServiceId() string {
 	if m != nil {
@@ -261,7 +304,7 @@ type HeartbeatSetResponse struct {
 func (m *HeartbeatSetResponse) Reset()                    { *m = HeartbeatSetResponse{} }
 func (m *HeartbeatSetResponse) String() string            { return proto1.CompactTextString(m) }
 func (*HeartbeatSetResponse) ProtoMessage()               {}
-func (*HeartbeatSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*HeartbeatSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *HeartbeatSetResponse) GetResponse() *Response {
 	if m != nil {
@@ -286,7 +329,7 @@ type InstanceHbRst struct {
 func (m *InstanceHbRst) Reset()                    { *m = InstanceHbRst{} }
 func (m *InstanceHbRst) String() string            { return proto1.CompactTextString(m) }
 func (*InstanceHbRst) ProtoMessage()               {}
-func (*InstanceHbRst) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*InstanceHbRst) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *InstanceHbRst) GetServiceId() string {
 	if m != nil {
@@ -317,7 +360,7 @@ type StService struct {
 func (m *StService) Reset()                    { *m = StService{} }
 func (m *StService) String() string            { return proto1.CompactTextString(m) }
 func (*StService) ProtoMessage()               {}
-func (*StService) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*StService) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *StService) GetCount() int64 {
 	if m != nil {
@@ -341,7 +384,7 @@ type StInstance struct {
 func (m *StInstance) Reset()                    { *m = StInstance{} }
 func (m *StInstance) String() string            { return proto1.CompactTextString(m) }
 func (*StInstance) ProtoMessage()               {}
-func (*StInstance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*StInstance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *StInstance) GetCount() int64 {
 	if m != nil {
@@ -364,7 +407,7 @@ type StApp struct {
 func (m *StApp) Reset()                    { *m = StApp{} }
 func (m *StApp) String() string            { return proto1.CompactTextString(m) }
 func (*StApp) ProtoMessage()               {}
-func (*StApp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*StApp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *StApp) GetCount() int64 {
 	if m != nil {
@@ -382,7 +425,7 @@ type Statistics struct {
 func (m *Statistics) Reset()                    { *m = Statistics{} }
 func (m *Statistics) String() string            { return proto1.CompactTextString(m) }
 func (*Statistics) ProtoMessage()               {}
-func (*Statistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*Statistics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *Statistics) GetServices() *StService {
 	if m != nil {
@@ -415,7 +458,7 @@ type GetServicesInfoRequest struct {
 func (m *GetServicesInfoRequest) Reset()                    { *m = GetServicesInfoRequest{} }
 func (m *GetServicesInfoRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetServicesInfoRequest) ProtoMessage()               {}
-func (*GetServicesInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*GetServicesInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GetServicesInfoRequest) GetOptions() []string {
 	if m != nil {
@@ -454,7 +497,7 @@ type GetServicesInfoResponse struct {
 func (m *GetServicesInfoResponse) Reset()                    { *m = GetServicesInfoResponse{} }
 func (m *GetServicesInfoResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetServicesInfoResponse) ProtoMessage()               {}
-func (*GetServicesInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*GetServicesInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *GetServicesInfoResponse) GetResponse() *Response {
 	if m != nil {
@@ -490,7 +533,7 @@ type MicroServiceKey struct {
 func (m *MicroServiceKey) Reset()                    { *m = MicroServiceKey{} }
 func (m *MicroServiceKey) String() string            { return proto1.CompactTextString(m) }
 func (*MicroServiceKey) ProtoMessage()               {}
-func (*MicroServiceKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*MicroServiceKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *MicroServiceKey) GetTenant() string {
 	if m != nil {
@@ -560,12 +603,13 @@ type MicroService struct {
 	Environment  string             `protobuf:"bytes,16,opt,name=environment" json:"environment,omitempty"`
 	RegisterBy   string             `protobuf:"bytes,17,opt,name=registerBy" json:"registerBy,omitempty"`
 	Framework    *FrameWorkProperty `protobuf:"bytes,18,opt,name=framework" json:"framework,omitempty"`
+	Deprecation  *Deprecation       `protobuf:"bytes,19,opt,name=deprecation" json:"deprecation,omitempty"`
 }
 
 func (m *MicroService) Reset()                    { *m = MicroService{} }
 func (m *MicroService) String() string            { return proto1.CompactTextString(m) }
 func (*MicroService) ProtoMessage()               {}
-func (*MicroService) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*MicroService) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *MicroService) GetServiceId() string {
 	if m != nil {
@@ -693,6 +737,53 @@ func (m *MicroService) GetFramework() *FrameWorkProperty {
 	return nil
 }
 
+func (m *MicroService) GetDeprecation() *Deprecation {
+	if m != nil {
+		return m.Deprecation
+	}
+	return nil
+}
+
+type Deprecation struct {
+	State       string `protobuf:"bytes,1,opt,name=state" json:"state,omitempty"`
+	SunsetDate  string `protobuf:"bytes,2,opt,name=sunsetDate" json:"sunsetDate,omitempty"`
+	Replacement string `protobuf:"bytes,3,opt,name=replacement" json:"replacement,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+}
+
+func (m *Deprecation) Reset()                    { *m = Deprecation{} }
+func (m *Deprecation) String() string            { return proto1.CompactTextString(m) }
+func (*Deprecation) ProtoMessage()               {}
+func (*Deprecation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *Deprecation) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Deprecation) GetSunsetDate() string {
+	if m != nil {
+		return m.SunsetDate
+	}
+	return ""
+}
+
+func (m *Deprecation) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
+	}
+	return ""
+}
+
+func (m *Deprecation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type FrameWorkProperty struct {
 	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
@@ -701,7 +792,7 @@ type FrameWorkProperty struct {
 func (m *FrameWorkProperty) Reset()                    { *m = FrameWorkProperty{} }
 func (m *FrameWorkProperty) String() string            { return proto1.CompactTextString(m) }
 func (*FrameWorkProperty) ProtoMessage()               {}
-func (*FrameWorkProperty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*FrameWorkProperty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *FrameWorkProperty) GetName() string {
 	if m != nil {
@@ -725,12 +816,16 @@ type ServiceRule struct {
 	Description  string `protobuf:"bytes,5,opt,name=description" json:"description,omitempty"`
 	Timestamp    string `protobuf:"bytes,6,opt,name=timestamp" json:"timestamp,omitempty"`
 	ModTimestamp string `protobuf:"bytes,7,opt,name=modTimestamp" json:"modTimestamp,omitempty"`
+	Priority     int32  `protobuf:"varint,8,opt,name=priority" json:"priority,omitempty"`
+	PatternType  string `protobuf:"bytes,9,opt,name=patternType" json:"patternType,omitempty"`
+	ValidFrom    string `protobuf:"bytes,10,opt,name=validFrom" json:"validFrom,omitempty"`
+	ValidTo      string `protobuf:"bytes,11,opt,name=validTo" json:"validTo,omitempty"`
 }
 
 func (m *ServiceRule) Reset()                    { *m = ServiceRule{} }
 func (m *ServiceRule) String() string            { return proto1.CompactTextString(m) }
 func (*ServiceRule) ProtoMessage()               {}
-func (*ServiceRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*ServiceRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *ServiceRule) GetRuleId() string {
 	if m != nil {
@@ -781,17 +876,49 @@ func (m *ServiceRule) GetModTimestamp() string {
 	return ""
 }
 
+func (m *ServiceRule) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *ServiceRule) GetPatternType() string {
+	if m != nil {
+		return m.PatternType
+	}
+	return ""
+}
+
+func (m *ServiceRule) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *ServiceRule) GetValidTo() string {
+	if m != nil {
+		return m.ValidTo
+	}
+	return ""
+}
+
 type AddOrUpdateServiceRule struct {
 	RuleType    string `protobuf:"bytes,1,opt,name=ruleType" json:"ruleType,omitempty"`
 	Attribute   string `protobuf:"bytes,2,opt,name=attribute" json:"attribute,omitempty"`
 	Pattern     string `protobuf:"bytes,3,opt,name=pattern" json:"pattern,omitempty"`
 	Description string `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Priority    int32  `protobuf:"varint,5,opt,name=priority" json:"priority,omitempty"`
+	PatternType string `protobuf:"bytes,6,opt,name=patternType" json:"patternType,omitempty"`
+	ValidFrom   string `protobuf:"bytes,7,opt,name=validFrom" json:"validFrom,omitempty"`
+	ValidTo     string `protobuf:"bytes,8,opt,name=validTo" json:"validTo,omitempty"`
 }
 
 func (m *AddOrUpdateServiceRule) Reset()                    { *m = AddOrUpdateServiceRule{} }
 func (m *AddOrUpdateServiceRule) String() string            { return proto1.CompactTextString(m) }
 func (*AddOrUpdateServiceRule) ProtoMessage()               {}
-func (*AddOrUpdateServiceRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*AddOrUpdateServiceRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *AddOrUpdateServiceRule) GetRuleType() string {
 	if m != nil {
@@ -821,6 +948,34 @@ func (m *AddOrUpdateServiceRule) GetDescription() string {
 	return ""
 }
 
+func (m *AddOrUpdateServiceRule) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AddOrUpdateServiceRule) GetPatternType() string {
+	if m != nil {
+		return m.PatternType
+	}
+	return ""
+}
+
+func (m *AddOrUpdateServiceRule) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *AddOrUpdateServiceRule) GetValidTo() string {
+	if m != nil {
+		return m.ValidTo
+	}
+	return ""
+}
+
 type ServicePath struct {
 	Path     string            `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
 	Property map[string]string `protobuf:"bytes,2,rep,name=property" json:"property,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -829,7 +984,7 @@ type ServicePath struct {
 func (m *ServicePath) Reset()                    { *m = ServicePath{} }
 func (m *ServicePath) String() string            { return proto1.CompactTextString(m) }
 func (*ServicePath) ProtoMessage()               {}
-func (*ServicePath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*ServicePath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *ServicePath) GetPath() string {
 	if m != nil {
@@ -853,7 +1008,7 @@ type Response struct {
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto1.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *Response) GetCode() int32 {
 	if m != nil {
@@ -882,7 +1037,7 @@ type GetExistenceRequest struct {
 func (m *GetExistenceRequest) Reset()                    { *m = GetExistenceRequest{} }
 func (m *GetExistenceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetExistenceRequest) ProtoMessage()               {}
-func (*GetExistenceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*GetExistenceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *GetExistenceRequest) GetType() string {
 	if m != nil {
@@ -943,7 +1098,7 @@ type GetExistenceResponse struct {
 func (m *GetExistenceResponse) Reset()                    { *m = GetExistenceResponse{} }
 func (m *GetExistenceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetExistenceResponse) ProtoMessage()               {}
-func (*GetExistenceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*GetExistenceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *GetExistenceResponse) GetResponse() *Response {
 	if m != nil {
@@ -974,16 +1129,17 @@ func (m *GetExistenceResponse) GetSummary() string {
 }
 
 type CreateServiceRequest struct {
-	Service   *MicroService             `protobuf:"bytes,1,opt,name=service" json:"service,omitempty"`
-	Rules     []*AddOrUpdateServiceRule `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
-	Tags      map[string]string         `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Instances []*MicroServiceInstance   `protobuf:"bytes,4,rep,name=instances" json:"instances,omitempty"`
+	Service    *MicroService             `protobuf:"bytes,1,opt,name=service" json:"service,omitempty"`
+	Rules      []*AddOrUpdateServiceRule `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
+	Tags       map[string]string         `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Instances  []*MicroServiceInstance   `protobuf:"bytes,4,rep,name=instances" json:"instances,omitempty"`
+	Credential string                    `protobuf:"bytes,5,opt,name=credential" json:"credential,omitempty"`
 }
 
 func (m *CreateServiceRequest) Reset()                    { *m = CreateServiceRequest{} }
 func (m *CreateServiceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*CreateServiceRequest) ProtoMessage()               {}
-func (*CreateServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*CreateServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *CreateServiceRequest) GetService() *MicroService {
 	if m != nil {
@@ -1013,15 +1169,23 @@ func (m *CreateServiceRequest) GetInstances() []*MicroServiceInstance {
 	return nil
 }
 
+func (m *CreateServiceRequest) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
+}
+
 type CreateServiceResponse struct {
-	Response  *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
-	ServiceId string    `protobuf:"bytes,2,opt,name=serviceId" json:"serviceId,omitempty"`
+	Response   *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	ServiceId  string    `protobuf:"bytes,2,opt,name=serviceId" json:"serviceId,omitempty"`
+	Credential string    `protobuf:"bytes,3,opt,name=credential" json:"credential,omitempty"`
 }
 
 func (m *CreateServiceResponse) Reset()                    { *m = CreateServiceResponse{} }
 func (m *CreateServiceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*CreateServiceResponse) ProtoMessage()               {}
-func (*CreateServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*CreateServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *CreateServiceResponse) GetResponse() *Response {
 	if m != nil {
@@ -1037,6 +1201,13 @@ func (m *CreateServiceResponse) GetServiceId() string {
 	return ""
 }
 
+func (m *CreateServiceResponse) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
+}
+
 type DeleteServiceRequest struct {
 	ServiceId string `protobuf:"bytes,1,opt,name=serviceId" json:"serviceId,omitempty"`
 	Force     bool   `protobuf:"varint,2,opt,name=force" json:"force,omitempty"`
@@ -1045,7 +1216,7 @@ type DeleteServiceRequest struct {
 func (m *DeleteServiceRequest) Reset()                    { *m = DeleteServiceRequest{} }
 func (m *DeleteServiceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteServiceRequest) ProtoMessage()               {}
-func (*DeleteServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *DeleteServiceRequest) GetServiceId() string {
 	if m != nil {
@@ -1068,7 +1239,7 @@ type DeleteServiceResponse struct {
 func (m *DeleteServiceResponse) Reset()                    { *m = DeleteServiceResponse{} }
 func (m *DeleteServiceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteServiceResponse) ProtoMessage()               {}
-func (*DeleteServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeleteServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *DeleteServiceResponse) GetResponse() *Response {
 	if m != nil {
@@ -1084,7 +1255,7 @@ type GetServiceRequest struct {
 func (m *GetServiceRequest) Reset()                    { *m = GetServiceRequest{} }
 func (m *GetServiceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceRequest) ProtoMessage()               {}
-func (*GetServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetServiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *GetServiceRequest) GetServiceId() string {
 	if m != nil {
@@ -1101,7 +1272,7 @@ type GetServiceResponse struct {
 func (m *GetServiceResponse) Reset()                    { *m = GetServiceResponse{} }
 func (m *GetServiceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceResponse) ProtoMessage()               {}
-func (*GetServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*GetServiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *GetServiceResponse) GetResponse() *Response {
 	if m != nil {
@@ -1123,7 +1294,7 @@ type GetServicesRequest struct {
 func (m *GetServicesRequest) Reset()                    { *m = GetServicesRequest{} }
 func (m *GetServicesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetServicesRequest) ProtoMessage()               {}
-func (*GetServicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*GetServicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 type GetServicesResponse struct {
 	Response *Response       `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
@@ -1133,7 +1304,7 @@ type GetServicesResponse struct {
 func (m *GetServicesResponse) Reset()                    { *m = GetServicesResponse{} }
 func (m *GetServicesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetServicesResponse) ProtoMessage()               {}
-func (*GetServicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*GetServicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *GetServicesResponse) GetResponse() *Response {
 	if m != nil {
@@ -1157,7 +1328,7 @@ type UpdateServicePropsRequest struct {
 func (m *UpdateServicePropsRequest) Reset()                    { *m = UpdateServicePropsRequest{} }
 func (m *UpdateServicePropsRequest) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateServicePropsRequest) ProtoMessage()               {}
-func (*UpdateServicePropsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*UpdateServicePropsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *UpdateServicePropsRequest) GetServiceId() string {
 	if m != nil {
@@ -1180,7 +1351,7 @@ type UpdateServicePropsResponse struct {
 func (m *UpdateServicePropsResponse) Reset()                    { *m = UpdateServicePropsResponse{} }
 func (m *UpdateServicePropsResponse) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateServicePropsResponse) ProtoMessage()               {}
-func (*UpdateServicePropsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*UpdateServicePropsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *UpdateServicePropsResponse) GetResponse() *Response {
 	if m != nil {
@@ -1196,7 +1367,7 @@ type GetServiceRulesRequest struct {
 func (m *GetServiceRulesRequest) Reset()                    { *m = GetServiceRulesRequest{} }
 func (m *GetServiceRulesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceRulesRequest) ProtoMessage()               {}
-func (*GetServiceRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*GetServiceRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *GetServiceRulesRequest) GetServiceId() string {
 	if m != nil {
@@ -1213,7 +1384,7 @@ type GetServiceRulesResponse struct {
 func (m *GetServiceRulesResponse) Reset()                    { *m = GetServiceRulesResponse{} }
 func (m *GetServiceRulesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceRulesResponse) ProtoMessage()               {}
-func (*GetServiceRulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*GetServiceRulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *GetServiceRulesResponse) GetResponse() *Response {
 	if m != nil {
@@ -1238,7 +1409,7 @@ type UpdateServiceRuleRequest struct {
 func (m *UpdateServiceRuleRequest) Reset()                    { *m = UpdateServiceRuleRequest{} }
 func (m *UpdateServiceRuleRequest) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateServiceRuleRequest) ProtoMessage()               {}
-func (*UpdateServiceRuleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*UpdateServiceRuleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *UpdateServiceRuleRequest) GetServiceId() string {
 	if m != nil {
@@ -1268,7 +1439,7 @@ type UpdateServiceRuleResponse struct {
 func (m *UpdateServiceRuleResponse) Reset()                    { *m = UpdateServiceRuleResponse{} }
 func (m *UpdateServiceRuleResponse) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateServiceRuleResponse) ProtoMessage()               {}
-func (*UpdateServiceRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*UpdateServiceRuleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *UpdateServiceRuleResponse) GetResponse() *Response {
 	if m != nil {
@@ -1280,12 +1451,13 @@ func (m *UpdateServiceRuleResponse) GetResponse() *Response {
 type AddServiceRulesRequest struct {
 	ServiceId string                    `protobuf:"bytes,1,opt,name=serviceId" json:"serviceId,omitempty"`
 	Rules     []*AddOrUpdateServiceRule `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
+	DryRun    bool                      `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
 }
 
 func (m *AddServiceRulesRequest) Reset()                    { *m = AddServiceRulesRequest{} }
 func (m *AddServiceRulesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*AddServiceRulesRequest) ProtoMessage()               {}
-func (*AddServiceRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*AddServiceRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *AddServiceRulesRequest) GetServiceId() string {
 	if m != nil {
@@ -1301,6 +1473,13 @@ func (m *AddServiceRulesRequest) GetRules() []*AddOrUpdateServiceRule {
 	return nil
 }
 
+func (m *AddServiceRulesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type AddServiceRulesResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	RuleIds  []string  `protobuf:"bytes,2,rep,name=RuleIds" json:"RuleIds,omitempty"`
@@ -1309,7 +1488,7 @@ type AddServiceRulesResponse struct {
 func (m *AddServiceRulesResponse) Reset()                    { *m = AddServiceRulesResponse{} }
 func (m *AddServiceRulesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*AddServiceRulesResponse) ProtoMessage()               {}
-func (*AddServiceRulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*AddServiceRulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *AddServiceRulesResponse) GetResponse() *Response {
 	if m != nil {
@@ -1333,7 +1512,7 @@ type DeleteServiceRulesRequest struct {
 func (m *DeleteServiceRulesRequest) Reset()                    { *m = DeleteServiceRulesRequest{} }
 func (m *DeleteServiceRulesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteServiceRulesRequest) ProtoMessage()               {}
-func (*DeleteServiceRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*DeleteServiceRulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *DeleteServiceRulesRequest) GetServiceId() string {
 	if m != nil {
@@ -1356,7 +1535,7 @@ type DeleteServiceRulesResponse struct {
 func (m *DeleteServiceRulesResponse) Reset()                    { *m = DeleteServiceRulesResponse{} }
 func (m *DeleteServiceRulesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteServiceRulesResponse) ProtoMessage()               {}
-func (*DeleteServiceRulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*DeleteServiceRulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *DeleteServiceRulesResponse) GetResponse() *Response {
 	if m != nil {
@@ -1372,7 +1551,7 @@ type GetServiceTagsRequest struct {
 func (m *GetServiceTagsRequest) Reset()                    { *m = GetServiceTagsRequest{} }
 func (m *GetServiceTagsRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceTagsRequest) ProtoMessage()               {}
-func (*GetServiceTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*GetServiceTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *GetServiceTagsRequest) GetServiceId() string {
 	if m != nil {
@@ -1389,7 +1568,7 @@ type GetServiceTagsResponse struct {
 func (m *GetServiceTagsResponse) Reset()                    { *m = GetServiceTagsResponse{} }
 func (m *GetServiceTagsResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceTagsResponse) ProtoMessage()               {}
-func (*GetServiceTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*GetServiceTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *GetServiceTagsResponse) GetResponse() *Response {
 	if m != nil {
@@ -1414,7 +1593,7 @@ type UpdateServiceTagRequest struct {
 func (m *UpdateServiceTagRequest) Reset()                    { *m = UpdateServiceTagRequest{} }
 func (m *UpdateServiceTagRequest) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateServiceTagRequest) ProtoMessage()               {}
-func (*UpdateServiceTagRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*UpdateServiceTagRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *UpdateServiceTagRequest) GetServiceId() string {
 	if m != nil {
@@ -1444,7 +1623,7 @@ type UpdateServiceTagResponse struct {
 func (m *UpdateServiceTagResponse) Reset()                    { *m = UpdateServiceTagResponse{} }
 func (m *UpdateServiceTagResponse) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateServiceTagResponse) ProtoMessage()               {}
-func (*UpdateServiceTagResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*UpdateServiceTagResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *UpdateServiceTagResponse) GetResponse() *Response {
 	if m != nil {
@@ -1461,7 +1640,7 @@ type AddServiceTagsRequest struct {
 func (m *AddServiceTagsRequest) Reset()                    { *m = AddServiceTagsRequest{} }
 func (m *AddServiceTagsRequest) String() string            { return proto1.CompactTextString(m) }
 func (*AddServiceTagsRequest) ProtoMessage()               {}
-func (*AddServiceTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*AddServiceTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *AddServiceTagsRequest) GetServiceId() string {
 	if m != nil {
@@ -1484,7 +1663,7 @@ type AddServiceTagsResponse struct {
 func (m *AddServiceTagsResponse) Reset()                    { *m = AddServiceTagsResponse{} }
 func (m *AddServiceTagsResponse) String() string            { return proto1.CompactTextString(m) }
 func (*AddServiceTagsResponse) ProtoMessage()               {}
-func (*AddServiceTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*AddServiceTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *AddServiceTagsResponse) GetResponse() *Response {
 	if m != nil {
@@ -1501,7 +1680,7 @@ type DeleteServiceTagsRequest struct {
 func (m *DeleteServiceTagsRequest) Reset()                    { *m = DeleteServiceTagsRequest{} }
 func (m *DeleteServiceTagsRequest) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteServiceTagsRequest) ProtoMessage()               {}
-func (*DeleteServiceTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*DeleteServiceTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *DeleteServiceTagsRequest) GetServiceId() string {
 	if m != nil {
@@ -1524,7 +1703,7 @@ type DeleteServiceTagsResponse struct {
 func (m *DeleteServiceTagsResponse) Reset()                    { *m = DeleteServiceTagsResponse{} }
 func (m *DeleteServiceTagsResponse) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteServiceTagsResponse) ProtoMessage()               {}
-func (*DeleteServiceTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*DeleteServiceTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *DeleteServiceTagsResponse) GetResponse() *Response {
 	if m != nil {
@@ -1544,7 +1723,7 @@ type HealthCheck struct {
 func (m *HealthCheck) Reset()                    { *m = HealthCheck{} }
 func (m *HealthCheck) String() string            { return proto1.CompactTextString(m) }
 func (*HealthCheck) ProtoMessage()               {}
-func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *HealthCheck) GetMode() string {
 	if m != nil {
@@ -1598,7 +1777,7 @@ type MicroServiceInstance struct {
 func (m *MicroServiceInstance) Reset()                    { *m = MicroServiceInstance{} }
 func (m *MicroServiceInstance) String() string            { return proto1.CompactTextString(m) }
 func (*MicroServiceInstance) ProtoMessage()               {}
-func (*MicroServiceInstance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*MicroServiceInstance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *MicroServiceInstance) GetInstanceId() string {
 	if m != nil {
@@ -1686,7 +1865,7 @@ type DataCenterInfo struct {
 func (m *DataCenterInfo) Reset()                    { *m = DataCenterInfo{} }
 func (m *DataCenterInfo) String() string            { return proto1.CompactTextString(m) }
 func (*DataCenterInfo) ProtoMessage()               {}
-func (*DataCenterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*DataCenterInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *DataCenterInfo) GetName() string {
 	if m != nil {
@@ -1717,7 +1896,7 @@ type MicroServiceInstanceKey struct {
 func (m *MicroServiceInstanceKey) Reset()                    { *m = MicroServiceInstanceKey{} }
 func (m *MicroServiceInstanceKey) String() string            { return proto1.CompactTextString(m) }
 func (*MicroServiceInstanceKey) ProtoMessage()               {}
-func (*MicroServiceInstanceKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*MicroServiceInstanceKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *MicroServiceInstanceKey) GetInstanceId() string {
 	if m != nil {
@@ -1740,7 +1919,7 @@ type RegisterInstanceRequest struct {
 func (m *RegisterInstanceRequest) Reset()                    { *m = RegisterInstanceRequest{} }
 func (m *RegisterInstanceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*RegisterInstanceRequest) ProtoMessage()               {}
-func (*RegisterInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*RegisterInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *RegisterInstanceRequest) GetInstance() *MicroServiceInstance {
 	if m != nil {
@@ -1757,7 +1936,7 @@ type RegisterInstanceResponse struct {
 func (m *RegisterInstanceResponse) Reset()                    { *m = RegisterInstanceResponse{} }
 func (m *RegisterInstanceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*RegisterInstanceResponse) ProtoMessage()               {}
-func (*RegisterInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*RegisterInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *RegisterInstanceResponse) GetResponse() *Response {
 	if m != nil {
@@ -1781,7 +1960,7 @@ type UnregisterInstanceRequest struct {
 func (m *UnregisterInstanceRequest) Reset()                    { *m = UnregisterInstanceRequest{} }
 func (m *UnregisterInstanceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*UnregisterInstanceRequest) ProtoMessage()               {}
-func (*UnregisterInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*UnregisterInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *UnregisterInstanceRequest) GetServiceId() string {
 	if m != nil {
@@ -1804,7 +1983,7 @@ type UnregisterInstanceResponse struct {
 func (m *UnregisterInstanceResponse) Reset()                    { *m = UnregisterInstanceResponse{} }
 func (m *UnregisterInstanceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*UnregisterInstanceResponse) ProtoMessage()               {}
-func (*UnregisterInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*UnregisterInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *UnregisterInstanceResponse) GetResponse() *Response {
 	if m != nil {
@@ -1821,7 +2000,7 @@ type HeartbeatRequest struct {
 func (m *HeartbeatRequest) Reset()                    { *m = HeartbeatRequest{} }
 func (m *HeartbeatRequest) String() string            { return proto1.CompactTextString(m) }
 func (*HeartbeatRequest) ProtoMessage()               {}
-func (*HeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *HeartbeatRequest) GetServiceId() string {
 	if m != nil {
@@ -1844,7 +2023,7 @@ type HeartbeatResponse struct {
 func (m *HeartbeatResponse) Reset()                    { *m = HeartbeatResponse{} }
 func (m *HeartbeatResponse) String() string            { return proto1.CompactTextString(m) }
 func (*HeartbeatResponse) ProtoMessage()               {}
-func (*HeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 func (m *HeartbeatResponse) GetResponse() *Response {
 	if m != nil {
@@ -1864,7 +2043,7 @@ type FindInstancesRequest struct {
 func (m *FindInstancesRequest) Reset()                    { *m = FindInstancesRequest{} }
 func (m *FindInstancesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*FindInstancesRequest) ProtoMessage()               {}
-func (*FindInstancesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*FindInstancesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *FindInstancesRequest) GetConsumerServiceId() string {
 	if m != nil {
@@ -1909,7 +2088,7 @@ type FindInstancesResponse struct {
 func (m *FindInstancesResponse) Reset()                    { *m = FindInstancesResponse{} }
 func (m *FindInstancesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*FindInstancesResponse) ProtoMessage()               {}
-func (*FindInstancesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*FindInstancesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *FindInstancesResponse) GetResponse() *Response {
 	if m != nil {
@@ -1935,7 +2114,7 @@ type GetOneInstanceRequest struct {
 func (m *GetOneInstanceRequest) Reset()                    { *m = GetOneInstanceRequest{} }
 func (m *GetOneInstanceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetOneInstanceRequest) ProtoMessage()               {}
-func (*GetOneInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*GetOneInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 func (m *GetOneInstanceRequest) GetConsumerServiceId() string {
 	if m != nil {
@@ -1973,7 +2152,7 @@ type GetOneInstanceResponse struct {
 func (m *GetOneInstanceResponse) Reset()                    { *m = GetOneInstanceResponse{} }
 func (m *GetOneInstanceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetOneInstanceResponse) ProtoMessage()               {}
-func (*GetOneInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*GetOneInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 func (m *GetOneInstanceResponse) GetResponse() *Response {
 	if m != nil {
@@ -1998,7 +2177,7 @@ type GetInstancesRequest struct {
 func (m *GetInstancesRequest) Reset()                    { *m = GetInstancesRequest{} }
 func (m *GetInstancesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetInstancesRequest) ProtoMessage()               {}
-func (*GetInstancesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*GetInstancesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *GetInstancesRequest) GetConsumerServiceId() string {
 	if m != nil {
@@ -2029,7 +2208,7 @@ type GetInstancesResponse struct {
 func (m *GetInstancesResponse) Reset()                    { *m = GetInstancesResponse{} }
 func (m *GetInstancesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetInstancesResponse) ProtoMessage()               {}
-func (*GetInstancesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*GetInstancesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *GetInstancesResponse) GetResponse() *Response {
 	if m != nil {
@@ -2054,7 +2233,7 @@ type UpdateInstanceStatusRequest struct {
 func (m *UpdateInstanceStatusRequest) Reset()                    { *m = UpdateInstanceStatusRequest{} }
 func (m *UpdateInstanceStatusRequest) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateInstanceStatusRequest) ProtoMessage()               {}
-func (*UpdateInstanceStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*UpdateInstanceStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 func (m *UpdateInstanceStatusRequest) GetServiceId() string {
 	if m != nil {
@@ -2084,7 +2263,7 @@ type UpdateInstanceStatusResponse struct {
 func (m *UpdateInstanceStatusResponse) Reset()                    { *m = UpdateInstanceStatusResponse{} }
 func (m *UpdateInstanceStatusResponse) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateInstanceStatusResponse) ProtoMessage()               {}
-func (*UpdateInstanceStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*UpdateInstanceStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *UpdateInstanceStatusResponse) GetResponse() *Response {
 	if m != nil {
@@ -2102,7 +2281,7 @@ type UpdateInstancePropsRequest struct {
 func (m *UpdateInstancePropsRequest) Reset()                    { *m = UpdateInstancePropsRequest{} }
 func (m *UpdateInstancePropsRequest) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateInstancePropsRequest) ProtoMessage()               {}
-func (*UpdateInstancePropsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*UpdateInstancePropsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
 func (m *UpdateInstancePropsRequest) GetServiceId() string {
 	if m != nil {
@@ -2132,7 +2311,7 @@ type UpdateInstancePropsResponse struct {
 func (m *UpdateInstancePropsResponse) Reset()                    { *m = UpdateInstancePropsResponse{} }
 func (m *UpdateInstancePropsResponse) String() string            { return proto1.CompactTextString(m) }
 func (*UpdateInstancePropsResponse) ProtoMessage()               {}
-func (*UpdateInstancePropsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*UpdateInstancePropsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 func (m *UpdateInstancePropsResponse) GetResponse() *Response {
 	if m != nil {
@@ -2148,7 +2327,7 @@ type WatchInstanceRequest struct {
 func (m *WatchInstanceRequest) Reset()                    { *m = WatchInstanceRequest{} }
 func (m *WatchInstanceRequest) String() string            { return proto1.CompactTextString(m) }
 func (*WatchInstanceRequest) ProtoMessage()               {}
-func (*WatchInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*WatchInstanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 func (m *WatchInstanceRequest) GetSelfServiceId() string {
 	if m != nil {
@@ -2167,7 +2346,7 @@ type WatchInstanceResponse struct {
 func (m *WatchInstanceResponse) Reset()                    { *m = WatchInstanceResponse{} }
 func (m *WatchInstanceResponse) String() string            { return proto1.CompactTextString(m) }
 func (*WatchInstanceResponse) ProtoMessage()               {}
-func (*WatchInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*WatchInstanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 func (m *WatchInstanceResponse) GetResponse() *Response {
 	if m != nil {
@@ -2205,7 +2384,7 @@ type GetSchemaRequest struct {
 func (m *GetSchemaRequest) Reset()                    { *m = GetSchemaRequest{} }
 func (m *GetSchemaRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetSchemaRequest) ProtoMessage()               {}
-func (*GetSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 func (m *GetSchemaRequest) GetServiceId() string {
 	if m != nil {
@@ -2229,7 +2408,7 @@ type GetAllSchemaRequest struct {
 func (m *GetAllSchemaRequest) Reset()                    { *m = GetAllSchemaRequest{} }
 func (m *GetAllSchemaRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetAllSchemaRequest) ProtoMessage()               {}
-func (*GetAllSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*GetAllSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *GetAllSchemaRequest) GetServiceId() string {
 	if m != nil {
@@ -2249,12 +2428,13 @@ type GetSchemaResponse struct {
 	Response      *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	Schema        string    `protobuf:"bytes,2,opt,name=schema" json:"schema,omitempty"`
 	SchemaSummary string    `protobuf:"bytes,3,opt,name=schemaSummary" json:"schemaSummary,omitempty"`
+	SchemaType    string    `protobuf:"bytes,4,opt,name=schemaType" json:"schemaType,omitempty"`
 }
 
 func (m *GetSchemaResponse) Reset()                    { *m = GetSchemaResponse{} }
 func (m *GetSchemaResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetSchemaResponse) ProtoMessage()               {}
-func (*GetSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *GetSchemaResponse) GetResponse() *Response {
 	if m != nil {
@@ -2277,6 +2457,13 @@ func (m *GetSchemaResponse) GetSchemaSummary() string {
 	return ""
 }
 
+func (m *GetSchemaResponse) GetSchemaType() string {
+	if m != nil {
+		return m.SchemaType
+	}
+	return ""
+}
+
 type GetAllSchemaResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	Schemas  []*Schema `protobuf:"bytes,2,rep,name=schemas" json:"schemas,omitempty"`
@@ -2285,7 +2472,7 @@ type GetAllSchemaResponse struct {
 func (m *GetAllSchemaResponse) Reset()                    { *m = GetAllSchemaResponse{} }
 func (m *GetAllSchemaResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetAllSchemaResponse) ProtoMessage()               {}
-func (*GetAllSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*GetAllSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *GetAllSchemaResponse) GetResponse() *Response {
 	if m != nil {
@@ -2309,7 +2496,7 @@ type DeleteSchemaRequest struct {
 func (m *DeleteSchemaRequest) Reset()                    { *m = DeleteSchemaRequest{} }
 func (m *DeleteSchemaRequest) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteSchemaRequest) ProtoMessage()               {}
-func (*DeleteSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*DeleteSchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 func (m *DeleteSchemaRequest) GetServiceId() string {
 	if m != nil {
@@ -2332,7 +2519,7 @@ type DeleteSchemaResponse struct {
 func (m *DeleteSchemaResponse) Reset()                    { *m = DeleteSchemaResponse{} }
 func (m *DeleteSchemaResponse) String() string            { return proto1.CompactTextString(m) }
 func (*DeleteSchemaResponse) ProtoMessage()               {}
-func (*DeleteSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*DeleteSchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *DeleteSchemaResponse) GetResponse() *Response {
 	if m != nil {
@@ -2351,7 +2538,7 @@ type ModifySchemaRequest struct {
 func (m *ModifySchemaRequest) Reset()                    { *m = ModifySchemaRequest{} }
 func (m *ModifySchemaRequest) String() string            { return proto1.CompactTextString(m) }
 func (*ModifySchemaRequest) ProtoMessage()               {}
-func (*ModifySchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*ModifySchemaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *ModifySchemaRequest) GetServiceId() string {
 	if m != nil {
@@ -2388,7 +2575,7 @@ type ModifySchemaResponse struct {
 func (m *ModifySchemaResponse) Reset()                    { *m = ModifySchemaResponse{} }
 func (m *ModifySchemaResponse) String() string            { return proto1.CompactTextString(m) }
 func (*ModifySchemaResponse) ProtoMessage()               {}
-func (*ModifySchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*ModifySchemaResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *ModifySchemaResponse) GetResponse() *Response {
 	if m != nil {
@@ -2404,7 +2591,7 @@ type AddDependenciesRequest struct {
 func (m *AddDependenciesRequest) Reset()                    { *m = AddDependenciesRequest{} }
 func (m *AddDependenciesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*AddDependenciesRequest) ProtoMessage()               {}
-func (*AddDependenciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*AddDependenciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *AddDependenciesRequest) GetDependencies() []*ConsumerDependency {
 	if m != nil {
@@ -2420,7 +2607,7 @@ type AddDependenciesResponse struct {
 func (m *AddDependenciesResponse) Reset()                    { *m = AddDependenciesResponse{} }
 func (m *AddDependenciesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*AddDependenciesResponse) ProtoMessage()               {}
-func (*AddDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*AddDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *AddDependenciesResponse) GetResponse() *Response {
 	if m != nil {
@@ -2436,7 +2623,7 @@ type CreateDependenciesRequest struct {
 func (m *CreateDependenciesRequest) Reset()                    { *m = CreateDependenciesRequest{} }
 func (m *CreateDependenciesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*CreateDependenciesRequest) ProtoMessage()               {}
-func (*CreateDependenciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*CreateDependenciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *CreateDependenciesRequest) GetDependencies() []*ConsumerDependency {
 	if m != nil {
@@ -2454,7 +2641,7 @@ type ConsumerDependency struct {
 func (m *ConsumerDependency) Reset()                    { *m = ConsumerDependency{} }
 func (m *ConsumerDependency) String() string            { return proto1.CompactTextString(m) }
 func (*ConsumerDependency) ProtoMessage()               {}
-func (*ConsumerDependency) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*ConsumerDependency) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *ConsumerDependency) GetConsumer() *MicroServiceKey {
 	if m != nil {
@@ -2484,7 +2671,7 @@ type CreateDependenciesResponse struct {
 func (m *CreateDependenciesResponse) Reset()                    { *m = CreateDependenciesResponse{} }
 func (m *CreateDependenciesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*CreateDependenciesResponse) ProtoMessage()               {}
-func (*CreateDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*CreateDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 func (m *CreateDependenciesResponse) GetResponse() *Response {
 	if m != nil {
@@ -2502,7 +2689,7 @@ type GetDependenciesRequest struct {
 func (m *GetDependenciesRequest) Reset()                    { *m = GetDependenciesRequest{} }
 func (m *GetDependenciesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetDependenciesRequest) ProtoMessage()               {}
-func (*GetDependenciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+func (*GetDependenciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
 
 func (m *GetDependenciesRequest) GetServiceId() string {
 	if m != nil {
@@ -2533,7 +2720,7 @@ type GetConDependenciesResponse struct {
 func (m *GetConDependenciesResponse) Reset()                    { *m = GetConDependenciesResponse{} }
 func (m *GetConDependenciesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetConDependenciesResponse) ProtoMessage()               {}
-func (*GetConDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (*GetConDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 func (m *GetConDependenciesResponse) GetResponse() *Response {
 	if m != nil {
@@ -2557,7 +2744,7 @@ type GetProDependenciesResponse struct {
 func (m *GetProDependenciesResponse) Reset()                    { *m = GetProDependenciesResponse{} }
 func (m *GetProDependenciesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetProDependenciesResponse) ProtoMessage()               {}
-func (*GetProDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*GetProDependenciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
 func (m *GetProDependenciesResponse) GetResponse() *Response {
 	if m != nil {
@@ -2589,7 +2776,7 @@ type ServiceDetail struct {
 func (m *ServiceDetail) Reset()                    { *m = ServiceDetail{} }
 func (m *ServiceDetail) String() string            { return proto1.CompactTextString(m) }
 func (*ServiceDetail) ProtoMessage()               {}
-func (*ServiceDetail) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*ServiceDetail) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
 func (m *ServiceDetail) GetMicroService() *MicroService {
 	if m != nil {
@@ -2663,7 +2850,7 @@ type GetServiceDetailResponse struct {
 func (m *GetServiceDetailResponse) Reset()                    { *m = GetServiceDetailResponse{} }
 func (m *GetServiceDetailResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetServiceDetailResponse) ProtoMessage()               {}
-func (*GetServiceDetailResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*GetServiceDetailResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 func (m *GetServiceDetailResponse) GetResponse() *Response {
 	if m != nil {
@@ -2688,7 +2875,7 @@ type DelServicesRequest struct {
 func (m *DelServicesRequest) Reset()                    { *m = DelServicesRequest{} }
 func (m *DelServicesRequest) String() string            { return proto1.CompactTextString(m) }
 func (*DelServicesRequest) ProtoMessage()               {}
-func (*DelServicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*DelServicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 func (m *DelServicesRequest) GetServiceIds() []string {
 	if m != nil {
@@ -2713,7 +2900,7 @@ type DelServicesRspInfo struct {
 func (m *DelServicesRspInfo) Reset()                    { *m = DelServicesRspInfo{} }
 func (m *DelServicesRspInfo) String() string            { return proto1.CompactTextString(m) }
 func (*DelServicesRspInfo) ProtoMessage()               {}
-func (*DelServicesRspInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*DelServicesRspInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 func (m *DelServicesRspInfo) GetErrMessage() string {
 	if m != nil {
@@ -2738,7 +2925,7 @@ type DelServicesResponse struct {
 func (m *DelServicesResponse) Reset()                    { *m = DelServicesResponse{} }
 func (m *DelServicesResponse) String() string            { return proto1.CompactTextString(m) }
 func (*DelServicesResponse) ProtoMessage()               {}
-func (*DelServicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*DelServicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *DelServicesResponse) GetResponse() *Response {
 	if m != nil {
@@ -2761,7 +2948,7 @@ type GetAppsRequest struct {
 func (m *GetAppsRequest) Reset()                    { *m = GetAppsRequest{} }
 func (m *GetAppsRequest) String() string            { return proto1.CompactTextString(m) }
 func (*GetAppsRequest) ProtoMessage()               {}
-func (*GetAppsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+func (*GetAppsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 func (m *GetAppsRequest) GetEnvironment() string {
 	if m != nil {
@@ -2778,7 +2965,7 @@ type GetAppsResponse struct {
 func (m *GetAppsResponse) Reset()                    { *m = GetAppsResponse{} }
 func (m *GetAppsResponse) String() string            { return proto1.CompactTextString(m) }
 func (*GetAppsResponse) ProtoMessage()               {}
-func (*GetAppsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (*GetAppsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 func (m *GetAppsResponse) GetResponse() *Response {
 	if m != nil {
@@ -2797,6 +2984,7 @@ func (m *GetAppsResponse) GetAppIds() []string {
 func init() {
 	proto1.RegisterType((*ModifySchemasRequest)(nil), "com.huawei.paas.cse.serviceregistry.api.ModifySchemasRequest")
 	proto1.RegisterType((*Schema)(nil), "com.huawei.paas.cse.serviceregistry.api.Schema")
+	proto1.RegisterType((*SchemaRef)(nil), "com.huawei.paas.cse.serviceregistry.api.SchemaRef")
 	proto1.RegisterType((*ModifySchemasResponse)(nil), "com.huawei.paas.cse.serviceregistry.api.ModifySchemasResponse")
 	proto1.RegisterType((*HeartbeatSetRequest)(nil), "com.huawei.paas.cse.serviceregistry.api.HeartbeatSetRequest")
 	proto1.RegisterType((*HeartbeatSetElement)(nil), "com.huawei.paas.cse.serviceregistry.api.HeartbeatSetElement")
@@ -2810,6 +2998,7 @@ func init() {
 	proto1.RegisterType((*GetServicesInfoResponse)(nil), "com.huawei.paas.cse.serviceregistry.api.GetServicesInfoResponse")
 	proto1.RegisterType((*MicroServiceKey)(nil), "com.huawei.paas.cse.serviceregistry.api.MicroServiceKey")
 	proto1.RegisterType((*MicroService)(nil), "com.huawei.paas.cse.serviceregistry.api.MicroService")
+	proto1.RegisterType((*Deprecation)(nil), "com.huawei.paas.cse.serviceregistry.api.Deprecation")
 	proto1.RegisterType((*FrameWorkProperty)(nil), "com.huawei.paas.cse.serviceregistry.api.FrameWorkProperty")
 	proto1.RegisterType((*ServiceRule)(nil), "com.huawei.paas.cse.serviceregistry.api.ServiceRule")
 	proto1.RegisterType((*AddOrUpdateServiceRule)(nil), "com.huawei.paas.cse.serviceregistry.api.AddOrUpdateServiceRule")
@@ -4243,221 +4432,233 @@ var _GovernServiceCtrl_serviceDesc = grpc.ServiceDesc{
 func init() { proto1.RegisterFile("services.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 3447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0xdd, 0x8f, 0x1c, 0xc5,
-	0xb5, 0x57, 0xcd, 0xce, 0xec, 0xcc, 0x9c, 0xfd, 0xb0, 0xb7, 0xbc, 0xeb, 0x6d, 0x0f, 0x5c, 0xae,
-	0xd5, 0x42, 0xba, 0x3c, 0xa0, 0xbd, 0xb0, 0x5c, 0xc0, 0xd7, 0xd8, 0x98, 0xfd, 0x30, 0xf6, 0x1a,
-	0x8c, 0x4d, 0xcf, 0x62, 0x07, 0x48, 0x82, 0x7a, 0x67, 0x6a, 0x67, 0x1a, 0xcf, 0x74, 0x37, 0xdd,
-	0x3d, 0x6b, 0x46, 0x8a, 0x14, 0x81, 0x20, 0x21, 0x21, 0x81, 0xa0, 0x24, 0x4f, 0x3c, 0x24, 0x4a,
-	0xc2, 0x63, 0xa4, 0x24, 0x8a, 0x84, 0x22, 0x94, 0x28, 0x4a, 0x94, 0x37, 0x78, 0x8c, 0xf2, 0x94,
-	0xbc, 0x47, 0xca, 0x5b, 0xfe, 0x80, 0x44, 0xf5, 0xd1, 0xdd, 0xd5, 0x1f, 0xbb, 0x3b, 0xdd, 0x3d,
-	0x0d, 0xca, 0xd3, 0x76, 0x55, 0x4f, 0x9d, 0x3a, 0x55, 0xe7, 0xa3, 0xce, 0xf9, 0xd5, 0xd9, 0x86,
-	0x45, 0x97, 0x38, 0x07, 0x46, 0x87, 0xb8, 0x6b, 0xb6, 0x63, 0x79, 0x16, 0xfe, 0x9f, 0x8e, 0x35,
-	0x5c, 0xeb, 0x8f, 0xf4, 0xbb, 0xc4, 0x58, 0xb3, 0x75, 0xdd, 0x5d, 0xeb, 0xb8, 0x64, 0x4d, 0xfc,
-	0xc6, 0x21, 0x3d, 0xc3, 0xf5, 0x9c, 0xf1, 0x9a, 0x6e, 0x1b, 0xea, 0xd7, 0x61, 0xf9, 0xba, 0xd5,
-	0x35, 0xf6, 0xc7, 0xed, 0x4e, 0x9f, 0x0c, 0x75, 0x57, 0x23, 0xaf, 0x8d, 0x88, 0xeb, 0xe1, 0x7b,
-	0xa1, 0x29, 0x7e, 0xbe, 0xd3, 0x55, 0xd0, 0x59, 0xf4, 0x40, 0x53, 0x0b, 0x3b, 0xf0, 0x0e, 0xd4,
-	0x5d, 0xfe, 0x7b, 0xa5, 0x72, 0x76, 0xe6, 0x81, 0xb9, 0xf5, 0xff, 0x5d, 0x9b, 0x70, 0xc2, 0x35,
-	0x3e, 0x8f, 0xe6, 0x8f, 0x57, 0x6f, 0xc1, 0x2c, 0xef, 0xc2, 0x2d, 0x68, 0xf0, 0xce, 0x60, 0xc6,
-	0xa0, 0x8d, 0x15, 0xa8, 0xbb, 0xa3, 0xe1, 0x50, 0x77, 0xc6, 0x4a, 0x85, 0xbd, 0xf2, 0x9b, 0xf8,
-	0x34, 0xcc, 0xf2, 0x5f, 0x29, 0x33, 0xec, 0x85, 0x68, 0xa9, 0xfb, 0xb0, 0x12, 0x5b, 0x98, 0x6b,
-	0x5b, 0xa6, 0x4b, 0xf0, 0x75, 0x68, 0x38, 0xe2, 0x99, 0x4d, 0x33, 0xb7, 0xfe, 0xf0, 0xc4, 0xcc,
-	0xfb, 0x44, 0xb4, 0x80, 0x84, 0xfa, 0x1a, 0x9c, 0xba, 0x4a, 0x74, 0xc7, 0xdb, 0x23, 0xba, 0xd7,
-	0x26, 0x9e, 0xbf, 0x7f, 0x2f, 0x41, 0xd3, 0x30, 0x5d, 0x4f, 0x37, 0x3b, 0xc4, 0x55, 0x10, 0xdb,
-	0xa3, 0x0b, 0x13, 0x4f, 0x23, 0x13, 0xbc, 0x3c, 0x20, 0x43, 0x62, 0x7a, 0x5a, 0x48, 0x4e, 0x6d,
-	0x47, 0xa7, 0x14, 0xbf, 0x38, 0x46, 0x64, 0xf7, 0x01, 0xf8, 0x14, 0x76, 0xba, 0x62, 0x13, 0xa5,
-	0x1e, 0xf5, 0x13, 0x04, 0xcb, 0xd1, 0x85, 0x94, 0xb2, 0x5f, 0x78, 0x57, 0xde, 0x18, 0xae, 0x3c,
-	0x8f, 0x4d, 0x4c, 0x6f, 0x47, 0x8c, 0xbc, 0xba, 0xa7, 0xb9, 0x91, 0x2d, 0x19, 0xc2, 0x42, 0xe4,
-	0x5d, 0xb1, 0xcd, 0xa0, 0xef, 0x89, 0xe3, 0x5c, 0x27, 0xae, 0xab, 0xf7, 0x88, 0x50, 0x2c, 0xa9,
-	0x47, 0xdd, 0x82, 0x66, 0xdb, 0x6b, 0x73, 0x72, 0x78, 0x19, 0x6a, 0x1d, 0x6b, 0x64, 0x7a, 0x6c,
-	0x9a, 0x19, 0x8d, 0x37, 0xf0, 0x59, 0x98, 0xb3, 0xcc, 0x81, 0x61, 0x92, 0x2d, 0xf6, 0xae, 0xc2,
-	0xde, 0xc9, 0x5d, 0xea, 0x55, 0x80, 0xb6, 0xe7, 0x73, 0x7d, 0x08, 0x95, 0xfb, 0x61, 0x81, 0x3d,
-	0x6c, 0x8e, 0xb7, 0xad, 0xa1, 0x6e, 0x98, 0x82, 0x4e, 0xb4, 0x53, 0xfd, 0x2f, 0xa8, 0xb5, 0xbd,
-	0x0d, 0xdb, 0x4e, 0x27, 0xa2, 0xfe, 0x13, 0xd1, 0x99, 0x74, 0xcf, 0x70, 0x3d, 0xa3, 0xe3, 0xe2,
-	0xe7, 0xa0, 0xe1, 0x7b, 0x0b, 0x21, 0xd0, 0xf5, 0xc9, 0xad, 0xd7, 0x5f, 0xb5, 0x16, 0xd0, 0xc0,
-	0xcf, 0x47, 0x25, 0x4a, 0x09, 0x3e, 0x92, 0x81, 0xa0, 0xbf, 0x03, 0x92, 0x38, 0xf1, 0x26, 0x54,
-	0x75, 0xdb, 0x76, 0xd9, 0xce, 0xcf, 0xad, 0xaf, 0x65, 0xa0, 0xb6, 0x61, 0xdb, 0x1a, 0x1b, 0xab,
-	0xbe, 0x83, 0xe0, 0xf4, 0x15, 0xe2, 0xf3, 0xeb, 0xee, 0x98, 0xfb, 0x96, 0x6f, 0x9c, 0x0a, 0xd4,
-	0x2d, 0xdb, 0x33, 0x2c, 0x93, 0x9b, 0x66, 0x53, 0xf3, 0x9b, 0x74, 0x03, 0x75, 0xdb, 0x0e, 0x74,
-	0x82, 0x37, 0xa8, 0x2c, 0xc5, 0x6c, 0xcf, 0xe9, 0x43, 0x5f, 0x1f, 0xe4, 0x2e, 0xaa, 0x6e, 0x6c,
-	0xaf, 0x6f, 0x98, 0x83, 0xb1, 0x52, 0x3d, 0x8b, 0x1e, 0x68, 0x68, 0x61, 0x87, 0xfa, 0xd3, 0x0a,
-	0xac, 0x26, 0x58, 0x29, 0xc7, 0xbc, 0xba, 0xb0, 0xa4, 0x0f, 0x06, 0xfe, 0x4c, 0xdb, 0xc4, 0xd3,
-	0x8d, 0x41, 0x66, 0x33, 0x13, 0xc3, 0xf9, 0x68, 0x2d, 0x49, 0x10, 0xb7, 0x01, 0xdc, 0x40, 0xa1,
-	0x84, 0x94, 0xb2, 0xc8, 0xdc, 0x1f, 0xaa, 0x49, 0x64, 0xd4, 0xcf, 0x10, 0x9c, 0xb8, 0x6e, 0x74,
-	0x1c, 0x4b, 0x4c, 0xf6, 0x0c, 0x61, 0xde, 0xdd, 0x23, 0xa6, 0x2e, 0x34, 0xba, 0xa9, 0x89, 0x16,
-	0x95, 0xa0, 0xed, 0x58, 0xaf, 0x92, 0x8e, 0xe7, 0x9f, 0x07, 0xa2, 0x19, 0x4a, 0x70, 0xe6, 0x08,
-	0x09, 0x56, 0x93, 0x12, 0x54, 0xa0, 0x7e, 0x40, 0x1c, 0xd7, 0xb0, 0x4c, 0xa5, 0xc6, 0x29, 0x8a,
-	0x26, 0x1d, 0x4b, 0xcc, 0x03, 0xc3, 0xb1, 0x4c, 0xea, 0x66, 0x95, 0x59, 0x3e, 0x56, 0xea, 0x62,
-	0x73, 0x0e, 0x0c, 0xdd, 0x55, 0xea, 0x62, 0x4e, 0xda, 0x50, 0x3f, 0xad, 0xc3, 0xbc, 0xbc, 0x9e,
-	0x63, 0x7c, 0x52, 0x5e, 0xd5, 0x93, 0x18, 0xaf, 0x26, 0x18, 0xef, 0x12, 0xb7, 0xe3, 0x18, 0x4c,
-	0xb9, 0xc5, 0xb2, 0xe4, 0x2e, 0x3a, 0xe7, 0x80, 0x1c, 0x90, 0x81, 0x58, 0x14, 0x6f, 0xb0, 0xc3,
-	0x56, 0x9c, 0xee, 0x75, 0x6e, 0x1e, 0xa2, 0x89, 0xaf, 0x41, 0xcd, 0xd6, 0xbd, 0xbe, 0xab, 0x00,
-	0xd3, 0xa8, 0xff, 0xcb, 0xaa, 0x51, 0x37, 0x75, 0xaf, 0xaf, 0x71, 0x12, 0xec, 0xe0, 0xf6, 0x74,
-	0x6f, 0xe4, 0x2a, 0x0d, 0x71, 0x70, 0xb3, 0x16, 0x26, 0x00, 0xb6, 0x63, 0xd9, 0xc4, 0xf1, 0x0c,
-	0xe2, 0x2a, 0x4d, 0x36, 0xd1, 0xe5, 0x89, 0x27, 0x92, 0x37, 0x7c, 0xed, 0x66, 0x40, 0xe7, 0xb2,
-	0xe9, 0x39, 0x63, 0x4d, 0x22, 0x4c, 0x85, 0xe1, 0x19, 0x43, 0xe2, 0x7a, 0xfa, 0xd0, 0x56, 0xe6,
-	0xb8, 0x30, 0x82, 0x0e, 0x7c, 0x0b, 0x9a, 0xb6, 0x63, 0x1d, 0x18, 0x5d, 0xe2, 0xb8, 0xca, 0x3c,
-	0xe3, 0xe1, 0x5c, 0x2e, 0x1e, 0x9e, 0x21, 0x63, 0x2d, 0x24, 0x15, 0x6a, 0xca, 0x82, 0xa4, 0x29,
-	0x74, 0xc9, 0xcf, 0x6e, 0xb6, 0x3d, 0x47, 0xf7, 0x48, 0x6f, 0xac, 0x2c, 0x16, 0x59, 0x72, 0x48,
-	0x47, 0x2c, 0x39, 0xec, 0xc0, 0x2a, 0xcc, 0x0f, 0xad, 0xee, 0x6e, 0xb0, 0xea, 0x13, 0x8c, 0x87,
-	0x48, 0x5f, 0x5c, 0xd9, 0x4f, 0x26, 0x95, 0xfd, 0x3e, 0x00, 0x3e, 0x3d, 0x71, 0x36, 0xc7, 0xca,
-	0x12, 0x3f, 0x1b, 0xc3, 0x1e, 0xfc, 0x25, 0x68, 0xee, 0x3b, 0xfa, 0x90, 0xdc, 0xb5, 0x9c, 0x3b,
-	0x0a, 0x66, 0xae, 0xe1, 0xfc, 0xc4, 0x6b, 0x79, 0x9a, 0x8e, 0xbc, 0x6d, 0x39, 0x77, 0x84, 0xe8,
-	0xc6, 0x5a, 0x48, 0xac, 0x75, 0x11, 0x4e, 0xc4, 0x24, 0x8a, 0x4f, 0xc2, 0xcc, 0x1d, 0x32, 0x16,
-	0xc6, 0x44, 0x1f, 0xe9, 0x0e, 0x1f, 0xe8, 0x83, 0x11, 0xf1, 0xcd, 0x88, 0x35, 0xce, 0x57, 0xce,
-	0x21, 0x3a, 0x3c, 0xb6, 0x3b, 0x59, 0x86, 0xab, 0x1b, 0xb0, 0x94, 0xe0, 0x0e, 0x63, 0xa8, 0x9a,
-	0xd4, 0x2e, 0x39, 0x05, 0xf6, 0x2c, 0x1b, 0x64, 0x25, 0x62, 0x90, 0xea, 0x5f, 0x11, 0xcc, 0xf9,
-	0xe7, 0xe7, 0x68, 0x40, 0xa8, 0x09, 0x38, 0xa3, 0x41, 0xe8, 0x0d, 0x44, 0x8b, 0x46, 0xc2, 0xf4,
-	0x69, 0x77, 0x6c, 0xfb, 0x7c, 0x04, 0x6d, 0xaa, 0xb7, 0xba, 0xe7, 0x39, 0xc6, 0xde, 0xc8, 0xf3,
-	0xdd, 0x41, 0xd8, 0xc1, 0xfc, 0xa2, 0xee, 0x79, 0xc4, 0x09, 0x9c, 0x81, 0x68, 0x4e, 0xe0, 0x0c,
-	0x22, 0x16, 0x31, 0x1b, 0xb7, 0x88, 0xb8, 0xf2, 0xd4, 0x93, 0xca, 0xa3, 0xbe, 0x87, 0xe0, 0xf4,
-	0x46, 0xb7, 0x7b, 0xc3, 0x79, 0xc1, 0xee, 0xea, 0x1e, 0x91, 0x97, 0x2a, 0x2f, 0x09, 0x1d, 0xb5,
-	0xa4, 0xca, 0x11, 0x4b, 0x9a, 0x39, 0x72, 0x49, 0xd5, 0xc4, 0x92, 0xd4, 0xdf, 0x85, 0x1b, 0x4e,
-	0x5d, 0x0f, 0x15, 0x17, 0x75, 0x3e, 0xbe, 0xb8, 0xe8, 0x33, 0xfe, 0x2a, 0x34, 0x84, 0x5b, 0x18,
-	0x8b, 0x83, 0x72, 0x33, 0x8f, 0x5b, 0xf3, 0x9d, 0x8d, 0xb0, 0xbb, 0x80, 0x66, 0xeb, 0x09, 0x58,
-	0x88, 0xbc, 0xca, 0xa4, 0x74, 0xe7, 0xa0, 0x11, 0x44, 0x0a, 0x18, 0xaa, 0x1d, 0xab, 0xcb, 0xb7,
-	0xaf, 0xa6, 0xb1, 0x67, 0xba, 0x39, 0x43, 0x11, 0xa5, 0x0a, 0x5d, 0x13, 0x4d, 0xf5, 0x2f, 0x08,
-	0x4e, 0x5d, 0x21, 0xde, 0xe5, 0xd7, 0xa9, 0x5d, 0xd2, 0xf0, 0x4a, 0xc4, 0x3e, 0x18, 0xaa, 0x5e,
-	0x28, 0x04, 0xf6, 0x5c, 0xc2, 0xd1, 0x13, 0x39, 0xea, 0x6a, 0xf1, 0xa3, 0x4e, 0xce, 0xf4, 0x66,
-	0x63, 0x99, 0x5e, 0xcc, 0x01, 0xd5, 0x13, 0x0e, 0x48, 0xfd, 0x0d, 0x82, 0xe5, 0xe8, 0xca, 0xca,
-	0x09, 0xa5, 0x22, 0x6b, 0xa8, 0x1c, 0xb5, 0x86, 0x99, 0xc3, 0xb3, 0xd5, 0x6a, 0x24, 0x5b, 0x55,
-	0x7f, 0x39, 0x03, 0xcb, 0x5b, 0x0e, 0x91, 0x8c, 0x43, 0x88, 0xe5, 0x06, 0xd4, 0x05, 0x6d, 0xc1,
-	0xfa, 0xa3, 0xb9, 0xfc, 0xbf, 0xe6, 0x53, 0xc1, 0x2f, 0x40, 0x8d, 0x1a, 0x98, 0x9f, 0x63, 0x5d,
-	0x9a, 0x98, 0x5c, 0xba, 0x01, 0x6b, 0x9c, 0x1a, 0x7e, 0x19, 0xaa, 0x9e, 0xde, 0xa3, 0x31, 0x1f,
-	0xa5, 0x7a, 0x65, 0x62, 0xaa, 0x69, 0x8b, 0x5e, 0xdb, 0xd5, 0x7b, 0xe2, 0x64, 0x66, 0x44, 0xf1,
-	0xcb, 0x72, 0x26, 0x51, 0x65, 0x33, 0x5c, 0xcc, 0xb5, 0x0d, 0x29, 0x39, 0x45, 0xeb, 0x71, 0x68,
-	0x06, 0xf3, 0x65, 0xb2, 0xc1, 0xb7, 0x10, 0xac, 0xc4, 0xd8, 0xff, 0x02, 0x14, 0x4e, 0xbd, 0x06,
-	0xcb, 0xdb, 0x64, 0x40, 0x12, 0x9a, 0x73, 0x6c, 0x54, 0xb9, 0x6f, 0x39, 0x1d, 0xbe, 0xac, 0x86,
-	0xc6, 0x1b, 0xea, 0x3e, 0xac, 0xc4, 0x68, 0x95, 0x03, 0x8e, 0x3c, 0x0c, 0x4b, 0x61, 0xde, 0x33,
-	0x11, 0xc3, 0xea, 0xaf, 0x11, 0x60, 0x79, 0x4c, 0x39, 0x5b, 0x2d, 0x99, 0x5b, 0x65, 0x1a, 0xe6,
-	0xa6, 0x2e, 0xcb, 0x5c, 0xfb, 0x28, 0x9a, 0xfa, 0x31, 0x77, 0xc2, 0x61, 0x77, 0x39, 0xab, 0x79,
-	0x5e, 0xca, 0xe8, 0xb9, 0xb9, 0xe7, 0x5c, 0x4e, 0x40, 0x46, 0xfd, 0x07, 0x82, 0x33, 0x11, 0x27,
-	0x40, 0xcf, 0xb0, 0x09, 0xd1, 0x41, 0x27, 0x12, 0xc1, 0x73, 0x86, 0xb4, 0x89, 0x19, 0x3a, 0x74,
-	0xd6, 0xa3, 0xc2, 0xf9, 0x82, 0xb1, 0xa1, 0x7a, 0x07, 0x5a, 0x69, 0xf3, 0x96, 0x63, 0x15, 0x8f,
-	0xc9, 0xc0, 0x04, 0x75, 0xae, 0xee, 0xc4, 0xa6, 0xb1, 0x9a, 0x18, 0x58, 0x8e, 0x46, 0x5d, 0x8b,
-	0x9e, 0x1e, 0x99, 0x13, 0x3d, 0xe9, 0xc8, 0x50, 0x3f, 0x42, 0xa0, 0x24, 0xcf, 0x93, 0x89, 0x34,
-	0x29, 0x0c, 0x90, 0x2b, 0x91, 0x00, 0xb9, 0x0d, 0x55, 0xfa, 0x24, 0x90, 0x87, 0xc2, 0x67, 0x1b,
-	0x23, 0xa6, 0xbe, 0x1a, 0xd3, 0x78, 0xce, 0x66, 0x39, 0x2a, 0xf0, 0x5d, 0x1e, 0x29, 0x67, 0xd6,
-	0x81, 0x92, 0x8e, 0x75, 0xf5, 0x4d, 0x04, 0xab, 0x09, 0x7e, 0xca, 0x51, 0x2d, 0x05, 0xea, 0x1a,
-	0x93, 0x22, 0x5f, 0x43, 0x53, 0xf3, 0x9b, 0x6a, 0x1b, 0xce, 0x44, 0x4f, 0xa5, 0xc9, 0xb7, 0x45,
-	0x81, 0xba, 0x13, 0x25, 0x2a, 0x9a, 0xd4, 0xb2, 0xd3, 0x88, 0x96, 0x23, 0xd6, 0x47, 0x61, 0x25,
-	0x34, 0x50, 0x1a, 0x6d, 0x4c, 0x66, 0xd8, 0xff, 0x8a, 0x40, 0x95, 0x7c, 0x5c, 0x39, 0x9b, 0xff,
-	0x15, 0x11, 0xbe, 0x71, 0xed, 0xd9, 0x99, 0x98, 0x54, 0x3a, 0x77, 0xf1, 0x00, 0x2e, 0x7f, 0x8c,
-	0xf5, 0x0a, 0xac, 0x46, 0x74, 0x73, 0x57, 0xef, 0x4d, 0x26, 0x78, 0x31, 0x49, 0x25, 0x65, 0x92,
-	0x19, 0x69, 0x12, 0xd5, 0x88, 0xf9, 0x20, 0x36, 0x41, 0x39, 0x4a, 0xf0, 0x29, 0x82, 0x95, 0xd0,
-	0x96, 0x26, 0xd6, 0x02, 0xfc, 0xe5, 0x88, 0x6c, 0xae, 0x66, 0xb1, 0xec, 0xe4, 0x5c, 0xd3, 0x13,
-	0x4d, 0x4f, 0xf6, 0x54, 0x25, 0xea, 0xa6, 0xfa, 0x2c, 0x28, 0x11, 0x4b, 0x9d, 0x7c, 0xe7, 0x30,
-	0x54, 0xef, 0x90, 0xb1, 0x6f, 0xfa, 0xec, 0x99, 0x7a, 0xf3, 0x14, 0x6a, 0xe5, 0x70, 0x3e, 0x86,
-	0xb9, 0xab, 0x44, 0x1f, 0x78, 0xfd, 0xad, 0x3e, 0xe9, 0xdc, 0xa1, 0xec, 0x0c, 0xfd, 0x44, 0xbd,
-	0xa9, 0xb1, 0x67, 0x86, 0x3c, 0x58, 0x0e, 0x47, 0xab, 0x6b, 0x1a, 0x7b, 0xa6, 0x29, 0xa4, 0x61,
-	0x7a, 0xc4, 0x39, 0xd0, 0x07, 0x4c, 0x59, 0x6b, 0x5a, 0xd0, 0xa6, 0xf2, 0x60, 0xd8, 0x0b, 0x4b,
-	0x20, 0x6b, 0x1a, 0x6f, 0x50, 0xb9, 0x8d, 0x9c, 0x81, 0x48, 0xa8, 0xe9, 0xa3, 0xfa, 0xf7, 0x2a,
-	0x2c, 0xa7, 0x65, 0x3e, 0xb1, 0x2b, 0x2e, 0x94, 0xb8, 0xe2, 0x3a, 0x3a, 0xbb, 0xbd, 0x17, 0x9a,
-	0xc4, 0xec, 0xda, 0x96, 0x61, 0x7a, 0x3c, 0xd7, 0x6b, 0x6a, 0x61, 0x07, 0x65, 0xbc, 0x6f, 0xb9,
-	0x9e, 0x04, 0xa5, 0x07, 0x6d, 0x09, 0xd6, 0xad, 0x45, 0x60, 0xdd, 0x61, 0x24, 0x28, 0x9c, 0x65,
-	0x3a, 0x7e, 0xbd, 0x50, 0x72, 0x77, 0x24, 0xbc, 0x7b, 0x0b, 0xe6, 0xfa, 0xa1, 0x48, 0x18, 0x8c,
-	0x90, 0x25, 0x8c, 0x91, 0xc4, 0xa9, 0xc9, 0x84, 0xa2, 0x20, 0x59, 0x23, 0x0e, 0x92, 0xbd, 0x02,
-	0x8b, 0x5d, 0xdd, 0xd3, 0xb7, 0x08, 0x15, 0xe3, 0x8e, 0xb9, 0x6f, 0x29, 0x4d, 0x36, 0xf1, 0xe3,
-	0x13, 0x4f, 0xbc, 0x1d, 0x19, 0xae, 0xc5, 0xc8, 0x25, 0x50, 0x38, 0x48, 0x81, 0x70, 0x25, 0x54,
-	0x66, 0x2e, 0x82, 0xca, 0x14, 0x0d, 0x92, 0xf7, 0x60, 0x31, 0xca, 0x5e, 0x2a, 0xfc, 0x49, 0x63,
-	0x36, 0xd2, 0x0b, 0xd1, 0x4f, 0xd1, 0xc2, 0xf7, 0xc3, 0x82, 0x7e, 0xa0, 0x1b, 0x03, 0x7d, 0x6f,
-	0x40, 0x5e, 0xb2, 0x4c, 0xdf, 0x3f, 0x47, 0x3b, 0xd5, 0xdb, 0xb0, 0x9a, 0x26, 0xeb, 0x67, 0xc8,
-	0xb8, 0x98, 0x46, 0xab, 0x1e, 0xac, 0x6a, 0x02, 0xa4, 0x0e, 0xd0, 0x01, 0xe1, 0x5c, 0x5e, 0xa4,
-	0x76, 0xc8, 0xbb, 0x84, 0x37, 0x28, 0x88, 0x3a, 0x04, 0xe4, 0xd4, 0x6f, 0x21, 0x50, 0x92, 0xd3,
-	0x96, 0x73, 0xb6, 0x1f, 0x77, 0xc3, 0xff, 0x22, 0x9c, 0x79, 0xc1, 0x74, 0x0e, 0xd9, 0x83, 0x62,
-	0xc5, 0x03, 0x34, 0x7d, 0x4a, 0x21, 0x5d, 0x8e, 0xb7, 0xbd, 0x09, 0x27, 0x83, 0x42, 0x85, 0xe9,
-	0xb0, 0xbf, 0x07, 0x4b, 0x12, 0xc5, 0x72, 0xb8, 0xfe, 0x15, 0x82, 0xe5, 0xa7, 0x0d, 0xb3, 0xeb,
-	0xef, 0x4e, 0x70, 0xb4, 0x3d, 0x08, 0x4b, 0x1d, 0xcb, 0x74, 0x47, 0x43, 0xe2, 0xb4, 0x63, 0x4b,
-	0x48, 0xbe, 0xc8, 0x0d, 0xd5, 0x9e, 0x85, 0x39, 0xe1, 0x05, 0x68, 0x00, 0xec, 0x63, 0xe5, 0x52,
-	0x17, 0x03, 0x86, 0x69, 0xf8, 0x51, 0xe3, 0x87, 0x28, 0x7d, 0x56, 0xff, 0x88, 0x60, 0x25, 0xc6,
-	0x74, 0x39, 0xba, 0xfb, 0x72, 0xb2, 0x2a, 0x64, 0x6a, 0xc8, 0x9f, 0xfa, 0x31, 0x62, 0x61, 0xf9,
-	0x0d, 0x93, 0xc4, 0xb5, 0x3e, 0xdb, 0xde, 0x3f, 0x08, 0x4b, 0xfe, 0x4d, 0x5e, 0x3b, 0xe6, 0x68,
-	0x92, 0x2f, 0xf0, 0x1a, 0x60, 0xbf, 0x73, 0x27, 0x54, 0x3e, 0x2e, 0x9a, 0x94, 0x37, 0xc1, 0xfe,
-	0x57, 0xa5, 0xfd, 0xff, 0x03, 0x4f, 0x0c, 0x22, 0x9c, 0x97, 0x23, 0x00, 0xd9, 0x07, 0x56, 0xa6,
-	0xeb, 0x03, 0xdf, 0xe6, 0x20, 0x58, 0x41, 0xc5, 0xcf, 0xb6, 0xf9, 0x58, 0x82, 0xa9, 0xa5, 0xcd,
-	0x5c, 0x8e, 0xf2, 0xf1, 0x1f, 0xa8, 0xcb, 0x2e, 0xdc, 0xc3, 0xf3, 0x18, 0xff, 0x65, 0x9b, 0x85,
-	0x57, 0x53, 0xf1, 0x83, 0x52, 0xec, 0x36, 0x23, 0xc7, 0x6e, 0xea, 0x10, 0xee, 0x4d, 0x9f, 0xb4,
-	0x1c, 0x57, 0xf9, 0x5e, 0xc5, 0x47, 0xe3, 0xfc, 0xf9, 0x32, 0x80, 0x8f, 0xc7, 0xad, 0xd1, 0x8d,
-	0xc4, 0xa1, 0xfc, 0x1a, 0xa3, 0x9d, 0x11, 0x9c, 0x4c, 0x63, 0xab, 0x4c, 0x74, 0x72, 0x10, 0x17,
-	0x7a, 0xa9, 0xf0, 0xe4, 0x05, 0x58, 0xbe, 0xad, 0x7b, 0x9d, 0x7e, 0xdc, 0x59, 0xde, 0x0f, 0x0b,
-	0x2e, 0x19, 0xec, 0xc7, 0x6d, 0x35, 0xda, 0xa9, 0x7e, 0x54, 0x81, 0x95, 0xd8, 0xf0, 0x72, 0xcc,
-	0xec, 0x34, 0xcc, 0xea, 0x1d, 0x4f, 0x8a, 0x33, 0x79, 0x0b, 0x5f, 0xe3, 0x1b, 0xcb, 0xa1, 0xc1,
-	0xfc, 0x45, 0x1b, 0x4c, 0x24, 0xb2, 0x57, 0xac, 0x4e, 0xd7, 0x2b, 0x3e, 0x0b, 0x27, 0xaf, 0x10,
-	0x4f, 0x54, 0xc3, 0x4e, 0xa4, 0xd9, 0xf2, 0x8d, 0x63, 0x25, 0x7a, 0xe3, 0xa8, 0xb6, 0x99, 0x8b,
-	0xdd, 0x18, 0x0c, 0xb2, 0x10, 0xbc, 0x0f, 0xe0, 0xae, 0xe1, 0xf5, 0xf9, 0x10, 0x71, 0x41, 0x24,
-	0xf5, 0xa8, 0x3f, 0x46, 0xfc, 0xfa, 0x46, 0x90, 0x2c, 0x4d, 0x8c, 0x6e, 0xc8, 0x40, 0x50, 0xbf,
-	0xcb, 0xb4, 0x8d, 0x3d, 0xb5, 0xc5, 0x4d, 0xaa, 0x48, 0x17, 0x22, 0x9d, 0xea, 0x2f, 0xb8, 0x4f,
-	0x97, 0x16, 0x5e, 0x0e, 0x97, 0x53, 0x2c, 0x78, 0xbe, 0x01, 0xa7, 0x04, 0x30, 0x31, 0x25, 0xd9,
-	0x93, 0xe0, 0x62, 0xb0, 0xcc, 0x2d, 0x50, 0xdf, 0x40, 0x70, 0x4a, 0xae, 0xa8, 0x2e, 0xcc, 0xf8,
-	0x61, 0xa5, 0xdb, 0x47, 0x5c, 0x9f, 0x93, 0x68, 0xb5, 0x7a, 0x79, 0x78, 0xce, 0xe9, 0x8d, 0x6e,
-	0x77, 0x9b, 0xd8, 0xc4, 0xec, 0x12, 0xb3, 0x63, 0x84, 0x31, 0xcb, 0x2b, 0x30, 0xdf, 0x95, 0xba,
-	0x45, 0x65, 0xf7, 0x13, 0x93, 0x5f, 0x83, 0x8b, 0xb8, 0x26, 0xa0, 0x3d, 0xd6, 0x22, 0x04, 0xd5,
-	0x3e, 0xc3, 0xe1, 0xa3, 0x53, 0x97, 0xb3, 0xc8, 0xaf, 0xc1, 0x19, 0x7e, 0xab, 0xfd, 0x85, 0xac,
-	0xf3, 0x6f, 0x08, 0x70, 0xf2, 0x47, 0x78, 0x17, 0x1a, 0x7e, 0xe8, 0x27, 0xd6, 0x98, 0xdf, 0x83,
-	0x07, 0x94, 0xa2, 0xd5, 0x7c, 0x95, 0xe9, 0x55, 0xf3, 0xb5, 0xa0, 0x61, 0x1d, 0x10, 0xc7, 0x31,
-	0xba, 0x3c, 0xe7, 0x6a, 0x68, 0x41, 0x9b, 0xa6, 0xc4, 0x69, 0xdb, 0x5b, 0x8e, 0x2c, 0x4d, 0x96,
-	0x26, 0xa4, 0x09, 0xf2, 0xd8, 0x13, 0xc0, 0xd5, 0x87, 0x44, 0xaa, 0x2d, 0x6f, 0x68, 0x52, 0x0f,
-	0xb5, 0x50, 0xd3, 0x6a, 0x93, 0xc1, 0xbe, 0x58, 0x9e, 0x68, 0xa9, 0xbf, 0x47, 0xd0, 0xba, 0x42,
-	0xbc, 0x2d, 0xcb, 0xfc, 0x1c, 0x56, 0x87, 0xdb, 0x49, 0xf1, 0xe5, 0xbc, 0xdf, 0x0e, 0xe9, 0xf8,
-	0x4b, 0xb8, 0xe9, 0x58, 0x9f, 0xd3, 0x12, 0x7c, 0x6d, 0x2c, 0xba, 0x84, 0x80, 0x8e, 0xfa, 0x93,
-	0x59, 0x58, 0x88, 0x94, 0x6a, 0xe3, 0x17, 0x61, 0x7e, 0x28, 0xfd, 0xb8, 0x58, 0x29, 0x51, 0x84,
-	0x54, 0xa9, 0x59, 0x0d, 0x7e, 0x1e, 0xe6, 0xc4, 0xa9, 0x60, 0xee, 0x5b, 0x7e, 0x54, 0x9e, 0xf9,
-	0x88, 0x95, 0x69, 0x84, 0x37, 0xd8, 0xd5, 0xc2, 0x37, 0xd8, 0x51, 0x05, 0xac, 0x4d, 0x47, 0x01,
-	0xa3, 0x2a, 0x31, 0x3b, 0x1d, 0x95, 0xc0, 0xbb, 0x22, 0xef, 0xad, 0x33, 0x7a, 0x4f, 0xe5, 0xab,
-	0xf8, 0x4f, 0xd4, 0x65, 0xad, 0xc3, 0xb2, 0xac, 0x0b, 0xb7, 0x38, 0x6a, 0xe4, 0x2a, 0x0d, 0x96,
-	0x5d, 0xa7, 0xbe, 0xc3, 0xd7, 0xa1, 0xce, 0x6a, 0xfb, 0x3b, 0xae, 0xc0, 0xc0, 0x73, 0xfd, 0x7f,
-	0x80, 0x4f, 0x23, 0xff, 0xf5, 0xd5, 0x27, 0x08, 0x94, 0xf0, 0xf6, 0x52, 0xfc, 0x4b, 0x43, 0x49,
-	0x56, 0x7e, 0x33, 0x5e, 0x55, 0x94, 0xf7, 0x5f, 0x2e, 0x82, 0xb2, 0xa2, 0x6b, 0x80, 0xb7, 0xc9,
-	0x20, 0x56, 0x56, 0xc4, 0xdc, 0xb6, 0xef, 0xc3, 0xfd, 0x7f, 0x61, 0x91, 0x7a, 0x0e, 0x29, 0xfa,
-	0xd2, 0xa2, 0xb4, 0x5c, 0x9b, 0x41, 0xf8, 0xd1, 0x7f, 0x75, 0x42, 0xf1, 0x7f, 0x75, 0x3a, 0x06,
-	0x55, 0xff, 0x2d, 0x62, 0xd1, 0x6c, 0xd9, 0x05, 0x4e, 0xb7, 0x13, 0x05, 0x4e, 0x93, 0x87, 0x22,
-	0xc9, 0x35, 0x4b, 0x65, 0x4e, 0xeb, 0xb0, 0x48, 0xd3, 0x07, 0x3b, 0x44, 0x17, 0x62, 0xf5, 0xa7,
-	0x28, 0x59, 0x7f, 0xfa, 0x3a, 0x9c, 0x08, 0xc6, 0x94, 0x97, 0xda, 0xda, 0x76, 0x58, 0xcc, 0x20,
-	0x5a, 0xeb, 0x7f, 0xbe, 0x27, 0x28, 0x67, 0xde, 0xf2, 0x9c, 0x01, 0x7e, 0x0b, 0x41, 0x8d, 0xbc,
-	0x6e, 0xb8, 0x1e, 0xbe, 0x90, 0xe5, 0x26, 0x3f, 0x5e, 0x13, 0xdc, 0xba, 0x98, 0x73, 0xb4, 0x60,
-	0xf7, 0x9b, 0x08, 0x66, 0x3b, 0x2c, 0xd6, 0xc1, 0x17, 0x0b, 0x15, 0x84, 0xb6, 0x9e, 0xcc, 0x3b,
-	0x5c, 0xe2, 0xa4, 0xcb, 0x72, 0xa1, 0x0c, 0x9c, 0xa4, 0x55, 0x55, 0x66, 0xe0, 0x24, 0xbd, 0x90,
-	0xf2, 0x0d, 0x04, 0xb3, 0x3d, 0x86, 0xdc, 0xe2, 0xf3, 0x39, 0xaa, 0x2c, 0x7c, 0x36, 0x9e, 0xc8,
-	0x35, 0x56, 0xf0, 0xf0, 0x0e, 0x82, 0xb9, 0x5e, 0x58, 0x7d, 0x88, 0xf3, 0x10, 0xf3, 0xed, 0xa2,
-	0x75, 0x21, 0xdf, 0x60, 0xc1, 0xca, 0x87, 0x08, 0x4e, 0x8e, 0x18, 0x84, 0x15, 0xe2, 0x60, 0x78,
-	0xb3, 0x78, 0x4d, 0x60, 0x6b, 0xab, 0x10, 0x0d, 0xc1, 0xdd, 0x77, 0x10, 0xd4, 0xf5, 0x6e, 0x97,
-	0x5d, 0x83, 0x5c, 0xca, 0x51, 0x77, 0x21, 0x17, 0x2a, 0xb5, 0x9e, 0xca, 0x4f, 0x40, 0x62, 0xa7,
-	0x47, 0xbc, 0x8c, 0xec, 0xa4, 0x97, 0x14, 0x66, 0x60, 0xe7, 0xb0, 0xd2, 0xc2, 0xef, 0x23, 0x00,
-	0x2e, 0x3b, 0xc6, 0xd1, 0x46, 0xbe, 0x1d, 0x97, 0x8a, 0xfe, 0x5a, 0x9b, 0x45, 0x48, 0x08, 0xae,
-	0x7e, 0x88, 0x00, 0xb8, 0xa9, 0x33, 0xae, 0x36, 0x73, 0xda, 0xab, 0xbc, 0x55, 0x5b, 0x85, 0x68,
-	0x08, 0xbe, 0xbe, 0xcd, 0x75, 0x89, 0x06, 0x2b, 0xf8, 0xc9, 0x62, 0x35, 0x3c, 0xad, 0x4b, 0xb9,
-	0xc7, 0x4b, 0xcc, 0xf4, 0x88, 0x97, 0x91, 0x99, 0xd4, 0x12, 0xb6, 0xd6, 0xa5, 0x82, 0xc5, 0x62,
-	0xf8, 0x7b, 0x08, 0x9a, 0x5c, 0x8f, 0x76, 0xf5, 0x1e, 0x7e, 0x2a, 0x9f, 0x0e, 0x84, 0x85, 0x61,
-	0xad, 0x8d, 0x02, 0x14, 0x24, 0xd5, 0xe6, 0x4a, 0xc4, 0xb6, 0x68, 0x23, 0x9f, 0x02, 0xc8, 0xbb,
-	0xb4, 0x59, 0x84, 0x84, 0xe0, 0xea, 0x1b, 0x08, 0x16, 0x7a, 0x3e, 0xee, 0xca, 0x82, 0xb4, 0xff,
-	0xcf, 0xb4, 0xf7, 0x32, 0x3c, 0xd7, 0x3a, 0x9f, 0x67, 0xa8, 0x60, 0xe4, 0x7d, 0x04, 0x27, 0x7b,
-	0x12, 0xba, 0xca, 0x78, 0xc9, 0x74, 0x10, 0xc4, 0x11, 0xe9, 0x6c, 0xa1, 0x46, 0x12, 0xd6, 0x7d,
-	0x17, 0xc1, 0x7c, 0x57, 0x02, 0x3b, 0x33, 0x70, 0x93, 0x02, 0xba, 0xb6, 0x2e, 0xe6, 0x1c, 0x2d,
-	0x71, 0x33, 0x94, 0xf0, 0xc8, 0x0c, 0xdc, 0xa4, 0x20, 0xa9, 0x19, 0xb8, 0x49, 0x05, 0x41, 0xdf,
-	0x43, 0xb0, 0x20, 0x73, 0xe3, 0xe2, 0x7c, 0x04, 0xdd, 0xec, 0x31, 0x50, 0xfa, 0x97, 0x36, 0x7e,
-	0x86, 0xe0, 0xbf, 0xf5, 0x28, 0x98, 0xf9, 0xb4, 0xe5, 0xc8, 0xa9, 0xab, 0x9b, 0xed, 0xb8, 0x4d,
-	0x01, 0xb8, 0xb2, 0x1d, 0xb7, 0xa9, 0x50, 0xcf, 0xcf, 0x11, 0xa8, 0x9d, 0x04, 0x54, 0x97, 0xe0,
-	0x74, 0x33, 0x63, 0x6c, 0x9a, 0xc6, 0xec, 0x56, 0x21, 0x1a, 0x82, 0xdf, 0x1f, 0x21, 0x58, 0xed,
-	0x31, 0xe4, 0x8a, 0x21, 0x09, 0xf2, 0x6f, 0xb2, 0x85, 0x0b, 0xc5, 0x38, 0x3c, 0x02, 0x3c, 0x13,
-	0x1c, 0x26, 0xf0, 0xdd, 0xcf, 0x9f, 0xc3, 0xc3, 0x10, 0xca, 0x77, 0x11, 0x2c, 0x76, 0x65, 0x07,
-	0x9c, 0x25, 0x3a, 0x4e, 0x66, 0xe4, 0xad, 0x0b, 0xf9, 0x06, 0x73, 0x6e, 0xd6, 0x3f, 0x9b, 0x83,
-	0x53, 0x31, 0x74, 0x8c, 0xe5, 0x77, 0xef, 0x23, 0x9a, 0x57, 0xf2, 0xaa, 0xaa, 0x0c, 0x07, 0xe6,
-	0x21, 0x75, 0x6e, 0x19, 0x0e, 0xcc, 0x43, 0x4b, 0xd6, 0x68, 0xd4, 0x35, 0x0a, 0x2a, 0xbd, 0xb2,
-	0x44, 0xf0, 0x87, 0x55, 0x9e, 0x65, 0x89, 0xe0, 0x0f, 0x2f, 0x31, 0x7b, 0x13, 0x41, 0xb3, 0xef,
-	0x97, 0x70, 0x65, 0x38, 0x2e, 0xe3, 0x85, 0x64, 0x19, 0x8e, 0xcb, 0x64, 0xc5, 0xd8, 0xdb, 0x08,
-	0xaa, 0xfb, 0x86, 0xd9, 0xcd, 0xe0, 0x77, 0xd3, 0x2a, 0xc2, 0x32, 0xf8, 0xdd, 0xf4, 0xda, 0x2c,
-	0x7a, 0x2c, 0xf5, 0xa4, 0x42, 0x97, 0x6c, 0x47, 0x76, 0x82, 0x9d, 0x8b, 0x39, 0x47, 0x0b, 0x6e,
-	0x3e, 0x40, 0xb0, 0xd8, 0x8b, 0xd4, 0x30, 0x65, 0x0b, 0x45, 0x93, 0x65, 0x5b, 0xd9, 0x42, 0xd1,
-	0xb4, 0xe2, 0xa9, 0x0f, 0x11, 0xcc, 0xf3, 0x50, 0x94, 0x57, 0xb2, 0xe0, 0xed, 0x9c, 0x15, 0x20,
-	0x91, 0xea, 0x9b, 0xd6, 0xe5, 0x82, 0x54, 0x04, 0x77, 0x1f, 0x21, 0x50, 0x46, 0x89, 0x7a, 0x0f,
-	0x91, 0x34, 0x6f, 0x4d, 0xa1, 0x56, 0xa5, 0xb5, 0x5d, 0x8c, 0x48, 0x88, 0x2f, 0xd4, 0xee, 0xea,
-	0x5e, 0xa7, 0x9f, 0x41, 0xe1, 0xd3, 0x2a, 0x4b, 0x32, 0x28, 0x7c, 0x6a, 0x65, 0xc9, 0x43, 0x88,
-	0xa9, 0x7c, 0x5f, 0xfa, 0x7a, 0x15, 0xce, 0xf7, 0xb1, 0xad, 0xec, 0x2a, 0x9f, 0xf6, 0xc9, 0xac,
-	0xf5, 0x3f, 0xcd, 0xc0, 0xd2, 0x15, 0xeb, 0x80, 0x38, 0xa6, 0x8c, 0xd6, 0x7d, 0xc0, 0xa3, 0xe9,
-	0xe8, 0x8d, 0x4d, 0x11, 0x70, 0x68, 0x23, 0xc7, 0xd8, 0x18, 0x00, 0xfe, 0x03, 0x04, 0x27, 0x7a,
-	0xd1, 0x2f, 0x13, 0xe5, 0x82, 0x1c, 0xe4, 0xcf, 0x2b, 0xe5, 0x82, 0x1c, 0xa2, 0x1f, 0x45, 0x7a,
-	0x8b, 0xb3, 0xb5, 0x61, 0xdb, 0x03, 0xa3, 0xa3, 0xf3, 0x4f, 0x33, 0x3d, 0x9e, 0x29, 0x73, 0x08,
-	0x11, 0xdd, 0xd6, 0xb9, 0xec, 0x03, 0x39, 0x1b, 0x9b, 0x0f, 0xc1, 0xa4, 0xdf, 0xd1, 0x7b, 0xa9,
-	0xc6, 0xbe, 0xbb, 0xb7, 0x37, 0xcb, 0xfe, 0x3c, 0xf2, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42,
-	0x8a, 0xd2, 0x2c, 0x90, 0x4f, 0x00, 0x00,
+	// 3635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0xcd, 0x8f, 0xdc, 0xc6,
+	0x95, 0x07, 0x7b, 0xba, 0xa7, 0xbb, 0x5f, 0x6b, 0x24, 0x4d, 0xcd, 0x48, 0xa2, 0x68, 0xaf, 0x56,
+	0x20, 0x0c, 0xac, 0x0f, 0xc6, 0xac, 0x3d, 0x5e, 0xdb, 0x5a, 0x7d, 0xcf, 0x87, 0x3e, 0x6d, 0x59,
+	0x32, 0x7b, 0x2c, 0xad, 0xed, 0xdd, 0x35, 0xa8, 0xee, 0x9a, 0x6e, 0x5a, 0xdd, 0x24, 0x4d, 0x56,
+	0x8f, 0xdc, 0xc0, 0x02, 0x0b, 0x1b, 0xf6, 0xda, 0x1b, 0x07, 0x76, 0x8c, 0x24, 0xa7, 0x1c, 0x02,
+	0x24, 0xf6, 0x31, 0x40, 0x10, 0x04, 0x08, 0x02, 0x23, 0x41, 0x90, 0x20, 0x97, 0x20, 0x3e, 0x04,
+	0x41, 0x90, 0x5b, 0xee, 0x01, 0x72, 0xcb, 0x1f, 0x90, 0xa0, 0x3e, 0x48, 0x16, 0x3f, 0x66, 0xa6,
+	0x49, 0x0e, 0x6d, 0xe4, 0x34, 0xac, 0xe2, 0xd4, 0xaf, 0x5e, 0xbd, 0x7a, 0xef, 0xd5, 0x7b, 0xaf,
+	0x1e, 0x1b, 0x0e, 0xfb, 0xd8, 0xdb, 0xb1, 0x7a, 0xd8, 0x5f, 0x71, 0x3d, 0x87, 0x38, 0xe8, 0x5f,
+	0x7a, 0xce, 0x78, 0x65, 0x38, 0x31, 0x1f, 0x62, 0x6b, 0xc5, 0x35, 0x4d, 0x7f, 0xa5, 0xe7, 0xe3,
+	0x15, 0xf1, 0x3f, 0x1e, 0x1e, 0x58, 0x3e, 0xf1, 0xa6, 0x2b, 0xa6, 0x6b, 0xe9, 0xff, 0x0b, 0xcb,
+	0xb7, 0x9c, 0xbe, 0xb5, 0x3d, 0xed, 0xf6, 0x86, 0x78, 0x6c, 0xfa, 0x06, 0x7e, 0x73, 0x82, 0x7d,
+	0x82, 0x1e, 0x85, 0xb6, 0xf8, 0xf7, 0x1b, 0x7d, 0x55, 0x39, 0xad, 0x3c, 0xde, 0x36, 0xa2, 0x0e,
+	0x74, 0x03, 0x9a, 0x3e, 0xff, 0x7f, 0xb5, 0x76, 0x7a, 0xee, 0xf1, 0xce, 0xea, 0xbf, 0xae, 0xcc,
+	0x38, 0xe1, 0x0a, 0x9f, 0xc7, 0x08, 0xc6, 0xeb, 0xbf, 0x51, 0x60, 0x9e, 0xf7, 0x21, 0x0d, 0x5a,
+	0xbc, 0x37, 0x9c, 0x32, 0x6c, 0x23, 0x15, 0x9a, 0xfe, 0x64, 0x3c, 0x36, 0xbd, 0xa9, 0x5a, 0x63,
+	0xaf, 0x82, 0x26, 0x3a, 0x0e, 0xf3, 0xfc, 0xbf, 0xd4, 0x39, 0xf6, 0x42, 0xb4, 0xd0, 0x29, 0x00,
+	0xfe, 0xb4, 0x35, 0x75, 0xb1, 0x5a, 0x67, 0xef, 0xa4, 0x1e, 0x64, 0x00, 0xf8, 0x43, 0xd3, 0xc3,
+	0xfd, 0x7b, 0x16, 0x19, 0xaa, 0x0d, 0xb6, 0x8c, 0xd5, 0xbc, 0xcb, 0xc0, 0xdb, 0x86, 0x84, 0xa2,
+	0x5f, 0x81, 0x76, 0xf8, 0x62, 0x1f, 0x16, 0xca, 0x8b, 0xad, 0xc5, 0x17, 0xab, 0x6f, 0xc3, 0xb1,
+	0xc4, 0xa6, 0xf8, 0xae, 0x63, 0xfb, 0x18, 0xdd, 0x82, 0x96, 0x27, 0x9e, 0x19, 0x62, 0x67, 0xf5,
+	0xa9, 0x99, 0x29, 0x0e, 0x40, 0x8c, 0x10, 0x42, 0x7f, 0x13, 0x96, 0xae, 0x63, 0xd3, 0x23, 0xf7,
+	0xb1, 0x49, 0xba, 0x98, 0x04, 0x7b, 0xff, 0x2a, 0xb4, 0x2d, 0xdb, 0x27, 0xa6, 0xdd, 0xc3, 0xbe,
+	0xaa, 0x30, 0xc6, 0x9c, 0x9f, 0x79, 0x1a, 0x19, 0xf0, 0xca, 0x08, 0x8f, 0xb1, 0x4d, 0x8c, 0x08,
+	0x4e, 0xef, 0xc2, 0x52, 0xc6, 0x7f, 0xec, 0xc3, 0xab, 0x53, 0x00, 0x01, 0x42, 0xc8, 0x2d, 0xa9,
+	0x47, 0xff, 0x5c, 0x81, 0xe5, 0xf8, 0x42, 0x2a, 0xe1, 0x17, 0xda, 0x92, 0x19, 0xc3, 0x05, 0xff,
+	0xd9, 0x99, 0xf1, 0x6e, 0x88, 0x91, 0xd7, 0xef, 0x1b, 0x7e, 0x8c, 0x25, 0x63, 0x58, 0x88, 0xbd,
+	0x2b, 0xc7, 0x0c, 0xfa, 0x1e, 0x7b, 0xde, 0x2d, 0xec, 0xfb, 0xe6, 0x00, 0x0b, 0x9d, 0x90, 0x7a,
+	0xf4, 0x0d, 0x68, 0x77, 0x49, 0x97, 0xc3, 0xa1, 0x65, 0x68, 0xf4, 0x9c, 0x89, 0x4d, 0xd8, 0x34,
+	0x73, 0x06, 0x6f, 0xa0, 0xd3, 0xd0, 0x71, 0xec, 0x91, 0x65, 0xe3, 0x0d, 0xf6, 0xae, 0xc6, 0xde,
+	0xc9, 0x5d, 0xfa, 0x75, 0x80, 0x2e, 0x09, 0xa8, 0xde, 0x05, 0xe5, 0x31, 0x58, 0x60, 0x0f, 0xeb,
+	0xd3, 0x4d, 0x67, 0x6c, 0x5a, 0xb6, 0xc0, 0x89, 0x77, 0xea, 0xff, 0x04, 0x8d, 0x2e, 0x59, 0x73,
+	0xdd, 0x6c, 0x10, 0xfd, 0xaf, 0x0a, 0x9d, 0xc9, 0x24, 0x96, 0x4f, 0xac, 0x9e, 0x8f, 0x5e, 0x84,
+	0x56, 0x60, 0xe9, 0xc4, 0x86, 0xe6, 0x50, 0xd9, 0x60, 0xd5, 0x46, 0x88, 0x81, 0x5e, 0x8a, 0xef,
+	0x28, 0x05, 0x7c, 0x3a, 0x07, 0x60, 0xc0, 0x01, 0x69, 0x3b, 0xd1, 0x3a, 0xd4, 0x4d, 0xd7, 0xf5,
+	0x19, 0xe7, 0x3b, 0xab, 0x2b, 0x39, 0xd0, 0xd6, 0x5c, 0xd7, 0x60, 0x63, 0xf5, 0x0f, 0x14, 0x38,
+	0x7e, 0x0d, 0x07, 0xf4, 0xfa, 0x37, 0xec, 0x6d, 0x27, 0x50, 0x4e, 0x15, 0x9a, 0x8e, 0x4b, 0x2c,
+	0xc7, 0xe6, 0xaa, 0xd9, 0x36, 0x82, 0x26, 0x65, 0xa0, 0xe9, 0xba, 0xa1, 0x4c, 0xf0, 0x06, 0xdd,
+	0x4b, 0x31, 0xdb, 0x8b, 0xe6, 0x38, 0x90, 0x07, 0xb9, 0x8b, 0x8a, 0x1b, 0xe3, 0xf5, 0x6d, 0x7b,
+	0x34, 0x65, 0x76, 0xb2, 0x65, 0x44, 0x1d, 0xfa, 0xf7, 0x6b, 0x70, 0x22, 0x45, 0x4a, 0x35, 0xea,
+	0xd5, 0x87, 0x45, 0x73, 0x34, 0x0a, 0x66, 0xda, 0xc4, 0xc4, 0xb4, 0x46, 0xb9, 0xd5, 0x4c, 0x0c,
+	0xe7, 0xa3, 0x8d, 0x34, 0x20, 0xea, 0x02, 0xf8, 0xa1, 0x40, 0xa9, 0x73, 0xb9, 0xf7, 0x3c, 0x18,
+	0x6a, 0x48, 0x30, 0xfa, 0x17, 0x0a, 0x1c, 0xb9, 0x65, 0xf5, 0x3c, 0x47, 0x4c, 0xf6, 0x3c, 0x66,
+	0x07, 0x13, 0xc1, 0xb6, 0x29, 0x24, 0xba, 0x6d, 0x88, 0x16, 0xdd, 0x41, 0xd7, 0x73, 0xde, 0xc0,
+	0x3d, 0x12, 0x1c, 0x65, 0xa2, 0x19, 0xed, 0xe0, 0xdc, 0x1e, 0x3b, 0x58, 0x4f, 0xef, 0xa0, 0x0a,
+	0xcd, 0x1d, 0xec, 0xf9, 0x96, 0x63, 0xab, 0x0d, 0x8e, 0x28, 0x9a, 0x74, 0x2c, 0xb6, 0x77, 0x2c,
+	0xcf, 0xb1, 0xa9, 0x99, 0x55, 0xe7, 0xf9, 0x58, 0xa9, 0x8b, 0xcd, 0x39, 0xb2, 0x4c, 0x5f, 0x6d,
+	0x8a, 0x39, 0x69, 0x43, 0xff, 0xb4, 0x05, 0x87, 0xe4, 0xf5, 0xec, 0x63, 0x93, 0x8a, 0x8a, 0x9e,
+	0x44, 0x78, 0x3d, 0x45, 0x78, 0x1f, 0xfb, 0x3d, 0xcf, 0x72, 0x49, 0xb4, 0x2c, 0xb9, 0x8b, 0xce,
+	0x39, 0xc2, 0x3b, 0x78, 0x24, 0x16, 0xc5, 0x1b, 0x14, 0x31, 0xf0, 0x4c, 0x9a, 0x5c, 0x3d, 0x44,
+	0x13, 0xdd, 0x84, 0x86, 0x6b, 0x92, 0xa1, 0xaf, 0x02, 0x93, 0xa8, 0x7f, 0xcb, 0x2b, 0x51, 0x77,
+	0x4c, 0x32, 0x34, 0x38, 0x04, 0xf3, 0x39, 0x88, 0x49, 0x26, 0xbe, 0xda, 0x12, 0x3e, 0x07, 0x6b,
+	0x21, 0x0c, 0xe0, 0x7a, 0x8e, 0x8b, 0x3d, 0x62, 0x61, 0x5f, 0x6d, 0xb3, 0x89, 0xae, 0xcc, 0x3c,
+	0x91, 0xcc, 0xf0, 0x95, 0x3b, 0x21, 0xce, 0x15, 0x9b, 0x78, 0x53, 0x43, 0x02, 0xa6, 0x9b, 0x41,
+	0xac, 0x31, 0xf6, 0x89, 0x39, 0x76, 0xd5, 0x0e, 0xdf, 0x8c, 0xb0, 0x03, 0xdd, 0x85, 0xb6, 0xeb,
+	0x39, 0x3b, 0x56, 0x1f, 0x7b, 0xbe, 0x7a, 0x88, 0xd1, 0x70, 0xa6, 0x10, 0x0d, 0xcf, 0xe3, 0xa9,
+	0x11, 0x41, 0x45, 0x92, 0xb2, 0x20, 0x49, 0x0a, 0x5d, 0xf2, 0x0b, 0xeb, 0x5d, 0xe2, 0x99, 0x04,
+	0x0f, 0xa6, 0xea, 0xe1, 0x32, 0x4b, 0x8e, 0x70, 0xc4, 0x92, 0xa3, 0x0e, 0xa4, 0xc3, 0xa1, 0xb1,
+	0xd3, 0xdf, 0x0a, 0x57, 0x7d, 0x84, 0xd1, 0x10, 0xeb, 0x4b, 0x0a, 0xfb, 0xd1, 0xb4, 0xb0, 0x9f,
+	0x02, 0xe0, 0xd3, 0x63, 0x6f, 0x7d, 0xaa, 0x2e, 0xf2, 0xb3, 0x31, 0xea, 0x41, 0xff, 0x01, 0xed,
+	0x6d, 0xcf, 0x1c, 0xe3, 0x87, 0x8e, 0xf7, 0x40, 0x45, 0xcc, 0x34, 0x9c, 0x9d, 0x79, 0x2d, 0x57,
+	0xe9, 0xc8, 0x7b, 0x8e, 0xf7, 0x40, 0x6c, 0xdd, 0xd4, 0x88, 0xc0, 0xd0, 0x5d, 0x2a, 0xcf, 0xae,
+	0x87, 0x7b, 0x26, 0x93, 0xe7, 0xa5, 0xd3, 0x4a, 0x2e, 0x19, 0xdc, 0x8c, 0xc6, 0x1a, 0x32, 0x90,
+	0x76, 0x01, 0x8e, 0x24, 0x24, 0x05, 0x1d, 0x85, 0xb9, 0x07, 0x78, 0x2a, 0x94, 0x94, 0x3e, 0xd2,
+	0x9d, 0xdb, 0x31, 0x47, 0x13, 0x1c, 0xa8, 0x27, 0x6b, 0x9c, 0xad, 0x9d, 0x51, 0xe8, 0xf0, 0x04,
+	0xd7, 0xf3, 0x0c, 0xd7, 0xdf, 0x57, 0xa0, 0x23, 0x91, 0x46, 0xff, 0x93, 0x6a, 0x02, 0x16, 0xa3,
+	0x79, 0x83, 0x79, 0xe2, 0x13, 0xdb, 0xc7, 0x64, 0xd3, 0x24, 0x01, 0x88, 0xd4, 0x43, 0xf7, 0xcd,
+	0xc3, 0xee, 0xc8, 0xec, 0x31, 0x5f, 0x30, 0xb0, 0x13, 0x52, 0x57, 0xd2, 0x1a, 0xd4, 0x53, 0xd6,
+	0x40, 0x5f, 0x83, 0xc5, 0x14, 0xff, 0x11, 0x82, 0xba, 0x6d, 0x8e, 0x03, 0x6a, 0xd8, 0xb3, 0x6c,
+	0x72, 0x6a, 0x31, 0x93, 0xa3, 0xff, 0xbe, 0x06, 0x9d, 0xc0, 0x43, 0x98, 0x8c, 0x30, 0x55, 0x72,
+	0x6f, 0x32, 0x8a, 0xec, 0x9d, 0x68, 0x51, 0xcf, 0x9d, 0x3e, 0xb1, 0xb0, 0x42, 0x78, 0xee, 0x41,
+	0x9b, 0x6a, 0xa6, 0x49, 0x88, 0x67, 0xdd, 0x9f, 0x90, 0xc0, 0xe0, 0x45, 0x1d, 0xcc, 0xf2, 0x9b,
+	0x84, 0x60, 0x2f, 0x34, 0x77, 0xa2, 0x39, 0x83, 0xb9, 0x8b, 0xe9, 0xfc, 0x7c, 0x52, 0xe7, 0x93,
+	0xea, 0xd1, 0xcc, 0x50, 0x0f, 0x0d, 0x5a, 0xae, 0x67, 0x39, 0x9e, 0x45, 0xa6, 0xcc, 0x6c, 0x35,
+	0x8c, 0xb0, 0x4d, 0xe7, 0x17, 0xa4, 0xb0, 0x65, 0xb5, 0xf9, 0xfc, 0x52, 0x17, 0x9d, 0x7f, 0xc7,
+	0x1c, 0x59, 0xfd, 0xab, 0x9e, 0x33, 0x56, 0x81, 0xcf, 0x1f, 0x76, 0x30, 0xae, 0xd2, 0xc6, 0x96,
+	0x23, 0xec, 0x51, 0xd0, 0xd4, 0xdf, 0xad, 0xc1, 0xf1, 0xb5, 0x7e, 0xff, 0xb6, 0xf7, 0xb2, 0xdb,
+	0x37, 0x09, 0x96, 0x19, 0x2c, 0x33, 0x52, 0xd9, 0x8b, 0x91, 0xb5, 0x3d, 0x18, 0x39, 0xb7, 0x27,
+	0x23, 0xd3, 0x92, 0x12, 0x63, 0x43, 0x63, 0x6f, 0x36, 0xcc, 0xef, 0xc3, 0x86, 0xe6, 0x1e, 0x6c,
+	0x68, 0xc5, 0xd9, 0xf0, 0x73, 0x05, 0x3a, 0xd2, 0x41, 0x42, 0x45, 0x93, 0x1e, 0x25, 0x81, 0x68,
+	0xd2, 0x67, 0xf4, 0xdf, 0x94, 0x32, 0x2e, 0xba, 0xc2, 0xed, 0x59, 0x2f, 0x72, 0x48, 0x05, 0x47,
+	0x87, 0xb0, 0xa2, 0x21, 0xa6, 0x76, 0x0e, 0x16, 0x62, 0xaf, 0x72, 0xa9, 0xfa, 0x19, 0x68, 0x85,
+	0x7e, 0x1f, 0x82, 0x7a, 0xcf, 0xe9, 0xf3, 0x4d, 0x6b, 0x18, 0xec, 0x99, 0x2e, 0x7d, 0x2c, 0x62,
+	0x0e, 0xa1, 0x57, 0xa2, 0xa9, 0xff, 0x51, 0x81, 0xa5, 0x6b, 0x98, 0x5c, 0x79, 0xcb, 0xf2, 0x09,
+	0xb6, 0x7b, 0x38, 0xf0, 0x64, 0x11, 0xd4, 0x49, 0xb4, 0xf5, 0xec, 0xb9, 0x02, 0x47, 0x22, 0xe6,
+	0xb8, 0x34, 0xf6, 0x8a, 0xc2, 0xe7, 0x13, 0x29, 0x87, 0xc4, 0x71, 0xd2, 0x4c, 0x1d, 0x27, 0xfa,
+	0x4f, 0x15, 0x58, 0x8e, 0xaf, 0xac, 0x1a, 0xc7, 0x38, 0xb6, 0x86, 0xda, 0x5e, 0x6b, 0x98, 0xdb,
+	0x3d, 0x6d, 0x52, 0x8f, 0xa5, 0x4d, 0xf4, 0xdf, 0xcd, 0xc1, 0xf2, 0x86, 0x87, 0x25, 0x95, 0x14,
+	0xdb, 0x72, 0x1b, 0x9a, 0x02, 0x5b, 0x90, 0xfe, 0x4c, 0xa1, 0xd3, 0xdc, 0x08, 0x50, 0xd0, 0xcb,
+	0xd0, 0xa0, 0x6a, 0x1d, 0x44, 0xcc, 0x97, 0x66, 0x86, 0xcb, 0x36, 0x1b, 0x06, 0x47, 0x43, 0xaf,
+	0x41, 0x9d, 0x98, 0x03, 0xea, 0xc1, 0x53, 0xd4, 0x6b, 0x33, 0xa3, 0x66, 0x2d, 0x7a, 0x65, 0xcb,
+	0x1c, 0x08, 0x3f, 0x8b, 0x81, 0xa2, 0xd7, 0xe4, 0xb8, 0xb0, 0xce, 0x66, 0xb8, 0x50, 0x88, 0x0d,
+	0x59, 0x11, 0xe2, 0x29, 0x80, 0x9e, 0x87, 0xfb, 0xd8, 0x26, 0x96, 0x39, 0x12, 0x32, 0x29, 0xf5,
+	0x68, 0xcf, 0x41, 0x3b, 0xa4, 0x27, 0x97, 0x8e, 0x7e, 0xaa, 0xc0, 0xb1, 0xc4, 0xf2, 0xbe, 0x0a,
+	0x81, 0x8c, 0xaf, 0x6f, 0x2e, 0xb9, 0x3e, 0xfd, 0x26, 0x2c, 0x6f, 0xe2, 0x11, 0x4e, 0x49, 0xde,
+	0xbe, 0x31, 0xc6, 0xb6, 0xe3, 0xf5, 0xf8, 0xb2, 0x5b, 0x06, 0x6f, 0xd0, 0x54, 0x59, 0x02, 0xab,
+	0x9a, 0x54, 0xd9, 0x53, 0xb0, 0x18, 0x45, 0xc1, 0x33, 0x11, 0xac, 0xff, 0x58, 0x01, 0x24, 0x8f,
+	0xa9, 0x66, 0x2b, 0x24, 0x75, 0xad, 0x1d, 0x84, 0xba, 0xea, 0xcb, 0x32, 0xd5, 0x41, 0x3e, 0x58,
+	0xff, 0x09, 0x37, 0xe2, 0x51, 0x77, 0x35, 0xab, 0x79, 0x49, 0xca, 0xef, 0x70, 0x73, 0x51, 0x70,
+	0x39, 0x21, 0x8c, 0xfe, 0x17, 0x05, 0x4e, 0xc6, 0x8c, 0x08, 0x3d, 0x03, 0x67, 0xcc, 0x73, 0x7b,
+	0xb1, 0x78, 0x8e, 0x13, 0x64, 0xcc, 0x4c, 0xd0, 0xae, 0xb3, 0xee, 0x15, 0xdc, 0x95, 0xf4, 0xe8,
+	0xf5, 0x07, 0xa0, 0x65, 0xcd, 0x5b, 0x8d, 0x56, 0x3c, 0x2b, 0xa7, 0xa9, 0xa8, 0x71, 0xf6, 0x67,
+	0x56, 0x8d, 0x13, 0xa9, 0x81, 0xd5, 0x48, 0xd4, 0xcd, 0xf8, 0xe9, 0x93, 0x3b, 0xec, 0x97, 0x8e,
+	0x1c, 0xfd, 0x33, 0x05, 0xd4, 0xf4, 0x79, 0x34, 0x93, 0x24, 0x45, 0xc1, 0x44, 0x2d, 0x16, 0x4c,
+	0x74, 0xa1, 0x4e, 0x9f, 0x44, 0x1e, 0xaa, 0xf4, 0xd9, 0xc8, 0xc0, 0xf4, 0x37, 0xe0, 0x64, 0xfa,
+	0x55, 0x45, 0x22, 0xf0, 0x99, 0xc2, 0xfc, 0xfb, 0xdc, 0x32, 0x50, 0x95, 0x5b, 0x70, 0x1c, 0xe6,
+	0xfb, 0xde, 0xd4, 0x98, 0xf0, 0xc8, 0xa0, 0x65, 0x88, 0x96, 0xfe, 0x8e, 0x02, 0x27, 0x52, 0x74,
+	0x56, 0x23, 0x72, 0x2a, 0x34, 0x0d, 0xb6, 0xbb, 0x7c, 0x6d, 0x6d, 0x23, 0x68, 0xea, 0x5d, 0x38,
+	0x19, 0x3f, 0xad, 0x66, 0x67, 0x97, 0x0a, 0x4d, 0x2f, 0x0e, 0x2a, 0x9a, 0x54, 0xe3, 0xb3, 0x40,
+	0xab, 0xd9, 0xee, 0x67, 0xe0, 0x58, 0xa4, 0xb8, 0xd4, 0x4b, 0x99, 0x4d, 0xe1, 0xff, 0x16, 0x4b,
+	0x68, 0xf3, 0x71, 0xd5, 0x30, 0xff, 0xbf, 0x84, 0x5b, 0xc8, 0xa5, 0xea, 0xc6, 0xcc, 0x50, 0xd9,
+	0xd4, 0x25, 0x1d, 0xc3, 0xe2, 0xbe, 0xd9, 0xeb, 0x70, 0x22, 0x26, 0xb3, 0x5b, 0xe6, 0x60, 0xb6,
+	0x8d, 0x17, 0x93, 0xd4, 0x32, 0x26, 0x99, 0x93, 0x26, 0xd1, 0x2d, 0x50, 0xd3, 0x13, 0x54, 0x23,
+	0x04, 0xbf, 0x55, 0xe0, 0x58, 0xa4, 0x4b, 0x33, 0x4b, 0x01, 0xfa, 0xcf, 0xd8, 0xde, 0x5c, 0xcf,
+	0xa3, 0xf1, 0xe9, 0xb9, 0x0e, 0x6e, 0x6b, 0x06, 0xb2, 0x05, 0xab, 0x50, 0x36, 0xf5, 0x17, 0x40,
+	0x8d, 0x69, 0xea, 0xec, 0x9c, 0x43, 0x50, 0x7f, 0x80, 0xa7, 0x81, 0xea, 0xb3, 0x67, 0x6a, 0xe5,
+	0x33, 0xd0, 0xaa, 0xa1, 0x7c, 0x0a, 0x9d, 0xeb, 0xd8, 0x1c, 0x91, 0xe1, 0xc6, 0x10, 0xf7, 0x1e,
+	0x50, 0x72, 0xc6, 0x41, 0x02, 0xa0, 0x6d, 0xb0, 0x67, 0xda, 0xe7, 0x3a, 0x1e, 0xbf, 0xd3, 0x68,
+	0x18, 0xec, 0x99, 0x86, 0xa6, 0x96, 0x4d, 0xb0, 0xb7, 0x23, 0xe2, 0x80, 0x86, 0x11, 0xb6, 0xe9,
+	0x7e, 0xb0, 0xfc, 0x15, 0x0b, 0x4c, 0x1b, 0x06, 0x6f, 0xd0, 0x7d, 0x9b, 0x78, 0x41, 0x50, 0x44,
+	0x1f, 0xf5, 0x3f, 0xd7, 0x61, 0x39, 0x2b, 0xa2, 0x4a, 0x5c, 0x84, 0x2a, 0xa9, 0x8b, 0xd0, 0xbd,
+	0x83, 0x94, 0x47, 0xa1, 0x8d, 0xed, 0xbe, 0xeb, 0x58, 0x36, 0xe1, 0x31, 0x64, 0xdb, 0x88, 0x3a,
+	0x28, 0xe1, 0x43, 0xc7, 0x27, 0xd2, 0x85, 0x4b, 0xd8, 0x96, 0x92, 0xff, 0x8d, 0x58, 0xf2, 0x7f,
+	0x1c, 0x73, 0x16, 0xe7, 0x99, 0x8c, 0xdf, 0x2a, 0x15, 0x34, 0xee, 0x79, 0x09, 0x70, 0x17, 0x3a,
+	0xc3, 0x68, 0x4b, 0x58, 0x7a, 0x22, 0x8f, 0x7b, 0x23, 0x6d, 0xa7, 0x21, 0x03, 0xc5, 0x13, 0x8d,
+	0xad, 0x64, 0xa2, 0xf1, 0x75, 0x38, 0xdc, 0x37, 0x89, 0xb9, 0x81, 0xe9, 0x36, 0xd2, 0xcb, 0x40,
+	0x96, 0x2b, 0xec, 0xac, 0x3e, 0x37, 0x7b, 0x2a, 0x3b, 0x36, 0xdc, 0x48, 0xc0, 0xa5, 0x32, 0x99,
+	0x90, 0x91, 0xc9, 0x94, 0xb2, 0x3d, 0x9d, 0x58, 0xb6, 0xa7, 0xac, 0xf3, 0x7c, 0x1f, 0x0e, 0xc7,
+	0xc9, 0xcb, 0x4c, 0x21, 0x53, 0x5f, 0x0e, 0x0f, 0xa2, 0x0c, 0xb2, 0x68, 0xd1, 0x0b, 0x6f, 0x73,
+	0xc7, 0xb4, 0x46, 0xe6, 0xfd, 0x11, 0x7e, 0xd5, 0xb1, 0x03, 0xfb, 0x1c, 0xef, 0xd4, 0xef, 0xc1,
+	0x89, 0xac, 0xbd, 0xa6, 0x37, 0x86, 0xa5, 0x24, 0x5a, 0x27, 0x70, 0xc2, 0x10, 0x57, 0x19, 0x01,
+	0x68, 0x60, 0x5c, 0x5e, 0xa1, 0x7a, 0xc8, 0xbb, 0x84, 0x35, 0x28, 0x99, 0xcd, 0x08, 0xe1, 0xf4,
+	0xff, 0x57, 0x40, 0x4d, 0x4f, 0x5b, 0xcd, 0xd9, 0xbe, 0x5f, 0x1d, 0xc8, 0x2b, 0x70, 0xf2, 0x65,
+	0xdb, 0xdb, 0x85, 0x07, 0xe5, 0x4a, 0x4c, 0x68, 0x58, 0x95, 0x01, 0x5d, 0x8d, 0xb5, 0xbd, 0x03,
+	0x47, 0xc3, 0x72, 0x96, 0x83, 0x21, 0xff, 0x3e, 0x2c, 0x4a, 0x88, 0xd5, 0x50, 0xfd, 0x23, 0x05,
+	0x96, 0xaf, 0x5a, 0x76, 0x3f, 0xe0, 0x4e, 0x78, 0xb4, 0x3d, 0x01, 0x8b, 0x3d, 0xc7, 0xf6, 0x27,
+	0x63, 0xec, 0x75, 0x13, 0x4b, 0x48, 0xbf, 0x28, 0x9c, 0x02, 0x3e, 0x0d, 0x1d, 0x61, 0x05, 0xa8,
+	0x03, 0x1c, 0x64, 0xfe, 0xa5, 0x2e, 0x84, 0x84, 0xfb, 0xd1, 0xe0, 0x87, 0x28, 0x7d, 0xd6, 0x7f,
+	0xa5, 0xc0, 0xb1, 0x04, 0xd1, 0xd5, 0xc8, 0xee, 0x6b, 0xe9, 0xda, 0xa1, 0x03, 0xcb, 0x28, 0xd2,
+	0xec, 0x0c, 0x75, 0xcb, 0x6f, 0xdb, 0x38, 0x29, 0xf5, 0xf9, 0x78, 0xff, 0x04, 0x2c, 0x06, 0xf7,
+	0xbd, 0xdd, 0x84, 0xa1, 0x49, 0xbf, 0x40, 0x2b, 0x80, 0x82, 0xce, 0x1b, 0x91, 0xf0, 0xf1, 0xad,
+	0xc9, 0x78, 0x13, 0xf2, 0xbf, 0x2e, 0xf1, 0xff, 0x97, 0x3c, 0x30, 0x88, 0x51, 0x5e, 0xcd, 0x06,
+	0xc8, 0x36, 0xb0, 0x76, 0xb0, 0x36, 0xf0, 0x3d, 0x9e, 0x1c, 0x2b, 0x29, 0xf8, 0xf9, 0x98, 0x8f,
+	0xa4, 0xf4, 0xb7, 0xc4, 0xcc, 0xe5, 0x38, 0x1d, 0xff, 0x80, 0xb2, 0xec, 0xc3, 0x23, 0x3c, 0x8e,
+	0x09, 0x5e, 0x76, 0x99, 0x7b, 0x75, 0x20, 0x76, 0x50, 0xf2, 0xdd, 0xe6, 0x64, 0xdf, 0x4d, 0x1f,
+	0xc3, 0xa3, 0xd9, 0x93, 0x56, 0x63, 0x2a, 0x3f, 0xaa, 0x81, 0x16, 0x9f, 0x2f, 0x47, 0x52, 0x72,
+	0xbf, 0x35, 0xfa, 0x31, 0x3f, 0x94, 0x5f, 0x8f, 0x74, 0x73, 0x26, 0x2d, 0xb3, 0xc8, 0xaa, 0x32,
+	0x6b, 0x39, 0x4a, 0x6e, 0x7a, 0xa5, 0x69, 0xcb, 0xf3, 0xb0, 0x7c, 0xcf, 0x24, 0xbd, 0x61, 0xd2,
+	0x58, 0x3e, 0x06, 0x0b, 0x3e, 0x1e, 0x6d, 0x27, 0x75, 0x35, 0xde, 0xa9, 0x7f, 0x56, 0x83, 0x63,
+	0x89, 0xe1, 0xd5, 0xa8, 0xd9, 0x71, 0x98, 0x37, 0x7b, 0x44, 0xf2, 0x33, 0x79, 0x0b, 0xdd, 0xe4,
+	0x8c, 0xe5, 0x29, 0xc3, 0xe2, 0xa5, 0x3d, 0x6c, 0x4b, 0x64, 0xab, 0x58, 0x3f, 0x58, 0xab, 0xf8,
+	0x02, 0x1c, 0xa5, 0x49, 0x15, 0x51, 0x0f, 0x3d, 0x8b, 0x64, 0xef, 0x55, 0x13, 0xdd, 0x65, 0x26,
+	0x76, 0x6d, 0x34, 0xca, 0x03, 0x78, 0x0a, 0xe0, 0xa1, 0x45, 0x86, 0x7c, 0x88, 0xb8, 0x38, 0x92,
+	0x7a, 0xf4, 0x5f, 0x28, 0xfc, 0x5a, 0x47, 0x40, 0x56, 0xb6, 0x8d, 0x7e, 0x44, 0x40, 0x54, 0xa0,
+	0x4e, 0xa5, 0x8d, 0x3d, 0x75, 0xc5, 0x0d, 0xad, 0x08, 0x17, 0x62, 0x9d, 0xfb, 0x95, 0xb1, 0xeb,
+	0x3f, 0xe4, 0x36, 0x5f, 0x62, 0x4c, 0x35, 0xab, 0x38, 0xc0, 0x92, 0xff, 0xdb, 0xb0, 0x24, 0x12,
+	0x17, 0x07, 0x24, 0x1b, 0x38, 0xbc, 0x50, 0xac, 0x92, 0x05, 0xfa, 0xdb, 0x0a, 0x2c, 0xc9, 0x75,
+	0xf9, 0xa5, 0x09, 0xdf, 0xf5, 0xdb, 0x85, 0xdd, 0xaf, 0xed, 0x71, 0xfc, 0x7b, 0x8d, 0xea, 0xf2,
+	0x3d, 0x34, 0x25, 0xb6, 0x89, 0x5d, 0x6c, 0xf7, 0xb1, 0xdd, 0xb3, 0x22, 0x9f, 0xe6, 0x75, 0x38,
+	0xd4, 0x97, 0xba, 0xc5, 0xf7, 0x01, 0xe7, 0x66, 0xbf, 0x7e, 0x17, 0x7e, 0x4f, 0x88, 0x3d, 0x35,
+	0x62, 0x80, 0xfa, 0x90, 0xe5, 0xe9, 0xe3, 0x53, 0x57, 0xb3, 0xc8, 0xff, 0x81, 0x93, 0xfc, 0xb6,
+	0xfc, 0x2b, 0x59, 0xe7, 0x9f, 0x14, 0x40, 0xe9, 0x7f, 0x42, 0x5b, 0xd0, 0x0a, 0x5c, 0x43, 0x55,
+	0x29, 0x69, 0xe1, 0x43, 0xa4, 0x78, 0x4d, 0x68, 0xed, 0xe0, 0x6a, 0x42, 0x35, 0x68, 0x39, 0x3b,
+	0xd8, 0xf3, 0xac, 0x3e, 0x16, 0xf7, 0x2d, 0x61, 0x9b, 0x86, 0xcc, 0x59, 0xec, 0xad, 0x66, 0x2f,
+	0x6d, 0x16, 0x46, 0x64, 0x6d, 0xe4, 0xbe, 0x27, 0x84, 0x6f, 0x8e, 0xb1, 0xf4, 0x85, 0x42, 0xcb,
+	0x90, 0x7a, 0xa8, 0x86, 0xda, 0x4e, 0x17, 0x8f, 0xb6, 0x83, 0xeb, 0x24, 0xde, 0xa2, 0x27, 0x87,
+	0x76, 0x0d, 0x93, 0x0d, 0xc7, 0xfe, 0x12, 0x56, 0x87, 0xba, 0xe9, 0xed, 0x2b, 0x78, 0x2f, 0x1e,
+	0xe1, 0x04, 0x4b, 0xb8, 0xe3, 0x39, 0x5f, 0xd2, 0x12, 0x02, 0x69, 0x2c, 0xbb, 0x84, 0x10, 0x47,
+	0xff, 0xde, 0x3c, 0x2c, 0xc4, 0x0a, 0xfe, 0xd1, 0x2b, 0x70, 0x68, 0x2c, 0xfd, 0x73, 0xb9, 0x12,
+	0xa6, 0x18, 0x54, 0xa5, 0x51, 0x0f, 0x7a, 0x09, 0x3a, 0xe2, 0x54, 0xb0, 0xb7, 0x9d, 0xc0, 0x6b,
+	0xcf, 0x7d, 0xc4, 0xca, 0x18, 0xd1, 0xcd, 0x77, 0xbd, 0xf4, 0xcd, 0x77, 0x5c, 0x00, 0x1b, 0x07,
+	0x23, 0x80, 0x71, 0x91, 0x98, 0x3f, 0x18, 0x91, 0x40, 0x5b, 0x22, 0x2e, 0x6e, 0x32, 0xbc, 0xcb,
+	0xc5, 0xbe, 0x1b, 0x49, 0xd5, 0x83, 0xad, 0xc2, 0xb2, 0x2c, 0x0b, 0x77, 0x79, 0x56, 0x89, 0x96,
+	0xff, 0xd3, 0xe8, 0x3b, 0xf3, 0x1d, 0xba, 0x05, 0x4d, 0xf6, 0x85, 0x48, 0xcf, 0x57, 0xdb, 0xc5,
+	0xbf, 0x32, 0x09, 0x30, 0x8a, 0x5f, 0x6f, 0x7d, 0xae, 0x80, 0x1a, 0xdd, 0x6e, 0xf2, 0x05, 0x56,
+	0xa5, 0xe5, 0x77, 0x92, 0xd5, 0x48, 0x45, 0x3f, 0xdc, 0x09, 0xcb, 0x91, 0x6e, 0x02, 0xda, 0xc4,
+	0xa3, 0x44, 0x39, 0x12, 0x33, 0xdb, 0x81, 0x0d, 0x0f, 0x3e, 0x84, 0x92, 0x7a, 0x76, 0x29, 0x16,
+	0x33, 0xe2, 0x58, 0xbe, 0xcb, 0x52, 0xfc, 0xf1, 0x0f, 0xe6, 0x94, 0xe4, 0x07, 0x73, 0xfb, 0x64,
+	0xdd, 0x7f, 0xa6, 0xc0, 0x92, 0x0c, 0x5a, 0x11, 0x63, 0xef, 0xa5, 0x0a, 0xa3, 0xce, 0xe5, 0xf8,
+	0x78, 0x20, 0xb9, 0x66, 0xa9, 0x3c, 0x6a, 0x15, 0x0e, 0xd3, 0xf0, 0xc1, 0x8d, 0xb2, 0x0f, 0x89,
+	0xba, 0x57, 0x25, 0x5d, 0xf7, 0xfa, 0x16, 0x1c, 0x09, 0xc7, 0x54, 0x17, 0xfa, 0xd2, 0xbc, 0x6f,
+	0x70, 0xe3, 0x29, 0x5a, 0xab, 0x7f, 0x78, 0x24, 0x2c, 0xa3, 0xde, 0x20, 0xde, 0x08, 0xbd, 0xab,
+	0x40, 0x03, 0xd3, 0xf2, 0x5b, 0x74, 0x3e, 0xcf, 0x4d, 0x7f, 0xb2, 0x16, 0x59, 0xbb, 0x50, 0x70,
+	0xb4, 0x20, 0xf7, 0x7d, 0x05, 0xe6, 0x7b, 0xcc, 0xd7, 0x41, 0x17, 0x4a, 0x15, 0xa2, 0x6a, 0x17,
+	0x8b, 0x0e, 0x97, 0x28, 0xe9, 0xb3, 0x58, 0x28, 0x07, 0x25, 0x59, 0xd5, 0x98, 0xda, 0xc5, 0xa2,
+	0xc3, 0x05, 0x25, 0x6f, 0x2b, 0x30, 0x3f, 0x60, 0x99, 0x5d, 0x74, 0xb6, 0x40, 0x15, 0x46, 0x40,
+	0xc6, 0xb9, 0x42, 0x63, 0x05, 0x0d, 0x1f, 0x28, 0xd0, 0x19, 0x84, 0xdd, 0x3e, 0x2a, 0x02, 0x16,
+	0xe8, 0x85, 0x76, 0xbe, 0xd8, 0x60, 0x41, 0xca, 0x77, 0x14, 0x38, 0x3a, 0x61, 0x29, 0xae, 0x28,
+	0x4f, 0x86, 0xd6, 0xcb, 0xd7, 0x12, 0x6a, 0x1b, 0xa5, 0x30, 0x04, 0x75, 0x5f, 0x57, 0xa0, 0x69,
+	0xf6, 0xfb, 0xec, 0x9a, 0xe4, 0x52, 0x81, 0xba, 0x0c, 0xb9, 0x90, 0x49, 0xbb, 0x5c, 0x1c, 0x40,
+	0x22, 0x67, 0x80, 0x49, 0x4e, 0x72, 0xb2, 0x4b, 0x11, 0xb5, 0xcb, 0xc5, 0x01, 0x04, 0x39, 0xdf,
+	0x54, 0x00, 0xf8, 0xde, 0x31, 0x8a, 0xd6, 0x8a, 0x71, 0x5c, 0x2a, 0x16, 0xd4, 0xd6, 0xcb, 0x40,
+	0x08, 0xaa, 0xbe, 0xad, 0x00, 0x70, 0x55, 0x67, 0x54, 0xad, 0x17, 0xd4, 0x57, 0x99, 0x55, 0x1b,
+	0xa5, 0x30, 0x04, 0x5d, 0x5f, 0xe3, 0xb2, 0x44, 0x9d, 0x15, 0x74, 0xb1, 0x5c, 0x8d, 0x8f, 0x76,
+	0xa9, 0xf0, 0x78, 0x89, 0x98, 0x01, 0x26, 0x39, 0x89, 0xc9, 0x2c, 0x71, 0xd3, 0x2e, 0x95, 0x2c,
+	0x26, 0x43, 0xdf, 0x50, 0xa0, 0xcd, 0xe5, 0x68, 0xcb, 0x1c, 0xa0, 0xcb, 0xc5, 0x64, 0x20, 0x2a,
+	0x1c, 0xd3, 0xd6, 0x4a, 0x20, 0x48, 0xa2, 0xcd, 0x85, 0x88, 0xb1, 0x68, 0xad, 0x98, 0x00, 0xc8,
+	0x5c, 0x5a, 0x2f, 0x03, 0x21, 0xa8, 0xfa, 0x3f, 0x05, 0x16, 0x06, 0x41, 0x5e, 0x96, 0x39, 0x69,
+	0xff, 0x9e, 0x8b, 0xf7, 0x72, 0x7a, 0x4e, 0x3b, 0x5b, 0x64, 0xa8, 0x20, 0xe4, 0x63, 0x05, 0x8e,
+	0x0e, 0xa4, 0xec, 0x2a, 0xa3, 0x25, 0xd7, 0x41, 0x90, 0xcc, 0x58, 0x6b, 0x17, 0x0a, 0x8e, 0x16,
+	0x14, 0x7d, 0xa8, 0xd0, 0xc4, 0x54, 0x94, 0xec, 0x44, 0xe7, 0xf3, 0xf2, 0xbb, 0x20, 0x35, 0x99,
+	0x19, 0x56, 0x4a, 0xcd, 0x58, 0xca, 0x47, 0xe6, 0xa0, 0x26, 0x23, 0x93, 0xaa, 0x5d, 0x28, 0x38,
+	0x5a, 0x50, 0xf3, 0x91, 0x02, 0x0b, 0x32, 0x35, 0x3e, 0x2a, 0x06, 0xe8, 0xe7, 0xf7, 0x81, 0xb2,
+	0x7f, 0xaf, 0xe5, 0x53, 0x05, 0xfe, 0xd9, 0x8c, 0x27, 0x33, 0xaf, 0x3a, 0x9e, 0x1c, 0xba, 0xfa,
+	0xf9, 0x8e, 0xdb, 0x8c, 0x04, 0x97, 0x76, 0xb9, 0x38, 0x80, 0x20, 0xf3, 0x07, 0x0a, 0xe8, 0xbd,
+	0x54, 0xaa, 0x2e, 0x45, 0xe9, 0x7a, 0x4e, 0xdf, 0x34, 0x8b, 0xd8, 0x8d, 0x52, 0x18, 0x82, 0xde,
+	0xef, 0x2a, 0x70, 0x62, 0xc0, 0x32, 0x57, 0x2c, 0x93, 0x20, 0xff, 0x4f, 0x3e, 0x77, 0xa1, 0x1c,
+	0x85, 0x7b, 0x24, 0xcf, 0x04, 0x85, 0xa9, 0xfc, 0xee, 0x97, 0x4f, 0xe1, 0x6e, 0x19, 0xca, 0x0f,
+	0x15, 0x38, 0xdc, 0x97, 0x0d, 0xb0, 0x8f, 0x8a, 0x45, 0x94, 0xb9, 0xbd, 0xe3, 0x8c, 0x68, 0x79,
+	0xf5, 0x8b, 0x0e, 0x2c, 0x25, 0xb2, 0x63, 0x2c, 0xbe, 0xfb, 0x58, 0x81, 0x16, 0x1f, 0x8c, 0xbd,
+	0x1c, 0x07, 0xe6, 0x2e, 0x75, 0x70, 0xda, 0x5a, 0x09, 0x04, 0xc9, 0xeb, 0x9a, 0x84, 0x95, 0x60,
+	0x79, 0x3c, 0xf8, 0xdd, 0x2a, 0xd3, 0xb4, 0x8d, 0x52, 0x18, 0x82, 0xae, 0x77, 0x14, 0x68, 0x0f,
+	0x83, 0x12, 0xaf, 0x1c, 0xc7, 0x65, 0xb2, 0xd0, 0x4c, 0x3b, 0x5b, 0x64, 0xa8, 0x20, 0xe2, 0x3d,
+	0x05, 0xea, 0xdb, 0x96, 0xdd, 0xcf, 0x61, 0x77, 0xb3, 0x2a, 0xc6, 0xb4, 0x8b, 0x45, 0x87, 0x4b,
+	0xc7, 0xd2, 0x40, 0x2a, 0x84, 0xc9, 0x77, 0x64, 0xa7, 0xc8, 0xb9, 0x50, 0x70, 0xb4, 0xa0, 0xe6,
+	0x13, 0x05, 0x0e, 0x0f, 0x62, 0x35, 0x4e, 0xf9, 0x5c, 0xd1, 0x74, 0x59, 0x97, 0x76, 0xa9, 0xf0,
+	0xf8, 0x28, 0x1c, 0x3d, 0xc4, 0x5d, 0x51, 0x5e, 0xe9, 0x82, 0x36, 0x0b, 0x56, 0x88, 0xc4, 0xaa,
+	0x73, 0xb4, 0x2b, 0x25, 0x51, 0x04, 0x75, 0xf4, 0x43, 0xab, 0x49, 0xaa, 0x1e, 0x44, 0x04, 0xcd,
+	0x1b, 0x07, 0x50, 0xcb, 0xa2, 0x6d, 0x96, 0x03, 0x89, 0xf2, 0x0b, 0x8d, 0x87, 0x26, 0xe9, 0x0d,
+	0x73, 0x08, 0x7c, 0x56, 0xe5, 0x89, 0x76, 0xb1, 0xe8, 0x70, 0x4e, 0xc8, 0x93, 0x0a, 0x13, 0xf9,
+	0xa1, 0xf4, 0x1b, 0x68, 0xa8, 0xd8, 0x4f, 0xb6, 0xe5, 0x17, 0xf9, 0xac, 0x1f, 0x5e, 0x5b, 0xfd,
+	0xf5, 0x1c, 0x2c, 0x5e, 0x73, 0x76, 0xb0, 0x67, 0xcb, 0xd9, 0xba, 0x4f, 0xb8, 0x37, 0x1d, 0xbf,
+	0xb1, 0x29, 0x93, 0x1c, 0x5a, 0x2b, 0x30, 0x36, 0x91, 0x00, 0xff, 0x96, 0x02, 0x47, 0x06, 0xf1,
+	0xdf, 0xb7, 0x2a, 0x94, 0x72, 0x90, 0x7f, 0xa4, 0x4b, 0xbb, 0x5c, 0x1c, 0x40, 0x90, 0xf5, 0x2e,
+	0x27, 0x6b, 0xcd, 0x75, 0x47, 0x16, 0xff, 0x71, 0x15, 0x1f, 0x3d, 0x97, 0x2b, 0x72, 0x88, 0x32,
+	0xba, 0xda, 0x99, 0xfc, 0x03, 0x39, 0x19, 0xeb, 0x4f, 0xc2, 0xac, 0xbf, 0x24, 0xf9, 0x6a, 0x83,
+	0xfd, 0xf2, 0xe4, 0xfd, 0x79, 0xf6, 0xe7, 0xe9, 0xbf, 0x0f, 0x00, 0xa3, 0xc0, 0x9d, 0x4c, 0x92,
+	0x52, 0x00, 0x00,
 }
@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package proto
+
+// Domain is the metadata stored in the domain key, the domain created
+// implicitly on the first use only has the name
+type Domain struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Timestamp   string            `json:"timestamp,omitempty"`
+	Usage       *TenantUsage      `json:"usage,omitempty"`
+}
+
+// Project is the metadata stored in the project key, the project created
+// implicitly on the first use only has the name
+type Project struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Timestamp   string            `json:"timestamp,omitempty"`
+	Usage       *TenantUsage      `json:"usage,omitempty"`
+}
+
+// TenantUsage is the number of the resources in the domain or project
+type TenantUsage struct {
+	Projects  int64 `json:"projects,omitempty"`
+	Services  int64 `json:"services"`
+	Instances int64 `json:"instances"`
+}
+
+type CreateDomainRequest struct {
+	Domain *Domain `json:"domain,omitempty"`
+}
+
+type CreateDomainResponse struct {
+	Response *Response `json:"response,omitempty"`
+}
+
+type GetDomainsRequest struct {
+}
+
+type GetDomainsResponse struct {
+	Response *Response `json:"response,omitempty"`
+	Domains  []*Domain `json:"domains,omitempty"`
+}
+
+type DeleteDomainRequest struct {
+	Domain string `json:"domain,omitempty"`
+	// Force deletes the projects and their resources in the domain
+	Force bool `json:"force,omitempty"`
+}
+
+type DeleteDomainResponse struct {
+	Response *Response `json:"response,omitempty"`
+}
+
+type CreateProjectRequest struct {
+	Domain  string   `json:"domain,omitempty"`
+	Project *Project `json:"project,omitempty"`
+}
+
+type CreateProjectResponse struct {
+	Response *Response `json:"response,omitempty"`
+}
+
+type GetProjectsRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+type GetProjectsResponse struct {
+	Response *Response  `json:"response,omitempty"`
+	Projects []*Project `json:"projects,omitempty"`
+}
+
+type DeleteProjectRequest struct {
+	Domain  string `json:"domain,omitempty"`
+	Project string `json:"project,omitempty"`
+	// Force deletes the micro-services and their instances in the project
+	Force bool `json:"force,omitempty"`
+}
+
+type DeleteProjectResponse struct {
+	Response *Response `json:"response,omitempty"`
+}
@@ -46,12 +46,32 @@ var errors = map[int32]string{
 	ErrNotEnoughQuota: "Not enough quota",
 
 	ErrUnauthorized: "Request unauthorized",
+	ErrForbidden:    "Request forbidden",
+
+	ErrTooManyRequests: "Too many requests",
+
+	ErrAccountAlreadyExists: "Account already exists",
+	ErrAccountNotExists:     "Account does not exist",
+	ErrRoleNotExists:        "Role does not exist",
+
+	ErrDomainAlreadyExists:  "Domain already exists",
+	ErrDomainNotExists:      "Domain does not exist",
+	ErrDomainNotEmpty:       "Domain has project(s)",
+	ErrProjectAlreadyExists: "Project already exists",
+	ErrProjectNotExists:     "Project does not exist",
+	ErrProjectNotEmpty:      "Project has micro-service(s)",
 
 	ErrInternal:           "Internal server error",
 	ErrUnavailableBackend: "Registry service is unavailable",
 	ErrUnavailableQuota:   "Quota service is unavailable",
 
 	ErrEndpointAlreadyExists: "Endpoint is already belong to other service",
+
+	ErrServiceRetired:     "Micro-service version is retired",
+	ErrIncompatibleSchema: "Schema is incompatible with the previous one",
+	ErrInvalidSchema:      "Invalid schema content",
+
+	ErrOperationNotExists: "Operation does not exist in schema",
 }
 
 const (
@@ -85,6 +105,26 @@ const (
 
 	ErrEndpointAlreadyExists int32 = 400025
 
+	ErrServiceRetired     int32 = 400026
+	ErrIncompatibleSchema int32 = 400027
+	ErrInvalidSchema      int32 = 400028
+
+	ErrOperationNotExists int32 = 404029
+
+	ErrForbidden            int32 = 403030
+	ErrAccountAlreadyExists int32 = 400031
+	ErrAccountNotExists     int32 = 400032
+	ErrRoleNotExists        int32 = 400033
+
+	ErrTooManyRequests int32 = 429034
+
+	ErrDomainAlreadyExists  int32 = 400035
+	ErrDomainNotExists      int32 = 400036
+	ErrDomainNotEmpty       int32 = 400037
+	ErrProjectAlreadyExists int32 = 400038
+	ErrProjectNotExists     int32 = 400039
+	ErrProjectNotEmpty      int32 = 400040
+
 	ErrNotEnoughQuota   int32 = 400100
 	ErrUnavailableQuota int32 = 500101
 )
@@ -40,6 +40,7 @@ func (governService *GovernServiceControllerV4) URLPatterns() []rest.Route {
 		{rest.HTTP_METHOD_GET, "/v4/:project/govern/relations", governService.GetGraph},
 		{rest.HTTP_METHOD_GET, "/v4/:project/govern/microservices", governService.GetAllServicesInfo},
 		{rest.HTTP_METHOD_GET, "/v4/:project/govern/apps", governService.GetAllApplications},
+		{rest.HTTP_METHOD_GET, "/v4/:project/govern/deprecations", governService.GetDeprecatedServices},
 	}
 }
 
@@ -180,3 +181,15 @@ func (governService *GovernServiceControllerV4) GetAllApplications(w http.Respon
 	resp.Response = nil
 	controller.WriteResponse(w, respInternal, resp)
 }
+
+func (governService *GovernServiceControllerV4) GetDeprecatedServices(w http.ResponseWriter, r *http.Request) {
+	request := &pb.GetDeprecatedServicesRequest{
+		AppId:       r.URL.Query().Get("appId"),
+		ServiceName: r.URL.Query().Get("serviceName"),
+	}
+	resp, _ := GovernServiceAPI.GetDeprecatedServices(r.Context(), request)
+
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
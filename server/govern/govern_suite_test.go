@@ -37,7 +37,7 @@ func TestGovern(t *testing.T) {
 	RunSpecsWithDefaultAndCustomReporters(t, "model Suite", []Reporter{junitReporter})
 }
 
-var serviceResource pb.ServiceCtrlServer
+var serviceResource pb.ServiceCtrlServerEx
 var instanceResource pb.SerivceInstanceCtrlServerEx
 var governService pb.GovernServiceCtrlServerEx
 
@@ -220,6 +220,50 @@ func (governService *GovernService) GetApplications(ctx context.Context, in *pb.
 	}, nil
 }
 
+func (governService *GovernService) GetDeprecatedServices(ctx context.Context, in *pb.GetDeprecatedServicesRequest) (*pb.GetDeprecatedServicesResponse, error) {
+	util.SetContext(ctx, serviceUtil.CTX_CACHEONLY, "1")
+
+	services, err := serviceUtil.GetAllServiceUtil(ctx)
+	if err != nil {
+		util.Logger().Errorf(err, "Get all services for deprecation report failed.")
+		return &pb.GetDeprecatedServicesResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+
+	deprecated := make([]*pb.DeprecatedService, 0, len(services))
+	domainProject := util.ParseDomainProject(ctx)
+	for _, service := range services {
+		if !pb.IsDeprecated(service) {
+			continue
+		}
+		if len(in.AppId) > 0 && in.AppId != service.AppId {
+			continue
+		}
+		if len(in.ServiceName) > 0 && in.ServiceName != service.ServiceName {
+			continue
+		}
+
+		dr := serviceUtil.NewDependencyRelation(ctx, domainProject, service, service)
+		consumers, err := dr.GetDependencyConsumers(serviceUtil.WithoutSelfDependency())
+		if err != nil {
+			util.Logger().Errorf(err, "Get consumers of deprecated service %s failed.", service.ServiceId)
+			return &pb.GetDeprecatedServicesResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+		deprecated = append(deprecated, &pb.DeprecatedService{
+			MicroService: service,
+			Consumers:    consumers,
+		})
+	}
+
+	return &pb.GetDeprecatedServicesResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Get deprecated services successfully."),
+		Services: deprecated,
+	}, nil
+}
+
 func getServiceAllVersions(ctx context.Context, serviceKey *pb.MicroServiceKey) ([]string, error) {
 	versions := []string{}
 	key := apt.GenerateServiceIndexKey(serviceKey)
@@ -255,7 +299,11 @@ func getSchemaInfoUtil(ctx context.Context, domainProject string, serviceId stri
 	schemas := make([]*pb.Schema, 0, len(resp.Kvs))
 	for _, kv := range resp.Kvs {
 		schemaInfo := &pb.Schema{}
-		schemaInfo.Schema = util.BytesToStringWithNoCopy(kv.Value)
+		schemaInfo.Schema, err = serviceUtil.ResolveSchemaContent(ctx, domainProject, kv.Value)
+		if err != nil {
+			util.Logger().Errorf(err, "Get schema content failed")
+			return make([]*pb.Schema, 0), err
+		}
 		schemaInfo.SchemaId = util.BytesToStringWithNoCopy(kv.Key[len(key):])
 		schemas = append(schemas, schemaInfo)
 	}
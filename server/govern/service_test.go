@@ -165,4 +165,87 @@ var _ = Describe("'Govern' service", func() {
 			})
 		})
 	})
+
+	Describe("execute 'get deprecations' operation", func() {
+		var (
+			consumerId, providerId string
+		)
+
+		It("should be passed", func() {
+			resp, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "govern_deprecation_group",
+					ServiceName: "govern_deprecation_provider",
+					Version:     "1.0.0",
+					Level:       "BACK",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			providerId = resp.ServiceId
+
+			resp, err = serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "govern_deprecation_group",
+					ServiceName: "govern_deprecation_consumer",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			consumerId = resp.ServiceId
+
+			respFind, err := instanceResource.Find(getContext(), &pb.FindInstancesRequest{
+				ConsumerServiceId: consumerId,
+				AppId:             "govern_deprecation_group",
+				ServiceName:       "govern_deprecation_provider",
+				VersionRule:       "1.0.0",
+			})
+			Expect(err).To(BeNil())
+			Expect(respFind.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+			respDeprecate, err := serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+				ServiceId:   providerId,
+				Deprecation: &pb.Deprecation{State: pb.MS_DEPRECATED},
+			})
+			Expect(err).To(BeNil())
+			Expect(respDeprecate.Response.Code).To(Equal(pb.Response_SUCCESS))
+		})
+
+		Context("when get deprecated services", func() {
+			It("should be passed", func() {
+				resp, err := governService.GetDeprecatedServices(getContext(), &pb.GetDeprecatedServicesRequest{
+					AppId: "govern_deprecation_group",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(resp.Services)).To(Equal(1))
+				Expect(resp.Services[0].MicroService.ServiceId).To(Equal(providerId))
+				Expect(len(resp.Services[0].Consumers)).To(Equal(1))
+				Expect(resp.Services[0].Consumers[0].ServiceId).To(Equal(consumerId))
+
+				resp, err = governService.GetDeprecatedServices(getContext(), &pb.GetDeprecatedServicesRequest{
+					AppId:       "govern_deprecation_group",
+					ServiceName: "govern_deprecation_consumer",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(resp.Services)).To(Equal(0))
+			})
+		})
+
+		It("should be deleted", func() {
+			for _, id := range []string{consumerId, providerId} {
+				resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+					ServiceId: id,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			}
+		})
+	})
 })
@@ -0,0 +1,57 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package audit
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/chain"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"net/http"
+	"strings"
+)
+
+type AuditHandler struct {
+}
+
+func (h *AuditHandler) Handle(i *chain.Invocation) {
+	r := i.Context().Value(rest.CTX_REQUEST).(*http.Request)
+	pattern := i.Context().Value(rest.CTX_MATCH_PATTERN).(string)
+	if !IsAudited(r.Method, pattern) {
+		i.Next()
+		return
+	}
+
+	i.WithContext(auditlog.CTX_CHANGES, &auditlog.Changes{})
+	i.Next(chain.WithAsyncFunc(func(ret chain.Result) {
+		w := i.Context().Value(rest.CTX_RESPONSE).(http.ResponseWriter)
+		plugin.Plugins().AuditLog().Record(r, w.Header())
+	}))
+}
+
+// IsAudited returns true if the request is mutating, the heartbeats are skipped
+func IsAudited(method, pattern string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return strings.Index(pattern, "/heartbeat") < 0
+}
+
+func RegisterHandlers() {
+	chain.RegisterHandler(rest.SERVER_CHAIN_NAME, &AuditHandler{})
+}
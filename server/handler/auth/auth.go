@@ -21,6 +21,7 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
 	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
 	"net/http"
@@ -31,7 +32,21 @@ type AuthRequest struct {
 
 func (h *AuthRequest) Handle(i *chain.Invocation) {
 	r := i.Context().Value(rest.CTX_REQUEST).(*http.Request)
-	err := plugin.Plugins().Auth().Identify(r)
+	var err error
+	if identity.Enabled() {
+		// the domain/project and the service are bound from the client certificate
+		err = identity.Identify(r)
+	}
+	if err == nil {
+		domain, admin := util.ParseDomain(r.Context()), util.IsAdmin(r.Context())
+		err = plugin.Plugins().Auth().Identify(r)
+		// the auth plugin can not move the client to the other domain, unless
+		// the certificate is mapped to the administrator
+		if err == nil && identity.Enabled() && !admin && util.ParseDomain(r.Context()) != domain {
+			err = scerr.NewError(scerr.ErrForbidden, "the domain of the client certificate is "+domain+
+				", but the request is authenticated to domain "+util.ParseDomain(r.Context()))
+		}
+	}
 	if err == nil {
 		i.Next()
 		return
@@ -40,7 +55,11 @@ func (h *AuthRequest) Handle(i *chain.Invocation) {
 	util.Logger().Errorf(err, "authenticate request failed, %s %s", r.Method, r.RequestURI)
 
 	w := i.Context().Value(rest.CTX_RESPONSE).(http.ResponseWriter)
-	controller.WriteError(w, scerr.ErrUnauthorized, err.Error())
+	if e, ok := err.(*scerr.Error); ok {
+		controller.WriteError(w, e.Code, e.Detail)
+	} else {
+		controller.WriteError(w, scerr.ErrUnauthorized, err.Error())
+	}
 
 	i.Fail(nil)
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/chain"
 	roa "github.com/apache/incubator-servicecomb-service-center/pkg/rest"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"net"
 	"net/http"
 )
 
@@ -31,6 +32,7 @@ func (c *ContextHandler) Handle(i *chain.Invocation) {
 		err     error
 		v3      v3Context
 		v4      v4Context
+		mock    mockContext
 		r       = i.Context().Value(roa.CTX_REQUEST).(*http.Request)
 		pattern = i.Context().Value(roa.CTX_MATCH_PATTERN).(string)
 	)
@@ -41,6 +43,8 @@ func (c *ContextHandler) Handle(i *chain.Invocation) {
 		err = v3.Do(r)
 	case v4.IsMatch(r):
 		err = v4.Do(r)
+	case mock.IsMatch(r):
+		err = mock.Do(r)
 	}
 
 	if err != nil {
@@ -49,11 +53,21 @@ func (c *ContextHandler) Handle(i *chain.Invocation) {
 	}
 
 	i.WithContext("x-remote-ip", util.GetRealIP(r))
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		i.WithContext("x-peer-ip", host)
+	}
+	if credential := r.Header.Get("X-Service-Credential"); len(credential) > 0 {
+		i.WithContext("service-credential", credential)
+	}
 
 	i.Next()
 }
 
 func IsSkip(url string) bool {
+	// the token is requested before the domain is known
+	if url == "/v4/token" {
+		return true
+	}
 	l, vl, hl := len(url), len("/version"), len("/health")
 	if l >= vl && url[l-vl:] == "/version" {
 		return true
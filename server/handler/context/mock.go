@@ -0,0 +1,56 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package context
+
+import (
+	"errors"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	"net/http"
+	"strings"
+)
+
+// mockContext handles the requests to the mock server, the mocked schema
+// belongs to the default project of the domain
+type mockContext struct {
+}
+
+func (v *mockContext) IsMatch(r *http.Request) bool {
+	return strings.Index(r.RequestURI, "/mock/") == 0
+}
+
+func (v *mockContext) Do(r *http.Request) error {
+	ctx := r.Context()
+
+	domain, project := util.ParseDomain(ctx), util.ParseProject(ctx)
+
+	if len(domain) == 0 {
+		domain = r.Header.Get("X-Domain-Name")
+		if len(domain) == 0 {
+			err := errors.New("Header does not contain domain.")
+			util.Logger().Errorf(err, "Invalid Request URI %s", r.RequestURI)
+			return err
+		}
+		util.SetDomain(r.Context(), domain)
+	}
+
+	if len(project) == 0 {
+		util.SetProject(r.Context(), core.REGISTRY_PROJECT)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	GROUP_DISCOVERY    = "discovery"
+	GROUP_REGISTRATION = "registration"
+	GROUP_GOVERN       = "govern"
+
+	KEY_DOMAIN  = "domain"
+	KEY_SERVICE = "service"
+	KEY_IP      = "ip"
+
+	sweepInterval = time.Minute
+)
+
+var groups = map[string]bool{
+	GROUP_DISCOVERY:    true,
+	GROUP_REGISTRATION: true,
+	GROUP_GOVERN:       true,
+}
+
+var keys = map[string]bool{
+	KEY_DOMAIN:  true,
+	KEY_SERVICE: true,
+	KEY_IP:      true,
+}
+
+// Policy limits the requests of an API group by the key,
+// Rate tokens are refilled per second and at most Burst tokens are kept
+type Policy struct {
+	Group string
+	Key   string
+	Rate  float64
+	Burst float64
+}
+
+// ParsePolicies parses the policies separated by ',',
+// format is <group>:<rate>/<burst>[:<key>], key is domain by default
+func ParsePolicies(s string) (map[string]*Policy, error) {
+	result := make(map[string]*Policy)
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		if len(p) == 0 {
+			continue
+		}
+		arr := strings.Split(p, ":")
+		if len(arr) < 2 || len(arr) > 3 {
+			return nil, fmt.Errorf("invalid rate limit policy '%s'", p)
+		}
+		policy := &Policy{
+			Group: strings.TrimSpace(arr[0]),
+			Key:   KEY_DOMAIN,
+		}
+		if !groups[policy.Group] {
+			return nil, fmt.Errorf("invalid group of rate limit policy '%s'", p)
+		}
+		if len(arr) == 3 {
+			policy.Key = strings.TrimSpace(arr[2])
+			if !keys[policy.Key] {
+				return nil, fmt.Errorf("invalid key of rate limit policy '%s'", p)
+			}
+		}
+		rb := strings.Split(strings.TrimSpace(arr[1]), "/")
+		if len(rb) != 2 {
+			return nil, fmt.Errorf("invalid rate of rate limit policy '%s'", p)
+		}
+		rate, err := strconv.ParseFloat(rb[0], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate of rate limit policy '%s'", p)
+		}
+		burst, err := strconv.ParseFloat(rb[1], 64)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("invalid burst of rate limit policy '%s'", p)
+		}
+		policy.Rate, policy.Burst = rate, burst
+		result[policy.Group] = policy
+	}
+	return result, nil
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter is a token bucket per key
+type Limiter struct {
+	policy    *Policy
+	mux       sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// Take takes a token of the key at now, returns false and the time
+// to wait for the next token if the bucket is empty
+func (l *Limiter) Take(key string, now time.Time) (bool, time.Duration) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.policy.Burst, last: now}
+		l.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(l.policy.Burst, b.tokens+elapsed*l.policy.Rate)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := (1 - b.tokens) / l.policy.Rate
+	return false, time.Duration(wait * float64(time.Second))
+}
+
+// sweep removes the buckets which are refilled fully, they are the same as the new ones
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	full := time.Duration(l.policy.Burst / l.policy.Rate * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func NewLimiter(policy *Policy) *Limiter {
+	return &Limiter{
+		policy:    policy,
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
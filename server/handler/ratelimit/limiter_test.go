@@ -0,0 +1,105 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ratelimit
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParsePolicies(t *testing.T) {
+	policies, err := ParsePolicies("")
+	if err != nil || len(policies) != 0 {
+		t.Fatalf("ParsePolicies with empty string failed")
+	}
+
+	policies, err = ParsePolicies("discovery:100/200:service, registration:20/40,govern:0.5/1:ip")
+	if err != nil || len(policies) != 3 {
+		t.Fatalf("ParsePolicies failed, %v", err)
+	}
+	p := policies[GROUP_DISCOVERY]
+	if p.Key != KEY_SERVICE || p.Rate != 100 || p.Burst != 200 {
+		t.Fatalf("ParsePolicies discovery failed, %v", p)
+	}
+	if policies[GROUP_REGISTRATION].Key != KEY_DOMAIN {
+		t.Fatalf("ParsePolicies default key failed")
+	}
+	if policies[GROUP_GOVERN].Rate != 0.5 {
+		t.Fatalf("ParsePolicies govern failed")
+	}
+
+	for _, s := range []string{
+		"discovery",
+		"discovery:100",
+		"discovery:a/1",
+		"discovery:0/1",
+		"discovery:1/0",
+		"unknown:1/1",
+		"discovery:1/1:unknown",
+		"discovery:1/1:ip:x",
+	} {
+		if _, err := ParsePolicies(s); err == nil {
+			t.Fatalf("ParsePolicies '%s' should fail", s)
+		}
+	}
+}
+
+func TestLimiterTake(t *testing.T) {
+	l := NewLimiter(&Policy{Group: GROUP_DISCOVERY, Key: KEY_IP, Rate: 2, Burst: 2})
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Take("a", now); !ok {
+			t.Fatalf("Take within burst failed")
+		}
+	}
+	ok, wait := l.Take("a", now)
+	if ok || wait != 500*time.Millisecond {
+		t.Fatalf("Take over burst should fail, wait %v", wait)
+	}
+	if ok, _ := l.Take("b", now); !ok {
+		t.Fatalf("Take of other key failed")
+	}
+	if ok, _ := l.Take("a", now.Add(wait)); !ok {
+		t.Fatalf("Take after refill failed")
+	}
+
+	l.sweep(now.Add(sweepInterval + time.Second))
+	if len(l.buckets) != 0 {
+		t.Fatalf("sweep the full buckets failed, %d left", len(l.buckets))
+	}
+}
+
+func TestGroupOf(t *testing.T) {
+	cases := []struct {
+		method, pattern, group string
+	}{
+		{http.MethodGet, "/v4/:project/registry/instances", GROUP_DISCOVERY},
+		{http.MethodGet, "/registry/v3/microservices", GROUP_DISCOVERY},
+		{http.MethodPost, "/v4/:project/registry/microservices", GROUP_REGISTRATION},
+		{http.MethodPut, "/registry/v3/microservices/:serviceId/instances/:instanceId/heartbeat", GROUP_REGISTRATION},
+		{http.MethodGet, "/v4/:project/govern/microservices", GROUP_GOVERN},
+		{http.MethodGet, "/registry/v3/govern/services", GROUP_GOVERN},
+		{http.MethodGet, "/version", ""},
+		{http.MethodPost, "/v4/token", ""},
+	}
+	for _, c := range cases {
+		if g := GroupOf(c.method, c.pattern); g != c.group {
+			t.Fatalf("GroupOf %s %s = '%s', want '%s'", c.method, c.pattern, g, c.group)
+		}
+	}
+}
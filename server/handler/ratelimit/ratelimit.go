@@ -0,0 +1,137 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package ratelimit
+
+import (
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/chain"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
+	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	"github.com/prometheus/client_golang/prometheus"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	limiters map[string]*Limiter
+
+	rejectedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "service_center",
+			Subsystem: "http",
+			Name:      "rate_limited_total",
+			Help:      "Counter of requests rejected by the rate limiter",
+		}, []string{"group", "key"})
+)
+
+func init() {
+	prometheus.MustRegister(rejectedRequests)
+}
+
+type RateLimitHandler struct {
+}
+
+func (h *RateLimitHandler) Handle(i *chain.Invocation) {
+	r := i.Context().Value(rest.CTX_REQUEST).(*http.Request)
+	pattern := i.Context().Value(rest.CTX_MATCH_PATTERN).(string)
+
+	limiter, ok := limiters[GroupOf(r.Method, pattern)]
+	if !ok {
+		i.Next()
+		return
+	}
+
+	policy := limiter.policy
+	domain := util.ParseDomain(r.Context())
+	pass, wait := limiter.Take(policy.Key+":"+keyOf(r, policy.Key), time.Now())
+	if pass {
+		i.Next()
+		return
+	}
+
+	rejectedRequests.WithLabelValues(policy.Group, policy.Key).Inc()
+	util.Logger().Warnf(nil, "too many %s requests, %s %s, domain %s, ip %s",
+		policy.Group, r.Method, r.RequestURI, domain, util.GetRealIP(r))
+
+	w := i.Context().Value(rest.CTX_RESPONSE).(http.ResponseWriter)
+	w.Header().Set("Retry-After", fmt.Sprint(int64(math.Max(1, math.Ceil(wait.Seconds())))))
+	controller.WriteError(w, scerr.ErrTooManyRequests,
+		fmt.Sprintf("the rate limit of %s is %v/s", policy.Group, policy.Rate))
+
+	i.Fail(nil)
+}
+
+// GroupOf returns the API group of the route, or empty if the route is not limited
+func GroupOf(method, pattern string) string {
+	switch {
+	case strings.Index(pattern, "/v4/:project/govern/") == 0,
+		strings.Index(pattern, "/registry/v3/govern/") == 0:
+		return GROUP_GOVERN
+	case strings.Index(pattern, "/v4/:project/registry/") == 0,
+		strings.Index(pattern, "/registry/v3/") == 0:
+		if method == http.MethodGet {
+			return GROUP_DISCOVERY
+		}
+		return GROUP_REGISTRATION
+	}
+	return ""
+}
+
+// keyOf returns the client of the request, the service falls back to the
+// client IP if the request is not from a verified micro-service, the
+// X-ConsumerId header is never trusted as clients can rotate it freely
+func keyOf(r *http.Request, key string) string {
+	switch key {
+	case KEY_DOMAIN:
+		return util.ParseDomain(r.Context())
+	case KEY_SERVICE:
+		if serviceId, _ := r.Context().Value(identity.CTX_SERVICE).(string); len(serviceId) > 0 {
+			return serviceId
+		}
+	}
+	return util.GetRealIP(r)
+}
+
+func newLimiters(s string) (map[string]*Limiter, error) {
+	policies, err := ParsePolicies(s)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*Limiter, len(policies))
+	for group, policy := range policies {
+		util.Logger().Infof("rate limit %s requests to %v/s, burst %v, per %s",
+			group, policy.Rate, policy.Burst, policy.Key)
+		result[group] = NewLimiter(policy)
+	}
+	return result, nil
+}
+
+func RegisterHandlers() {
+	var err error
+	limiters, err = newLimiters(core.ServerInfo.Config.RateLimitPolicy)
+	if err != nil {
+		// never run without the limits the operator asked for
+		util.Logger().Fatalf(err, "invalid rate limit policy")
+	}
+	chain.RegisterHandler(rest.SERVER_CHAIN_NAME, &RateLimitHandler{})
+}
@@ -0,0 +1,223 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package identity
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	"golang.org/x/net/context"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// the fields of the client certificate matched by the rules
+const (
+	FIELD_CN    = "CN"
+	FIELD_O     = "O"
+	FIELD_OU    = "OU"
+	FIELD_DNS   = "DNS"
+	FIELD_EMAIL = "EMAIL"
+)
+
+var fields = map[string]bool{
+	FIELD_CN:    true,
+	FIELD_O:     true,
+	FIELD_OU:    true,
+	FIELD_DNS:   true,
+	FIELD_EMAIL: true,
+}
+
+const CTX_SERVICE = "identity-service"
+
+// the target of the rules mapping the certificates to the administrator
+const TARGET_ADMIN = "admin"
+
+var (
+	rules     []*Rule
+	rulesErr  error
+	rulesOnce sync.Once
+)
+
+// Identity is the domain/project and the optional service of the client, or
+// the administrator whose domain/project are bound from the request
+type Identity struct {
+	Domain    string
+	Project   string
+	ServiceId string
+	Admin     bool
+}
+
+// Rule maps the client certificate whose field matches the pattern to the identity,
+// the format is <field>=<pattern>=><domain>/<project>[/<serviceId>] or
+// <field>=<pattern>=>admin, the '*' in the pattern matches any characters
+type Rule struct {
+	Field    string
+	Pattern  string
+	Identity Identity
+	regex    *regexp.Regexp
+}
+
+func (rule *Rule) Match(cert *x509.Certificate) bool {
+	for _, v := range fieldValues(cert, rule.Field) {
+		if rule.regex.MatchString(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldValues(cert *x509.Certificate, field string) []string {
+	switch field {
+	case FIELD_CN:
+		return []string{cert.Subject.CommonName}
+	case FIELD_O:
+		return cert.Subject.Organization
+	case FIELD_OU:
+		return cert.Subject.OrganizationalUnit
+	case FIELD_DNS:
+		return cert.DNSNames
+	case FIELD_EMAIL:
+		return cert.EmailAddresses
+	default:
+		return nil
+	}
+}
+
+// ParseRules parses the rules separated by ';'
+func ParseRules(s string) ([]*Rule, error) {
+	var result []*Rule
+	for _, r := range strings.Split(s, ";") {
+		r = strings.TrimSpace(r)
+		if len(r) == 0 {
+			continue
+		}
+		i, j := strings.Index(r, "="), strings.LastIndex(r, "=>")
+		if i <= 0 || j <= i {
+			return nil, fmt.Errorf("invalid client identity rule '%s'", r)
+		}
+		rule := &Rule{
+			Field:   strings.ToUpper(strings.TrimSpace(r[:i])),
+			Pattern: strings.TrimSpace(r[i+1 : j]),
+		}
+		if !fields[rule.Field] {
+			return nil, fmt.Errorf("invalid field '%s' of client identity rule '%s'", rule.Field, r)
+		}
+		target := strings.Split(strings.TrimSpace(r[j+2:]), "/")
+		if len(target) == 1 && target[0] == TARGET_ADMIN {
+			rule.Identity.Admin = true
+			rule.regex = newPatternRegexp(rule.Pattern)
+			result = append(result, rule)
+			continue
+		}
+		if len(target) < 2 || len(target) > 3 || len(target[0]) == 0 || len(target[1]) == 0 {
+			return nil, fmt.Errorf("invalid target of client identity rule '%s'", r)
+		}
+		rule.Identity.Domain, rule.Identity.Project = target[0], target[1]
+		if len(target) == 3 {
+			rule.Identity.ServiceId = target[2]
+		}
+		rule.regex = newPatternRegexp(rule.Pattern)
+		result = append(result, rule)
+	}
+	return result, nil
+}
+
+func newPatternRegexp(pattern string) *regexp.Regexp {
+	return regexp.MustCompile("^" +
+		strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1) + "$")
+}
+
+// Map returns the identity of the first rule matching the certificate
+func Map(rules []*Rule, cert *x509.Certificate) *Identity {
+	for _, rule := range rules {
+		if rule.Match(cert) {
+			id := rule.Identity
+			return &id
+		}
+	}
+	return nil
+}
+
+// Enabled returns true if the client certificates are mapped to the identities
+func Enabled() bool {
+	cfg := core.ServerInfo.Config
+	return cfg.SslEnabled && cfg.SslVerifyPeer && cfg.SslClientIdentity
+}
+
+// FromCertificates returns the identity of the verified client certificates
+func FromCertificates(certs []*x509.Certificate) (*Identity, error) {
+	if len(certs) == 0 {
+		return nil, errors.New("client certificate is required")
+	}
+	rulesOnce.Do(func() {
+		rules, rulesErr = ParseRules(core.ServerInfo.Config.SslClientIdentityRules)
+		if rulesErr != nil {
+			util.Logger().Errorf(rulesErr, "parse client identity rules failed")
+		}
+	})
+	if rulesErr != nil {
+		return nil, rulesErr
+	}
+	id := Map(rules, certs[0])
+	if id == nil {
+		return nil, fmt.Errorf("client certificate '%s' does not match any identity rule",
+			certs[0].Subject.CommonName)
+	}
+	return id, nil
+}
+
+// Identify binds the identity of the client certificate to the request
+func Identify(r *http.Request) error {
+	if r.TLS == nil {
+		return errors.New("client certificate is required")
+	}
+	id, err := FromCertificates(r.TLS.PeerCertificates)
+	if err != nil {
+		return err
+	}
+	if id.Admin {
+		util.SetRequestContext(r, "admin", true)
+		return nil
+	}
+	util.SetRequestContext(r, "domain", id.Domain)
+	util.SetRequestContext(r, "project", id.Project)
+	util.SetRequestContext(r, CTX_SERVICE, id.ServiceId)
+	return nil
+}
+
+// WithIdentity returns the context bound to the identity
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	if id.Admin {
+		return util.SetAdmin(ctx, true)
+	}
+	ctx = util.SetDomainProject(ctx, id.Domain, id.Project)
+	return util.SetContext(ctx, CTX_SERVICE, id.ServiceId)
+}
+
+// CheckService returns an error if the client is bound to the other service
+func CheckService(ctx context.Context, serviceId string) error {
+	bound, _ := ctx.Value(CTX_SERVICE).(string)
+	if len(bound) == 0 || bound == serviceId {
+		return nil
+	}
+	return fmt.Errorf("client of service %s can not access the instances of service %s", bound, serviceId)
+}
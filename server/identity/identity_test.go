@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package identity
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"golang.org/x/net/context"
+	"testing"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(" CN=order-*=>default/default/1 ; DNS=*.example.com=>tenant/prod;O=ops=>admin")
+	if err != nil || len(rules) != 3 {
+		t.Fatalf("ParseRules failed, %v", err)
+	}
+	if rules[0].Field != FIELD_CN || rules[0].Pattern != "order-*" ||
+		rules[0].Identity != (Identity{Domain: "default", Project: "default", ServiceId: "1"}) {
+		t.Fatalf("ParseRules failed, %v", rules[0])
+	}
+	if rules[1].Identity != (Identity{Domain: "tenant", Project: "prod"}) {
+		t.Fatalf("ParseRules failed, %v", rules[1])
+	}
+	if rules[2].Identity != (Identity{Admin: true}) || !rules[2].Match(&x509.Certificate{
+		Subject: pkix.Name{Organization: []string{"ops"}}}) {
+		t.Fatalf("ParseRules failed, %v", rules[2])
+	}
+
+	for _, s := range []string{"CN", "CN=a", "XX=a=>d/p", "CN=a=>d", "CN=a=>d/p/s/x", "CN=a=>/p", "CN=a=>root"} {
+		if _, err := ParseRules(s); err == nil {
+			t.Fatalf("ParseRules %s should fail", s)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	rules, _ := ParseRules("CN=order-*=>default/default/1;DNS=*.example.com=>tenant/prod;OU=ops=>ops/default")
+
+	id := Map(rules, &x509.Certificate{Subject: pkix.Name{CommonName: "order-service"}})
+	if id == nil || id.ServiceId != "1" {
+		t.Fatalf("Map CN failed, %v", id)
+	}
+	id = Map(rules, &x509.Certificate{Subject: pkix.Name{CommonName: "user"}, DNSNames: []string{"a.example.com"}})
+	if id == nil || id.Domain != "tenant" || id.Project != "prod" {
+		t.Fatalf("Map DNS failed, %v", id)
+	}
+	id = Map(rules, &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"dev", "ops"}}})
+	if id == nil || id.Domain != "ops" {
+		t.Fatalf("Map OU failed, %v", id)
+	}
+	id = Map(rules, &x509.Certificate{Subject: pkix.Name{CommonName: "xorder-service"}, DNSNames: []string{"example.com"}})
+	if id != nil {
+		t.Fatalf("Map should not match, %v", id)
+	}
+}
+
+func TestCheckService(t *testing.T) {
+	ctx := util.SetContext(context.Background(), "x", "")
+	if CheckService(ctx, "1") != nil {
+		t.Fatalf("CheckService without identity failed")
+	}
+	ctx = WithIdentity(ctx, &Identity{Domain: "default", Project: "default", ServiceId: "1"})
+	if util.ParseDomainProject(ctx) != "default/default" {
+		t.Fatalf("WithIdentity failed")
+	}
+	if CheckService(ctx, "1") != nil || CheckService(ctx, "2") == nil {
+		t.Fatalf("CheckService with identity failed")
+	}
+}
@@ -16,8 +16,181 @@
  */
 package auditlog
 
-import "net/http"
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"golang.org/x/net/context"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+const (
+	CTX_CHANGES = "audit-changes"
+
+	PROTOCOL_REST = "rest"
+	PROTOCOL_GRPC = "grpc"
+
+	ENTITY_SERVICE  = "service"
+	ENTITY_INSTANCE = "instance"
+	ENTITY_SCHEMA   = "schema"
+	ENTITY_TAG      = "tag"
+	ENTITY_RULE     = "rule"
+)
 
 type AuditLogger interface {
+	// Record records the mutating REST request after it is responded
 	Record(r *http.Request, responseHeaders http.Header)
+	// RecordEntry records the mutating call of the other protocols
+	RecordEntry(entry *Entry)
+	// Query returns the entries matching the option in time order
+	Query(option *QueryOption) ([]*Entry, error)
+}
+
+// Entry is an audit record of a mutating call
+type Entry struct {
+	// Timestamp is the unix time in seconds when the call is completed
+	Timestamp  int64     `json:"timestamp"`
+	Protocol   string    `json:"protocol"`
+	Operator   string    `json:"operator,omitempty"`
+	RemoteIP   string    `json:"remoteIP,omitempty"`
+	Method     string    `json:"method"`
+	API        string    `json:"api"`
+	Domain     string    `json:"domain,omitempty"`
+	Project    string    `json:"project,omitempty"`
+	ServiceId  string    `json:"serviceId,omitempty"`
+	InstanceId string    `json:"instanceId,omitempty"`
+	StatusCode string    `json:"statusCode"`
+	Changes    []*Change `json:"changes,omitempty"`
+}
+
+// Change is the diff of an entity changed by the call
+type Change struct {
+	Entity    string                `json:"entity"`
+	ServiceId string                `json:"serviceId,omitempty"`
+	Id        string                `json:"id,omitempty"`
+	Diff      map[string]*FieldDiff `json:"diff,omitempty"`
+}
+
+// FieldDiff is the values of a top level field, nil if the field does not exist
+type FieldDiff struct {
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Changes holds the changes of a call, it is bound to the context by WithChanges
+type Changes struct {
+	lock    sync.Mutex
+	changes []*Change
+}
+
+func (c *Changes) Add(change *Change) {
+	c.lock.Lock()
+	c.changes = append(c.changes, change)
+	c.lock.Unlock()
+}
+
+func (c *Changes) List() []*Change {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.changes
+}
+
+type QueryOption struct {
+	// Start and End are the unix time in seconds, zero means unlimited
+	Start      int64
+	End        int64
+	Domain     string
+	Project    string
+	ServiceId  string
+	InstanceId string
+	Entity     string
+	// Limit is the max number of the latest entries returned, zero means unlimited
+	Limit int
+}
+
+// Match returns true if the entry matches all the non-empty conditions of the option
+func (op *QueryOption) Match(entry *Entry) bool {
+	switch {
+	case op.Start > 0 && entry.Timestamp < op.Start,
+		op.End > 0 && entry.Timestamp > op.End,
+		len(op.Domain) > 0 && entry.Domain != op.Domain,
+		len(op.Project) > 0 && entry.Project != op.Project,
+		len(op.InstanceId) > 0 && entry.InstanceId != op.InstanceId:
+		return false
+	}
+	if len(op.ServiceId) == 0 && len(op.Entity) == 0 {
+		return true
+	}
+	if len(op.Entity) == 0 && entry.ServiceId == op.ServiceId {
+		return true
+	}
+	for _, change := range entry.Changes {
+		if (len(op.Entity) == 0 || change.Entity == op.Entity) &&
+			(len(op.ServiceId) == 0 || change.ServiceId == op.ServiceId) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithChanges binds a new changes holder to the context
+func WithChanges(ctx context.Context, changes *Changes) context.Context {
+	return util.SetContext(ctx, CTX_CHANGES, changes)
+}
+
+// ChangesOf returns the changes bound to the context, nil if the call is not audited
+func ChangesOf(ctx context.Context) *Changes {
+	changes, _ := ctx.Value(CTX_CHANGES).(*Changes)
+	return changes
+}
+
+// AddChange records the entity changed in the audited call, before is nil
+// if the entity is created and after is nil if the entity is deleted
+func AddChange(ctx context.Context, entity, serviceId, id string, before, after interface{}) {
+	changes := ChangesOf(ctx)
+	if changes == nil {
+		return
+	}
+	diff := Diff(before, after)
+	if len(diff) == 0 {
+		return
+	}
+	changes.Add(&Change{Entity: entity, ServiceId: serviceId, Id: id, Diff: diff})
+}
+
+// Diff compares the top level fields of the JSON presentation of the values,
+// the value which is not a JSON object is compared as the field ""
+func Diff(before, after interface{}) map[string]*FieldDiff {
+	b, a := toFields(before), toFields(after)
+	diff := make(map[string]*FieldDiff)
+	for k, bv := range b {
+		if av, ok := a[k]; !ok || !reflect.DeepEqual(bv, av) {
+			diff[k] = &FieldDiff{Before: bv, After: av}
+		}
+	}
+	for k, av := range a {
+		if _, ok := b[k]; !ok {
+			diff[k] = &FieldDiff{After: av}
+		}
+	}
+	return diff
+}
+
+func toFields(v interface{}) map[string]interface{} {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var i interface{}
+	if err := json.Unmarshal(data, &i); err != nil || i == nil {
+		return nil
+	}
+	if m, ok := i.(map[string]interface{}); ok {
+		return m
+	}
+	return map[string]interface{}{"": i}
 }
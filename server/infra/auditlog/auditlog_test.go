@@ -0,0 +1,109 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package auditlog
+
+import (
+	"golang.org/x/net/context"
+	"testing"
+)
+
+type entity struct {
+	Name  string            `json:"name"`
+	Tags  map[string]string `json:"tags,omitempty"`
+	Count int               `json:"count"`
+}
+
+func TestDiff(t *testing.T) {
+	if len(Diff(nil, nil)) != 0 {
+		t.Fatalf("Diff nil failed")
+	}
+	var e *entity
+	if len(Diff(e, nil)) != 0 {
+		t.Fatalf("Diff nil pointer failed")
+	}
+
+	diff := Diff(nil, &entity{Name: "a"})
+	if len(diff) != 2 || diff["name"].Before != nil || diff["name"].After != "a" {
+		t.Fatalf("Diff create failed, %v", diff)
+	}
+
+	diff = Diff(&entity{Name: "a", Tags: map[string]string{"k": "v"}, Count: 1},
+		&entity{Name: "a", Count: 2})
+	if len(diff) != 2 || diff["tags"] == nil || diff["tags"].After != nil || diff["count"] == nil {
+		t.Fatalf("Diff update failed, %v", diff)
+	}
+
+	diff = Diff(map[string]string{"a": "1"}, map[string]string{"a": "1"})
+	if len(diff) != 0 {
+		t.Fatalf("Diff the same failed, %v", diff)
+	}
+
+	diff = Diff("a", "b")
+	if len(diff) != 1 || diff[""].Before != "a" || diff[""].After != "b" {
+		t.Fatalf("Diff the scalar failed, %v", diff)
+	}
+}
+
+func TestAddChange(t *testing.T) {
+	ctx := context.Background()
+	AddChange(ctx, ENTITY_SERVICE, "1", "1", nil, &entity{Name: "a"})
+
+	changes := &Changes{}
+	ctx = WithChanges(ctx, changes)
+	AddChange(ctx, ENTITY_SERVICE, "1", "1", nil, &entity{Name: "a"})
+	AddChange(ctx, ENTITY_TAG, "1", "", map[string]string{}, map[string]string{})
+	if l := changes.List(); len(l) != 1 || l[0].Entity != ENTITY_SERVICE || l[0].Id != "1" {
+		t.Fatalf("AddChange failed, %v", l)
+	}
+}
+
+func TestQueryOptionMatch(t *testing.T) {
+	entry := &Entry{
+		Timestamp:  100,
+		Domain:     "default",
+		Project:    "default",
+		ServiceId:  "1",
+		InstanceId: "2",
+		Changes: []*Change{
+			{Entity: ENTITY_INSTANCE, ServiceId: "1", Id: "2"},
+		},
+	}
+	cases := []struct {
+		option *QueryOption
+		match  bool
+	}{
+		{&QueryOption{}, true},
+		{&QueryOption{Start: 100, End: 100}, true},
+		{&QueryOption{Start: 101}, false},
+		{&QueryOption{End: 99}, false},
+		{&QueryOption{Domain: "default", Project: "default"}, true},
+		{&QueryOption{Domain: "other"}, false},
+		{&QueryOption{Project: "other"}, false},
+		{&QueryOption{ServiceId: "1"}, true},
+		{&QueryOption{ServiceId: "3"}, false},
+		{&QueryOption{InstanceId: "3"}, false},
+		{&QueryOption{Entity: ENTITY_INSTANCE}, true},
+		{&QueryOption{Entity: ENTITY_SERVICE}, false},
+		{&QueryOption{Entity: ENTITY_INSTANCE, ServiceId: "1"}, true},
+		{&QueryOption{Entity: ENTITY_INSTANCE, ServiceId: "3"}, false},
+	}
+	for i, c := range cases {
+		if c.option.Match(entry) != c.match {
+			t.Fatalf("case %d: Match %v failed, expect %v", i, *c.option, c.match)
+		}
+	}
+}
@@ -20,13 +20,14 @@ import (
 	"fmt"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
 	"golang.org/x/net/context"
+	"strconv"
 )
 
-var (
-	DefaultSchemaQuota = 0
-	DefaultTagQuota    = 0
-	DefaultRuleQuota   = 0
-)
+// UNLIMITED is the limit overriding the upper level to be unlimited
+const UNLIMITED = -1
+
+// DefaultLimits is the limits of the domains without quota record
+var DefaultLimits = &Limits{}
 
 const (
 	RuleQuotaType ResourceType = iota
@@ -37,6 +38,20 @@ const (
 	typeEnd
 )
 
+const (
+	SCOPE_DOMAIN  = "domain"
+	SCOPE_PROJECT = "project"
+	SCOPE_SERVICE = "service"
+)
+
+var ResourceTypes = []ResourceType{
+	MicroServiceQuotaType,
+	MicroServiceInstanceQuotaType,
+	RuleQuotaType,
+	SchemaQuotaType,
+	TagQuotaType,
+}
+
 type ApplyQuotaResult struct {
 	Reporter QuotaReporter
 	Err      *scerr.Error
@@ -68,6 +83,85 @@ func NewApplyQuotaResource(quotaType ResourceType, domainProject, serviceId stri
 type QuotaManager interface {
 	Apply4Quotas(ctx context.Context, res *ApplyQuotaResource) *ApplyQuotaResult
 	RemandQuotas(ctx context.Context, quotaType ResourceType)
+	// GetUsage reports the used and the limit of the resources of the
+	// domainProject, the per-service resources are reported if serviceId is given
+	GetUsage(ctx context.Context, domainProject string, serviceId string) ([]*Usage, error)
+}
+
+// Limits is the quota record of a domain or a project. The service and
+// instance limits are the total of the domain or the project, the others are
+// the limits of each service. The zero value inherits the upper level, and
+// UNLIMITED removes the limit of the upper level
+type Limits struct {
+	Service  int64 `json:"service,omitempty"`
+	Instance int64 `json:"instance,omitempty"`
+	Rule     int64 `json:"rule,omitempty"`
+	Schema   int64 `json:"schema,omitempty"`
+	Tag      int64 `json:"tag,omitempty"`
+}
+
+func (l *Limits) Get(quotaType ResourceType) int64 {
+	if l == nil {
+		return 0
+	}
+	switch quotaType {
+	case MicroServiceQuotaType:
+		return l.Service
+	case MicroServiceInstanceQuotaType:
+		return l.Instance
+	case RuleQuotaType:
+		return l.Rule
+	case SchemaQuotaType:
+		return l.Schema
+	case TagQuotaType:
+		return l.Tag
+	default:
+		return 0
+	}
+}
+
+// Inherit returns a copy of the limits which the unset ones are filled by upper
+func (l *Limits) Inherit(upper *Limits) *Limits {
+	merged := &Limits{}
+	if l != nil {
+		*merged = *l
+	}
+	if upper == nil {
+		return merged
+	}
+	if merged.Service == 0 {
+		merged.Service = upper.Service
+	}
+	if merged.Instance == 0 {
+		merged.Instance = upper.Instance
+	}
+	if merged.Rule == 0 {
+		merged.Rule = upper.Rule
+	}
+	if merged.Schema == 0 {
+		merged.Schema = upper.Schema
+	}
+	if merged.Tag == 0 {
+		merged.Tag = upper.Tag
+	}
+	return merged
+}
+
+func (l *Limits) Validate() error {
+	for _, t := range ResourceTypes {
+		if l.Get(t) < UNLIMITED {
+			return fmt.Errorf("the limit of %s can not be less than %d", t, UNLIMITED)
+		}
+	}
+	return nil
+}
+
+type Usage struct {
+	Type  string `json:"type"`
+	Scope string `json:"scope"`
+	Used  int64  `json:"used"`
+	// Limit is zero or UNLIMITED if the resource is unlimited
+	Limit int64 `json:"limit"`
 }
 
 type QuotaReporter interface {
@@ -90,6 +184,6 @@ func (r ResourceType) String() string {
 	case MicroServiceInstanceQuotaType:
 		return "INSTANCE"
 	default:
-		return "RESOURCE" + fmt.Sprint(r)
+		return "RESOURCE" + strconv.Itoa(int(r))
 	}
 }
@@ -0,0 +1,61 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package quota
+
+import "testing"
+
+func TestLimitsInherit(t *testing.T) {
+	defaults := &Limits{Service: 100, Instance: 1000, Rule: 10, Schema: 10, Tag: 10}
+
+	var record *Limits
+	limits := record.Inherit(defaults)
+	if *limits != *defaults {
+		t.Fatalf("inherit from nil record failed, %v", *limits)
+	}
+
+	record = &Limits{Service: 5, Tag: 20}
+	limits = record.Inherit(defaults)
+	if limits.Get(MicroServiceQuotaType) != 5 || limits.Get(TagQuotaType) != 20 ||
+		limits.Get(MicroServiceInstanceQuotaType) != 1000 || limits.Get(RuleQuotaType) != 10 {
+		t.Fatalf("inherit the unset limits failed, %v", *limits)
+	}
+	if record.Instance != 0 {
+		t.Fatalf("the record is modified, %v", *record)
+	}
+
+	project := (&Limits{Instance: 50}).Inherit(limits)
+	if project.Get(MicroServiceQuotaType) != 5 || project.Get(MicroServiceInstanceQuotaType) != 50 {
+		t.Fatalf("inherit from domain failed, %v", *project)
+	}
+
+	project = (&Limits{Service: UNLIMITED}).Inherit(limits)
+	if project.Get(MicroServiceQuotaType) != UNLIMITED {
+		t.Fatalf("inherit the unlimited failed, %v", *project)
+	}
+}
+
+func TestLimitsValidate(t *testing.T) {
+	if err := (&Limits{Service: 1}).Validate(); err != nil {
+		t.Fatalf("validate failed, %s", err)
+	}
+	if err := (&Limits{Schema: UNLIMITED}).Validate(); err != nil {
+		t.Fatalf("validate unlimited failed, %s", err)
+	}
+	if err := (&Limits{Schema: -2}).Validate(); err == nil {
+		t.Fatalf("validate negative limit failed")
+	}
+}
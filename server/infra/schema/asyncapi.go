@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+const TYPE_ASYNCAPI = "asyncapi"
+
+var asyncAPIVersionRegex = regexp.MustCompile(`^2\.\d+\.\d+$`)
+
+func init() {
+	RegisterType(&asyncAPIType{})
+}
+
+// asyncAPIType validates the AsyncAPI 2.x documents
+type asyncAPIType struct {
+}
+
+func (t *asyncAPIType) Name() string {
+	return TYPE_ASYNCAPI
+}
+
+func (t *asyncAPIType) Match(doc *Document) bool {
+	return doc.HasKey("asyncapi")
+}
+
+func (t *asyncAPIType) Validate(doc *Document) (errs Errors) {
+	root, ok := doc.Tree.(map[string]interface{})
+	if !ok {
+		errs.Add("", "document must be an object")
+		return
+	}
+
+	if version, _ := root["asyncapi"].(string); !asyncAPIVersionRegex.MatchString(version) {
+		errs.Add("/asyncapi", "must be a 2.x.y version string")
+	}
+
+	validateInfo(root, &errs)
+
+	channels, ok := root["channels"].(map[string]interface{})
+	if !ok {
+		errs.Add("/channels", "is required and must be an object")
+		return
+	}
+	for _, name := range sortedKeys(channels) {
+		location := Pointer("/channels", name)
+		if strings.HasPrefix(name, "x-") {
+			continue
+		}
+		channel, ok := channels[name].(map[string]interface{})
+		if !ok {
+			errs.Add(location, "channel item must be an object")
+			continue
+		}
+		for _, action := range []string{"publish", "subscribe"} {
+			v, exist := channel[action]
+			if !exist {
+				continue
+			}
+			op, ok := v.(map[string]interface{})
+			if !ok {
+				errs.Add(Pointer(location, action), "operation must be an object")
+				continue
+			}
+			if message, exist := op["message"]; exist {
+				if _, ok := message.(map[string]interface{}); !ok {
+					errs.Add(Pointer(location, action, "message"), "must be an object")
+				}
+			}
+		}
+	}
+	return
+}
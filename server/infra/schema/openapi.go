@@ -0,0 +1,249 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schema
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	TYPE_SWAGGER2 = "swagger2"
+	TYPE_OPENAPI3 = "openapi3"
+)
+
+var (
+	openAPI3VersionRegex = regexp.MustCompile(`^3\.\d+\.\d+$`)
+	pathParamRegex       = regexp.MustCompile(`{([^{}]+)}`)
+
+	operationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+	swagger2ParamLocations = map[string]bool{"query": true, "header": true, "path": true, "formData": true, "body": true}
+	openAPI3ParamLocations = map[string]bool{"query": true, "header": true, "path": true, "cookie": true}
+)
+
+func init() {
+	RegisterType(&openAPIType{name: TYPE_SWAGGER2, versionKey: "swagger"})
+	RegisterType(&openAPIType{name: TYPE_OPENAPI3, versionKey: "openapi"})
+}
+
+// openAPIType validates the Swagger 2.0 and OpenAPI 3 documents, the
+// checks cover the structure which the consumers rely on rather than the
+// full specification
+type openAPIType struct {
+	name       string
+	versionKey string
+}
+
+func (t *openAPIType) Name() string {
+	return t.name
+}
+
+func (t *openAPIType) Match(doc *Document) bool {
+	return doc.HasKey(t.versionKey)
+}
+
+func (t *openAPIType) Validate(doc *Document) (errs Errors) {
+	root, ok := doc.Tree.(map[string]interface{})
+	if !ok {
+		errs.Add("", "document must be an object")
+		return
+	}
+
+	version, _ := root[t.versionKey].(string)
+	if number, ok := root[t.versionKey].(float64); ok && number == 2 {
+		// unquoted 2.0 in yaml
+		version = "2.0"
+	}
+	switch {
+	case t.name == TYPE_SWAGGER2 && version != "2.0":
+		errs.Add(Pointer("", t.versionKey), "must be \"2.0\"")
+	case t.name == TYPE_OPENAPI3 && !openAPI3VersionRegex.MatchString(version):
+		errs.Add(Pointer("", t.versionKey), "must be a 3.x.y version string")
+	}
+
+	validateInfo(root, &errs)
+
+	paths, ok := root["paths"].(map[string]interface{})
+	if !ok {
+		// paths is optional since OpenAPI 3.1
+		if _, exist := root["paths"]; exist || !strings.HasPrefix(version, "3.") || strings.HasPrefix(version, "3.0.") {
+			errs.Add("/paths", "is required and must be an object")
+		}
+		return
+	}
+	for _, path := range sortedKeys(paths) {
+		location := Pointer("/paths", path)
+		if strings.HasPrefix(path, "x-") {
+			continue
+		}
+		if !strings.HasPrefix(path, "/") {
+			errs.Add(location, "path must begin with a slash")
+		}
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			errs.Add(location, "path item must be an object")
+			continue
+		}
+		t.validatePathItem(location, path, item, &errs)
+	}
+	return
+}
+
+func (t *openAPIType) validatePathItem(location, path string, item map[string]interface{}, errs *Errors) {
+	templateParams := make(map[string]bool)
+	for _, m := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		templateParams[m[1]] = true
+	}
+
+	commonParams, hasRef := t.validateParameters(Pointer(location, "parameters"), item["parameters"], errs)
+	for _, method := range operationMethods {
+		v, exist := item[method]
+		if !exist {
+			continue
+		}
+		opLocation := Pointer(location, method)
+		op, ok := v.(map[string]interface{})
+		if !ok {
+			errs.Add(opLocation, "operation must be an object")
+			continue
+		}
+		params, opHasRef := t.validateParameters(Pointer(opLocation, "parameters"), op["parameters"], errs)
+		for name := range commonParams {
+			params[name] = true
+		}
+		if !hasRef && !opHasRef {
+			for name := range templateParams {
+				if !params[name] {
+					errs.Add(opLocation, "path parameter '%s' is not defined", name)
+				}
+			}
+		}
+
+		responses, ok := op["responses"].(map[string]interface{})
+		if !ok || len(responses) == 0 {
+			errs.Add(Pointer(opLocation, "responses"), "is required and must have at least one response")
+		}
+		if t.name == TYPE_OPENAPI3 {
+			if body, exist := op["requestBody"]; exist {
+				body, ok := body.(map[string]interface{})
+				if !ok {
+					errs.Add(Pointer(opLocation, "requestBody"), "must be an object")
+				} else if _, isRef := body["$ref"]; !isRef {
+					if _, ok := body["content"].(map[string]interface{}); !ok {
+						errs.Add(Pointer(opLocation, "requestBody", "content"), "is required and must be an object")
+					}
+				}
+			}
+		}
+	}
+}
+
+// validateParameters returns the names of path parameters, and whether
+// any parameter is a reference which can not be resolved here
+func (t *openAPIType) validateParameters(location string, v interface{}, errs *Errors) (map[string]bool, bool) {
+	pathParams := make(map[string]bool)
+	if v == nil {
+		return pathParams, false
+	}
+	params, ok := v.([]interface{})
+	if !ok {
+		errs.Add(location, "must be an array")
+		return pathParams, false
+	}
+
+	locations := swagger2ParamLocations
+	if t.name == TYPE_OPENAPI3 {
+		locations = openAPI3ParamLocations
+	}
+	hasRef := false
+	for i, p := range params {
+		paramLocation := Pointer(location, strconv.Itoa(i))
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			errs.Add(paramLocation, "parameter must be an object")
+			continue
+		}
+		if _, ok := param["$ref"]; ok {
+			hasRef = true
+			continue
+		}
+		name, _ := param["name"].(string)
+		if len(name) == 0 {
+			errs.Add(Pointer(paramLocation, "name"), "is required")
+		}
+		in, _ := param["in"].(string)
+		if !locations[in] {
+			errs.Add(Pointer(paramLocation, "in"), "must be one of %s", strings.Join(sortedKeys(locations), ", "))
+			continue
+		}
+		if in == "path" {
+			pathParams[name] = true
+			if required, _ := param["required"].(bool); !required {
+				errs.Add(Pointer(paramLocation, "required"), "must be true for path parameter")
+			}
+		}
+		switch {
+		case t.name == TYPE_SWAGGER2 && in == "body":
+			if _, ok := param["schema"]; !ok {
+				errs.Add(Pointer(paramLocation, "schema"), "is required for body parameter")
+			}
+		case t.name == TYPE_SWAGGER2:
+			if _, ok := param["type"]; !ok {
+				errs.Add(Pointer(paramLocation, "type"), "is required for non-body parameter")
+			}
+		default:
+			_, hasSchema := param["schema"]
+			_, hasContent := param["content"]
+			if hasSchema == hasContent {
+				errs.Add(paramLocation, "must contain either schema or content")
+			}
+		}
+	}
+	return pathParams, hasRef
+}
+
+func validateInfo(root map[string]interface{}, errs *Errors) {
+	info, ok := root["info"].(map[string]interface{})
+	if !ok {
+		errs.Add("/info", "is required and must be an object")
+		return
+	}
+	for _, key := range []string{"title", "version"} {
+		if s, _ := info[key].(string); len(s) == 0 {
+			errs.Add(Pointer("/info", key), "is required")
+		}
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch v := m.(type) {
+	case map[string]interface{}:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	case map[string]bool:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
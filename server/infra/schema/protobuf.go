@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schema
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+)
+
+const TYPE_PROTOBUF = "protobuf"
+
+func init() {
+	RegisterType(&protobufType{})
+}
+
+// protobufType validates the base64 encoded FileDescriptorSet, which is
+// generated by 'protoc --include_imports --descriptor_set_out', so that
+// all the dependencies and referenced types must be in the set
+type protobufType struct {
+}
+
+func (t *protobufType) Name() string {
+	return TYPE_PROTOBUF
+}
+
+func (t *protobufType) Match(doc *Document) bool {
+	return decodeDescriptorSet(doc.Content) != nil
+}
+
+func (t *protobufType) Validate(doc *Document) (errs Errors) {
+	set := decodeDescriptorSet(doc.Content)
+
+	files := make(map[string]bool, len(set.File))
+	defined := make(map[string]bool)
+	for _, file := range set.File {
+		if files[file.GetName()] {
+			errs.Add(file.GetName(), "file is duplicated")
+		}
+		files[file.GetName()] = true
+
+		prefix := ""
+		if len(file.GetPackage()) > 0 {
+			prefix = "." + file.GetPackage()
+		}
+		for _, msg := range file.MessageType {
+			collectMessageTypes(prefix, msg, defined)
+		}
+		for _, enum := range file.EnumType {
+			defined[prefix+"."+enum.GetName()] = true
+		}
+	}
+
+	for _, file := range set.File {
+		for _, dep := range file.Dependency {
+			if !files[dep] {
+				errs.Add(file.GetName(), "dependency '%s' is not in the set", dep)
+			}
+		}
+		prefix := ""
+		if len(file.GetPackage()) > 0 {
+			prefix = "." + file.GetPackage()
+		}
+		for _, msg := range file.MessageType {
+			validateMessageFields(file.GetName(), prefix, msg, defined, &errs)
+		}
+		for _, svc := range file.Service {
+			for _, method := range svc.Method {
+				location := fmt.Sprintf("%s: %s%s.%s", file.GetName(), prefix, "."+svc.GetName(), method.GetName())
+				for _, typeName := range []string{method.GetInputType(), method.GetOutputType()} {
+					if !defined[typeName] {
+						errs.Add(location, "type '%s' is undefined", typeName)
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func decodeDescriptorSet(content string) *descriptor.FileDescriptorSet {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(content))
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+	set := &descriptor.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil || len(set.File) == 0 {
+		return nil
+	}
+	for _, file := range set.File {
+		if !strings.HasSuffix(file.GetName(), ".proto") {
+			return nil
+		}
+	}
+	return set
+}
+
+func collectMessageTypes(prefix string, msg *descriptor.DescriptorProto, defined map[string]bool) {
+	name := prefix + "." + msg.GetName()
+	defined[name] = true
+	for _, nested := range msg.NestedType {
+		collectMessageTypes(name, nested, defined)
+	}
+	for _, enum := range msg.EnumType {
+		defined[name+"."+enum.GetName()] = true
+	}
+}
+
+func validateMessageFields(file, prefix string, msg *descriptor.DescriptorProto, defined map[string]bool, errs *Errors) {
+	name := prefix + "." + msg.GetName()
+	for _, field := range msg.Field {
+		// protoc always resolves the type name to a fully-qualified one
+		if len(field.GetTypeName()) > 0 && !defined[field.GetTypeName()] {
+			errs.Add(fmt.Sprintf("%s: %s.%s", file, name, field.GetName()), "type '%s' is undefined", field.GetTypeName())
+		}
+	}
+	for _, nested := range msg.NestedType {
+		validateMessageFields(file, name, nested, defined, errs)
+	}
+}
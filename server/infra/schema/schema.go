@@ -0,0 +1,217 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/ghodss/yaml"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	types   []Type
+	typesMu sync.RWMutex
+
+	yamlLineRegex   = regexp.MustCompile(`yaml: line (\d+): (.*)`)
+	pointerEscaper  = strings.NewReplacer("~", "~0", "/", "~1")
+	documentKeyExpr = `(?m)^\s*["']?%s["']?\s*:`
+)
+
+// Type is a kind of schema, such as Swagger 2.0 or OpenAPI 3, which can
+// recognize and validate the documents written in it
+type Type interface {
+	Name() string
+	// Match reports whether the document is written in this type, the
+	// document is validated by the first matched type
+	Match(doc *Document) bool
+	Validate(doc *Document) Errors
+}
+
+// Document is an uploaded schema, the json or yaml content is parsed only
+// once and shared by all the types
+type Document struct {
+	Content string
+	// Tree is the parsed json or yaml content, it is nil if the content
+	// is not a json or yaml document
+	Tree interface{}
+	// SyntaxError is the error when parse the json or yaml content
+	SyntaxError *Error
+}
+
+// HasKey reports whether the document is an object owns the key at top
+// level, the content with syntax error is searched by text
+func (doc *Document) HasKey(key string) bool {
+	if m, ok := doc.Tree.(map[string]interface{}); ok {
+		_, ok = m[key]
+		return ok
+	}
+	if doc.SyntaxError == nil {
+		return false
+	}
+	r := regexp.MustCompile(fmt.Sprintf(documentKeyExpr, regexp.QuoteMeta(key)))
+	return r.MatchString(doc.Content)
+}
+
+// Error is a problem of the schema, Location is a json pointer of the
+// node or a line and column of the content
+type Error struct {
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if len(e.Location) == 0 {
+		return e.Message
+	}
+	return e.Location + ": " + e.Message
+}
+
+type Errors []*Error
+
+func (es Errors) Error() string {
+	msgs := make([]string, 0, len(es))
+	for _, e := range es {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (es *Errors) Add(location, format string, args ...interface{}) {
+	*es = append(*es, &Error{Location: location, Message: fmt.Sprintf(format, args...)})
+}
+
+// Pointer joins the tokens to a json pointer
+func Pointer(parent string, tokens ...string) string {
+	for _, token := range tokens {
+		parent += "/" + pointerEscaper.Replace(token)
+	}
+	return parent
+}
+
+// RegisterType adds a schema type, it panics if the name is redeclared
+func RegisterType(t Type) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	for _, e := range types {
+		if e.Name() == t.Name() {
+			panic(fmt.Sprintf("redeclare schema type '%s'", t.Name()))
+		}
+	}
+	types = append(types, t)
+}
+
+func TypeNames() []string {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.Name())
+	}
+	return names
+}
+
+func NewDocument(content string) *Document {
+	doc := &Document{Content: content}
+	data := []byte(content)
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		// json reports the offset of syntax error, which is more precise
+		// than yaml
+		if err := json.Unmarshal(data, &doc.Tree); err != nil {
+			doc.Tree = nil
+			doc.SyntaxError = jsonSyntaxError(data, err)
+		}
+		return doc
+	}
+	js, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		doc.SyntaxError = yamlSyntaxError(err)
+		return doc
+	}
+	if err := json.Unmarshal(js, &doc.Tree); err != nil {
+		doc.SyntaxError = &Error{Message: err.Error()}
+	}
+	return doc
+}
+
+func jsonSyntaxError(data []byte, err error) *Error {
+	var offset int64
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		offset = e.Offset
+	case *json.UnmarshalTypeError:
+		offset = e.Offset
+	default:
+		return &Error{Message: err.Error()}
+	}
+	// the offset is after the invalid byte
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	if offset > 0 {
+		offset--
+	}
+	line, column := 1, 1
+	for _, b := range data[:offset] {
+		if b == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return &Error{
+		Location: "line " + strconv.Itoa(line) + ", column " + strconv.Itoa(column),
+		Message:  err.Error(),
+	}
+}
+
+func yamlSyntaxError(err error) *Error {
+	msg := err.Error()
+	m := yamlLineRegex.FindStringSubmatch(msg)
+	if m == nil {
+		return &Error{Message: msg}
+	}
+	return &Error{Location: "line " + m[1], Message: m[2]}
+}
+
+// Validate detects the type of the schema content and validates it, the
+// content of unknown type is accepted as opaque text and returns an empty
+// type name
+func Validate(content string) (string, error) {
+	doc := NewDocument(content)
+
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+	for _, t := range types {
+		if !t.Match(doc) {
+			continue
+		}
+		if doc.SyntaxError != nil {
+			return t.Name(), Errors{doc.SyntaxError}
+		}
+		if errs := t.Validate(doc); len(errs) > 0 {
+			return t.Name(), errs
+		}
+		return t.Name(), nil
+	}
+	return "", nil
+}
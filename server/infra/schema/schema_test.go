@@ -0,0 +1,196 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package schema
+
+import (
+	"encoding/base64"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"strings"
+	"testing"
+)
+
+func assertSchemaErrors(t *testing.T, content, expectedType string, locations ...string) {
+	schemaType, err := Validate(content)
+	if schemaType != expectedType {
+		t.Fatalf("Validate %q failed, type %s, expected %s", content, schemaType, expectedType)
+	}
+	if len(locations) == 0 {
+		if err != nil {
+			t.Fatalf("Validate %q failed, %s", content, err)
+		}
+		return
+	}
+	errs, ok := err.(Errors)
+	if !ok || len(errs) != len(locations) {
+		t.Fatalf("Validate %q failed, %v", content, err)
+	}
+	for i, e := range errs {
+		if e.Location != locations[i] {
+			t.Fatalf("Validate %q failed, location %s, expected %s", content, e.Location, locations[i])
+		}
+	}
+}
+
+func TestValidateOpenAPI(t *testing.T) {
+	assertSchemaErrors(t, `
+swagger: "2.0"
+info:
+  title: hello
+  version: 1.0.0
+paths:
+  /hello/{name}:
+    parameters:
+      - name: name
+        in: path
+        required: true
+        type: string
+    get:
+      responses:
+        200:
+          description: ok
+`, TYPE_SWAGGER2)
+
+	assertSchemaErrors(t, `
+swagger: "2.0"
+info:
+  title: hello
+paths:
+  /hello/{name}:
+    post:
+      parameters:
+        - name: body
+          in: body
+      responses: {}
+`, TYPE_SWAGGER2,
+		"/info/version",
+		"/paths/~1hello~1{name}/post/parameters/0/schema",
+		"/paths/~1hello~1{name}/post",
+		"/paths/~1hello~1{name}/post/responses")
+
+	assertSchemaErrors(t, `{
+  "openapi": "3.0.0",
+  "info": {"title": "hello", "version": "1.0.0"},
+  "paths": {
+    "/hello": {
+      "get": {
+        "parameters": [{"name": "name", "in": "formData", "schema": {"type": "string"}}],
+        "responses": {"200": {"description": "ok"}}
+      }
+    }
+  }
+}`, TYPE_OPENAPI3, "/paths/~1hello/get/parameters/0/in")
+
+	assertSchemaErrors(t, `{"openapi": "3.1.0", "info": {"title": "hello", "version": "1.0.0"}, "webhooks": {}}`, TYPE_OPENAPI3)
+	assertSchemaErrors(t, "{\n  \"openapi\": \"3.0.0\",\n  \"info\": {,\n}", TYPE_OPENAPI3, "line 3, column 12")
+	assertSchemaErrors(t, "swagger: \"2.0\"\ninfo: title: hello\n", TYPE_SWAGGER2, "line 2")
+}
+
+func TestValidateAsyncAPI(t *testing.T) {
+	assertSchemaErrors(t, `
+asyncapi: 2.0.0
+info:
+  title: hello
+  version: 1.0.0
+channels:
+  user/signedup:
+    subscribe:
+      message:
+        payload:
+          type: string
+`, TYPE_ASYNCAPI)
+
+	assertSchemaErrors(t, `
+asyncapi: 1.2.0
+info:
+  title: hello
+  version: 1.0.0
+channels:
+  user/signedup:
+    publish: hello
+`, TYPE_ASYNCAPI, "/asyncapi", "/channels/user~1signedup/publish")
+}
+
+func TestValidateProtobuf(t *testing.T) {
+	encode := func(set *descriptor.FileDescriptorSet) string {
+		data, err := proto.Marshal(set)
+		if err != nil {
+			t.Fatalf("Marshal descriptor set failed, %s", err)
+		}
+		return base64.StdEncoding.EncodeToString(data)
+	}
+	set := &descriptor.FileDescriptorSet{
+		File: []*descriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("hello.proto"),
+				Package: proto.String("hello"),
+				MessageType: []*descriptor.DescriptorProto{
+					{
+						Name: proto.String("HelloRequest"),
+						Field: []*descriptor.FieldDescriptorProto{
+							{Name: proto.String("name"), TypeName: proto.String(".hello.Name")},
+						},
+					},
+				},
+				Service: []*descriptor.ServiceDescriptorProto{
+					{
+						Name: proto.String("Greeter"),
+						Method: []*descriptor.MethodDescriptorProto{
+							{
+								Name:       proto.String("SayHello"),
+								InputType:  proto.String(".hello.HelloRequest"),
+								OutputType: proto.String(".hello.HelloReply"),
+							},
+						},
+					},
+				},
+				Dependency: []string{"name.proto"},
+			},
+		},
+	}
+	assertSchemaErrors(t, encode(set), TYPE_PROTOBUF, "hello.proto", "hello.proto: .hello.HelloRequest.name", "hello.proto: .hello.Greeter.SayHello")
+
+	file := set.File[0]
+	file.MessageType = append(file.MessageType, &descriptor.DescriptorProto{Name: proto.String("HelloReply")})
+	set.File = append(set.File, &descriptor.FileDescriptorProto{
+		Name:        proto.String("name.proto"),
+		Package:     proto.String("hello"),
+		MessageType: []*descriptor.DescriptorProto{{Name: proto.String("Name")}},
+	})
+	assertSchemaErrors(t, encode(set), TYPE_PROTOBUF)
+}
+
+func TestValidateUnknown(t *testing.T) {
+	for _, content := range []string{"", "first_schema", "test", "syntax = \"proto3\";", "{\"a\": 1}"} {
+		assertSchemaErrors(t, content, "")
+	}
+}
+
+func TestRegisterType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("RegisterType a redeclared type should panic")
+		}
+	}()
+	names := strings.Join(TypeNames(), ",")
+	for _, name := range []string{TYPE_SWAGGER2, TYPE_OPENAPI3, TYPE_ASYNCAPI, TYPE_PROTOBUF} {
+		if !strings.Contains(names, name) {
+			t.Fatalf("TypeNames failed, %s not found", name)
+		}
+	}
+	RegisterType(&asyncAPIType{})
+}
@@ -21,3 +21,11 @@ type Cipher interface {
 
 	Decrypt(src string) (string, error)
 }
+
+// RotatableCipher is the Cipher encrypting by the primary key of several
+// keys, the values encrypted by the other keys are re-encrypted in background
+type RotatableCipher interface {
+	Cipher
+	// IsStale returns true if the value is not encrypted by the primary key
+	IsStale(src string) bool
+}
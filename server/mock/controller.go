@@ -0,0 +1,109 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mock
+
+import (
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const MOCK_ROOT_PATH = "/mock/"
+
+func init() {
+	rest.RegisterServent(&MockController{})
+}
+
+type MockController struct {
+}
+
+func (this *MockController) URLPatterns() []rest.Route {
+	// the path of the operation follows the schemaId
+	return []rest.Route{
+		{rest.HTTP_METHOD_GET, MOCK_ROOT_PATH + ":serviceId/:schemaId/", this.Mock},
+		{rest.HTTP_METHOD_PUT, MOCK_ROOT_PATH + ":serviceId/:schemaId/", this.Mock},
+		{rest.HTTP_METHOD_POST, MOCK_ROOT_PATH + ":serviceId/:schemaId/", this.Mock},
+		{rest.HTTP_METHOD_DELETE, MOCK_ROOT_PATH + ":serviceId/:schemaId/", this.Mock},
+		{rest.HTTP_METHOD_PATCH, MOCK_ROOT_PATH + ":serviceId/:schemaId/", this.Mock},
+	}
+}
+
+func (this *MockController) Mock(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	request := &pb.GetSchemaRequest{
+		ServiceId: query.Get(":serviceId"),
+		SchemaId:  query.Get(":schemaId"),
+	}
+	resp, _ := core.ServiceAPI.GetSchemaInfo(r.Context(), request)
+	if resp.Response.Code != pb.Response_SUCCESS {
+		controller.WriteResponse(w, resp.Response, nil)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+
+	// remove the path parameters of the route
+	for key := range query {
+		if strings.HasPrefix(key, ":") {
+			delete(query, key)
+		}
+	}
+	path := "/"
+	if arr := strings.SplitN(strings.TrimPrefix(r.URL.Path, MOCK_ROOT_PATH), "/", 3); len(arr) == 3 {
+		path += arr[2]
+	}
+
+	mockResp, err := Mock(resp.Schema, &Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  query,
+		Header: r.Header,
+		Body:   body,
+	})
+	switch err.(type) {
+	case nil:
+	case *NotFoundError:
+		controller.WriteError(w, scerr.ErrOperationNotExists, err.Error())
+		return
+	case ValidationError:
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	default:
+		util.Logger().Errorf(err, "mock schema %s/%s failed", request.ServiceId, request.SchemaId)
+		controller.WriteError(w, scerr.ErrInvalidSchema, err.Error())
+		return
+	}
+
+	w.Header().Set(rest.HEADER_RESPONSE_STATUS, fmt.Sprint(mockResp.StatusCode))
+	if len(mockResp.ContentType) > 0 {
+		w.Header().Set(rest.HEADER_CONTENT_TYPE, mockResp.ContentType)
+	}
+	w.WriteHeader(mockResp.StatusCode)
+	w.Write(mockResp.Body)
+}
@@ -0,0 +1,779 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/ghodss/yaml"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// the client selects the declared response by this header, the lowest
+	// 2xx response is returned by default
+	HEADER_MOCK_STATUS = "X-Mock-Status"
+
+	// stop at the recursive schemas
+	maxSchemaDepth = 32
+	// stop at the schemas which expand to too many nodes, e.g. the object
+	// whose properties refer to the same definition at each level
+	maxSchemaNodes = 10000
+)
+
+var (
+	ErrNotOpenAPI = errors.New("only OpenAPI/Swagger schemas can be mocked")
+
+	pathTemplateRegex = regexp.MustCompile(`\{([^}]*)\}`)
+)
+
+// Request is the request to the mocked operation
+type Request struct {
+	Method string
+	// Path is relative to the schema, it may be prefixed with the basePath
+	// of Swagger 2.0 or the path of the server url of OpenAPI 3.x
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// NotFoundError means the operation is not declared in the schema
+type NotFoundError struct {
+	Method string
+	Path   string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("operation %s %s is not declared in the schema", e.Method, e.Path)
+}
+
+// ValidationError is the list of the violations of the request
+type ValidationError []string
+
+func (e ValidationError) Error() string {
+	return strings.Join(e, "; ")
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+func asArray(v interface{}) []interface{} {
+	a, _ := v.([]interface{})
+	return a
+}
+
+func asString(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func asBool(v interface{}) bool {
+	b, _ := v.(bool)
+	return b
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonMediaType returns the preferred media type in the content, the json
+// one is preferred
+func jsonMediaType(types []string) string {
+	if len(types) == 0 {
+		return ""
+	}
+	for _, t := range types {
+		if t == "application/json" {
+			return t
+		}
+	}
+	for _, t := range types {
+		if strings.Contains(t, "json") || t == "*/*" {
+			return t
+		}
+	}
+	return types[0]
+}
+
+type operation struct {
+	item       map[string]interface{}
+	node       map[string]interface{}
+	pathParams map[string]string
+	templates  int
+}
+
+type document struct {
+	root      map[string]interface{}
+	openapi3  bool
+	basePaths []string
+	// nodes is the number of the schema nodes visited by the request
+	nodes int
+}
+
+func parseDocument(content string) (*document, error) {
+	data, err := yaml.YAMLToJSON(util.StringToBytesWithNoCopy(content))
+	if err != nil {
+		return nil, ErrNotOpenAPI
+	}
+	doc := &document{}
+	if err := json.Unmarshal(data, &doc.root); err != nil || doc.root == nil {
+		return nil, ErrNotOpenAPI
+	}
+
+	var basePaths []string
+	switch {
+	case doc.root["openapi"] != nil:
+		doc.openapi3 = true
+		for _, server := range asArray(doc.root["servers"]) {
+			if u, err := url.Parse(asString(asMap(server)["url"])); err == nil {
+				basePaths = append(basePaths, u.Path)
+			}
+		}
+	case doc.root["swagger"] != nil:
+		basePaths = append(basePaths, asString(doc.root["basePath"]))
+	default:
+		return nil, ErrNotOpenAPI
+	}
+	for _, basePath := range basePaths {
+		if basePath = strings.TrimRight(basePath, "/"); len(basePath) > 0 {
+			doc.basePaths = append(doc.basePaths, basePath)
+		}
+	}
+	return doc, nil
+}
+
+// pointer returns the node referred by the local json pointer
+func (doc *document) pointer(ref string) interface{} {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil
+	}
+	var node interface{} = doc.root
+	for _, token := range strings.Split(ref[2:], "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+		node = asMap(node)[token]
+	}
+	return node
+}
+
+// visit counts the schema node, it returns false if the request has visited
+// too many nodes, the rest of the value is not validated or generated
+func (doc *document) visit() bool {
+	doc.nodes++
+	return doc.nodes <= maxSchemaNodes
+}
+
+// resolve follows the local $ref of the node
+func (doc *document) resolve(node map[string]interface{}) map[string]interface{} {
+	for i := 0; i < maxSchemaDepth && node != nil; i++ {
+		ref, ok := node["$ref"].(string)
+		if !ok {
+			return node
+		}
+		node = asMap(doc.pointer(ref))
+	}
+	return node
+}
+
+// matchTemplate returns the path parameters if the path matches the path
+// template declared in the schema
+func matchTemplate(template, path string) (map[string]string, int, bool) {
+	templateSegments := strings.Split(strings.Trim(template, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return nil, 0, false
+	}
+	params := make(map[string]string)
+	count := 0
+	for i, segment := range templateSegments {
+		names := pathTemplateRegex.FindAllStringSubmatch(segment, -1)
+		if len(names) == 0 {
+			if segment != pathSegments[i] {
+				return nil, 0, false
+			}
+			continue
+		}
+		parts := pathTemplateRegex.Split(segment, -1)
+		pattern := "^" + regexp.QuoteMeta(parts[0])
+		for _, part := range parts[1:] {
+			pattern += "(.+?)" + regexp.QuoteMeta(part)
+		}
+		values := regexp.MustCompile(pattern + "$").FindStringSubmatch(pathSegments[i])
+		if values == nil {
+			return nil, 0, false
+		}
+		for j, name := range names {
+			value, err := url.PathUnescape(values[j+1])
+			if err != nil {
+				value = values[j+1]
+			}
+			params[name[1]] = value
+		}
+		count += len(names)
+	}
+	return params, count, true
+}
+
+// findOperation returns the operation which matches the request, the path
+// without templates is preferred
+func (doc *document) findOperation(method, path string) (*operation, error) {
+	candidates := []string{path}
+	for _, basePath := range doc.basePaths {
+		if strings.HasPrefix(path, basePath+"/") || path == basePath {
+			candidates = append([]string{path[len(basePath):]}, candidates...)
+		}
+	}
+
+	paths := asMap(doc.root["paths"])
+	for _, candidate := range candidates {
+		var found *operation
+		for _, template := range sortedKeys(paths) {
+			params, templates, ok := matchTemplate(template, candidate)
+			if !ok {
+				continue
+			}
+			item := doc.resolve(asMap(paths[template]))
+			node := asMap(item[strings.ToLower(method)])
+			if node == nil {
+				continue
+			}
+			if found == nil || templates < found.templates {
+				found = &operation{item: item, node: node, pathParams: params, templates: templates}
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+	return nil, &NotFoundError{Method: method, Path: path}
+}
+
+// parameters returns the resolved parameters of the operation, the operation
+// level parameters override the path level ones
+func (doc *document) parameters(op *operation) []map[string]interface{} {
+	var (
+		keys   []string
+		params = make(map[string]map[string]interface{})
+	)
+	for _, list := range [][]interface{}{asArray(op.item["parameters"]), asArray(op.node["parameters"])} {
+		for _, v := range list {
+			param := doc.resolve(asMap(v))
+			if param == nil {
+				continue
+			}
+			key := asString(param["in"]) + ":" + asString(param["name"])
+			if _, ok := params[key]; !ok {
+				keys = append(keys, key)
+			}
+			params[key] = param
+		}
+	}
+	result := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, params[key])
+	}
+	return result
+}
+
+func (doc *document) validateRequest(op *operation, req *Request) ValidationError {
+	var (
+		errs ValidationError
+		form url.Values
+	)
+	for _, param := range doc.parameters(op) {
+		name, in := asString(param["name"]), asString(param["in"])
+		desc := fmt.Sprintf("%s parameter '%s'", in, name)
+
+		var values []string
+		switch in {
+		case "path":
+			if v, ok := op.pathParams[name]; ok {
+				values = []string{v}
+			}
+		case "query":
+			values = req.Query[name]
+		case "header":
+			values = req.Header[http.CanonicalHeaderKey(name)]
+		case "formData":
+			if form == nil {
+				form, _ = url.ParseQuery(util.BytesToStringWithNoCopy(req.Body))
+			}
+			values = form[name]
+		case "body":
+			errs = append(errs, doc.validateBody(req, asBool(param["required"]), asMap(param["schema"]))...)
+			continue
+		default:
+			continue
+		}
+
+		if len(values) == 0 {
+			if asBool(param["required"]) || in == "path" {
+				errs = append(errs, desc+" is required")
+			}
+			continue
+		}
+		schema := param
+		if doc.openapi3 {
+			schema = doc.resolve(asMap(param["schema"]))
+		}
+		errs = append(errs, doc.validateParameter(desc, schema, values)...)
+	}
+
+	if body := doc.resolve(asMap(op.node["requestBody"])); body != nil {
+		content := asMap(body["content"])
+		schema := asMap(asMap(content[jsonMediaType(sortedKeys(content))])["schema"])
+		errs = append(errs, doc.validateBody(req, asBool(body["required"]), schema)...)
+	}
+	return errs
+}
+
+func (doc *document) validateBody(req *Request, required bool, schema map[string]interface{}) []string {
+	if len(bytes.TrimSpace(req.Body)) == 0 {
+		if required {
+			return []string{"request body is required"}
+		}
+		return nil
+	}
+	contentType := req.Header.Get("Content-Type")
+	if schema == nil || (len(contentType) > 0 && !strings.Contains(contentType, "json")) {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(req.Body, &v); err != nil {
+		return []string{"request body is not a valid json: " + err.Error()}
+	}
+	return doc.validateValue("body", schema, v, 0)
+}
+
+func parseScalar(schema map[string]interface{}, s string) (interface{}, bool) {
+	switch asString(schema["type"]) {
+	case "integer":
+		i, err := strconv.ParseInt(s, 10, 64)
+		return float64(i), err == nil
+	case "number":
+		f, err := strconv.ParseFloat(s, 64)
+		return f, err == nil
+	case "boolean":
+		b, err := strconv.ParseBool(s)
+		return b, err == nil
+	default:
+		return s, true
+	}
+}
+
+// validateParameter validates the values of the parameter, the items of the
+// array parameter are separated by comma or repeated
+func (doc *document) validateParameter(desc string, schema map[string]interface{}, values []string) []string {
+	if schema == nil {
+		return nil
+	}
+	if asString(schema["type"]) != "array" {
+		v, ok := parseScalar(schema, values[0])
+		if !ok {
+			return []string{fmt.Sprintf("%s must be %s", desc, asString(schema["type"]))}
+		}
+		return doc.validateValue(desc, schema, v, 0)
+	}
+
+	items := doc.resolve(asMap(schema["items"]))
+	var arr []interface{}
+	for _, value := range values {
+		for _, s := range strings.Split(value, ",") {
+			v, ok := parseScalar(items, s)
+			if !ok {
+				return []string{fmt.Sprintf("%s must be an array of %s", desc, asString(items["type"]))}
+			}
+			arr = append(arr, v)
+		}
+	}
+	return doc.validateValue(desc, schema, arr, 0)
+}
+
+func (doc *document) validateNumber(desc string, schema map[string]interface{}, f float64) []string {
+	var errs []string
+	if min, ok := schema["minimum"].(float64); ok {
+		if f < min || (f == min && asBool(schema["exclusiveMinimum"])) {
+			errs = append(errs, fmt.Sprintf("%s must not be less than %v", desc, min))
+		}
+	}
+	if max, ok := schema["maximum"].(float64); ok {
+		if f > max || (f == max && asBool(schema["exclusiveMaximum"])) {
+			errs = append(errs, fmt.Sprintf("%s must not be greater than %v", desc, max))
+		}
+	}
+	return errs
+}
+
+func (doc *document) validateString(desc string, schema map[string]interface{}, s string) []string {
+	var errs []string
+	l := float64(utf8.RuneCountInString(s))
+	if min, ok := schema["minLength"].(float64); ok && l < min {
+		errs = append(errs, fmt.Sprintf("%s must be at least %v characters", desc, min))
+	}
+	if max, ok := schema["maxLength"].(float64); ok && l > max {
+		errs = append(errs, fmt.Sprintf("%s must be at most %v characters", desc, max))
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		if r, err := regexp.Compile(pattern); err == nil && !r.MatchString(s) {
+			errs = append(errs, fmt.Sprintf("%s must match pattern %s", desc, pattern))
+		}
+	}
+	return errs
+}
+
+func containsValue(arr []interface{}, v interface{}) bool {
+	for _, item := range arr {
+		if fmt.Sprint(item) == fmt.Sprint(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateValue validates the json value against the schema
+func (doc *document) validateValue(desc string, schema map[string]interface{}, v interface{}, depth int) []string {
+	schema = doc.resolve(schema)
+	if schema == nil || depth > maxSchemaDepth || !doc.visit() {
+		return nil
+	}
+	if v == nil {
+		if schema["type"] == nil || asBool(schema["nullable"]) || asBool(schema["x-nullable"]) {
+			return nil
+		}
+		return []string{desc + " must not be null"}
+	}
+
+	var errs []string
+	for _, sub := range asArray(schema["allOf"]) {
+		errs = append(errs, doc.validateValue(desc, asMap(sub), v, depth+1)...)
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		subs := asArray(schema[key])
+		if len(subs) == 0 {
+			continue
+		}
+		matched := false
+		for _, sub := range subs {
+			if len(doc.validateValue(desc, asMap(sub), v, depth+1)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			errs = append(errs, fmt.Sprintf("%s does not match any schema of %s", desc, key))
+		}
+	}
+	if enum := asArray(schema["enum"]); len(enum) > 0 && !containsValue(enum, v) {
+		errs = append(errs, fmt.Sprintf("%s must be one of %v", desc, enum))
+	}
+
+	switch t := asString(schema["type"]); t {
+	case "object":
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return append(errs, desc+" must be object")
+		}
+		for _, name := range asArray(schema["required"]) {
+			if _, ok := m[asString(name)]; !ok {
+				errs = append(errs, fmt.Sprintf("%s.%s is required", desc, asString(name)))
+			}
+		}
+		properties := asMap(schema["properties"])
+		for _, name := range sortedKeys(m) {
+			if property, ok := properties[name]; ok {
+				errs = append(errs, doc.validateValue(desc+"."+name, asMap(property), m[name], depth+1)...)
+				continue
+			}
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					errs = append(errs, fmt.Sprintf("%s.%s is not allowed", desc, name))
+				}
+			case map[string]interface{}:
+				errs = append(errs, doc.validateValue(desc+"."+name, additional, m[name], depth+1)...)
+			}
+		}
+	case "array":
+		arr, ok := v.([]interface{})
+		if !ok {
+			return append(errs, desc+" must be array")
+		}
+		if min, ok := schema["minItems"].(float64); ok && float64(len(arr)) < min {
+			errs = append(errs, fmt.Sprintf("%s must have at least %v items", desc, min))
+		}
+		if max, ok := schema["maxItems"].(float64); ok && float64(len(arr)) > max {
+			errs = append(errs, fmt.Sprintf("%s must have at most %v items", desc, max))
+		}
+		items := asMap(schema["items"])
+		for i, item := range arr {
+			errs = append(errs, doc.validateValue(fmt.Sprintf("%s[%d]", desc, i), items, item, depth+1)...)
+		}
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return append(errs, desc+" must be string")
+		}
+		errs = append(errs, doc.validateString(desc, schema, s)...)
+	case "integer", "number":
+		f, ok := v.(float64)
+		if !ok || (t == "integer" && f != math.Trunc(f)) {
+			return append(errs, desc+" must be "+t)
+		}
+		errs = append(errs, doc.validateNumber(desc, schema, f)...)
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return append(errs, desc+" must be boolean")
+		}
+	}
+	return errs
+}
+
+// selectResponse returns the response of the status, or the lowest 2xx
+// response, or the default response
+func (doc *document) selectResponse(op *operation, status string) (int, map[string]interface{}, error) {
+	responses := asMap(op.node["responses"])
+	if len(status) > 0 {
+		code, err := strconv.Atoi(status)
+		if err != nil || code < 100 || code > 599 {
+			return 0, nil, ValidationError{fmt.Sprintf("invalid %s header %s", HEADER_MOCK_STATUS, status)}
+		}
+		response, ok := responses[status]
+		if !ok {
+			if response, ok = responses["default"]; !ok {
+				return 0, nil, ValidationError{fmt.Sprintf("response %s is not declared", status)}
+			}
+		}
+		return code, doc.resolve(asMap(response)), nil
+	}
+
+	codes := sortedKeys(responses)
+	for _, status := range codes {
+		if code, err := strconv.Atoi(status); err == nil && code >= 200 && code < 300 {
+			return code, doc.resolve(asMap(responses[status])), nil
+		}
+	}
+	if response, ok := responses["default"]; ok {
+		return http.StatusOK, doc.resolve(asMap(response)), nil
+	}
+	for _, status := range codes {
+		if code, err := strconv.Atoi(status); err == nil {
+			return code, doc.resolve(asMap(responses[status])), nil
+		}
+	}
+	return http.StatusOK, nil, nil
+}
+
+// responseBody returns the example of the response, or the value generated
+// from the response schema
+func (doc *document) responseBody(op *operation, response map[string]interface{}) (string, interface{}, bool) {
+	if response == nil {
+		return "", nil, false
+	}
+
+	var (
+		contentType string
+		schema      map[string]interface{}
+	)
+	if doc.openapi3 {
+		content := asMap(response["content"])
+		contentType = jsonMediaType(sortedKeys(content))
+		media := asMap(content[contentType])
+		if media == nil {
+			return "", nil, false
+		}
+		if example, ok := media["example"]; ok {
+			return contentType, example, true
+		}
+		examples := asMap(media["examples"])
+		for _, name := range sortedKeys(examples) {
+			if value, ok := doc.resolve(asMap(examples[name]))["value"]; ok {
+				return contentType, value, true
+			}
+		}
+		schema = asMap(media["schema"])
+	} else {
+		var produces []string
+		for _, list := range []interface{}{op.node["produces"], doc.root["produces"]} {
+			for _, v := range asArray(list) {
+				produces = append(produces, asString(v))
+			}
+			if len(produces) > 0 {
+				break
+			}
+		}
+		examples := asMap(response["examples"])
+		contentType = jsonMediaType(produces)
+		if example, ok := examples[contentType]; ok {
+			return contentType, example, true
+		}
+		if len(contentType) == 0 {
+			contentType = jsonMediaType(sortedKeys(examples))
+			if example, ok := examples[contentType]; ok {
+				return contentType, example, true
+			}
+		}
+		schema = asMap(response["schema"])
+	}
+	if schema == nil {
+		return "", nil, false
+	}
+	if len(contentType) == 0 || contentType == "*/*" {
+		contentType = "application/json"
+	}
+	return contentType, doc.generate(schema, make(map[string]bool), 0), true
+}
+
+// generate returns a value of the schema from its example, default, enum or
+// type, the refs are the $ref on the current path, the recursive $ref is
+// generated as null
+func (doc *document) generate(schema map[string]interface{}, refs map[string]bool, depth int) interface{} {
+	if ref, ok := schema["$ref"].(string); ok {
+		if refs[ref] {
+			return nil
+		}
+		refs[ref] = true
+		defer delete(refs, ref)
+	}
+	schema = doc.resolve(schema)
+	if schema == nil || depth > maxSchemaDepth || !doc.visit() {
+		return nil
+	}
+	for _, key := range []string{"example", "x-example", "default"} {
+		if v, ok := schema[key]; ok {
+			return v
+		}
+	}
+	if enum := asArray(schema["enum"]); len(enum) > 0 {
+		return enum[0]
+	}
+	if all := asArray(schema["allOf"]); len(all) > 0 {
+		merged := make(map[string]interface{})
+		for _, sub := range all {
+			for k, v := range asMap(doc.generate(asMap(sub), refs, depth+1)) {
+				merged[k] = v
+			}
+		}
+		return merged
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if subs := asArray(schema[key]); len(subs) > 0 {
+			return doc.generate(asMap(subs[0]), refs, depth+1)
+		}
+	}
+
+	t := asString(schema["type"])
+	if len(t) == 0 && schema["properties"] != nil {
+		t = "object"
+	}
+	switch t {
+	case "object":
+		properties := asMap(schema["properties"])
+		m := make(map[string]interface{}, len(properties))
+		for name, property := range properties {
+			m[name] = doc.generate(asMap(property), refs, depth+1)
+		}
+		return m
+	case "array":
+		items := asMap(schema["items"])
+		if items == nil {
+			return []interface{}{}
+		}
+		return []interface{}{doc.generate(items, refs, depth+1)}
+	case "integer", "number":
+		if min, ok := schema["minimum"].(float64); ok {
+			return min
+		}
+		return 0
+	case "boolean":
+		return false
+	case "string":
+		switch asString(schema["format"]) {
+		case "date-time":
+			return "1970-01-01T00:00:00Z"
+		case "date":
+			return "1970-01-01"
+		case "uuid":
+			return "00000000-0000-0000-0000-000000000000"
+		case "email":
+			return "user@example.com"
+		case "byte", "binary":
+			return ""
+		}
+		return "string"
+	}
+	return nil
+}
+
+// Mock validates the request against the operation declared in the
+// OpenAPI/Swagger schema, and returns the response generated from the
+// examples or the types of the response schema
+func Mock(content string, req *Request) (*Response, error) {
+	doc, err := parseDocument(content)
+	if err != nil {
+		return nil, err
+	}
+	op, err := doc.findOperation(req.Method, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if errs := doc.validateRequest(op, req); len(errs) > 0 {
+		return nil, errs
+	}
+
+	code, response, err := doc.selectResponse(op, req.Header.Get(HEADER_MOCK_STATUS))
+	if err != nil {
+		return nil, err
+	}
+	resp := &Response{StatusCode: code}
+	contentType, value, ok := doc.responseBody(op, response)
+	if !ok {
+		return resp, nil
+	}
+	resp.ContentType = contentType
+	if s, isString := value.(string); isString && !strings.Contains(contentType, "json") {
+		resp.Body = util.StringToBytesWithNoCopy(s)
+		return resp, nil
+	}
+	resp.Body, err = json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
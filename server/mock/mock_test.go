@@ -0,0 +1,225 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const swagger2 = `swagger: "2.0"
+info:
+  title: order
+  version: 1.0.0
+basePath: /orders
+produces:
+  - application/json
+paths:
+  /{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        type: integer
+    get:
+      parameters:
+        - name: fields
+          in: query
+          type: array
+          items:
+            type: string
+            enum: [id, items]
+      responses:
+        200:
+          description: ok
+          schema:
+            $ref: '#/definitions/Order'
+        404:
+          description: not found
+          examples:
+            application/json:
+              message: not found
+  /:
+    post:
+      parameters:
+        - name: order
+          in: body
+          required: true
+          schema:
+            $ref: '#/definitions/Order'
+      responses:
+        201:
+          description: created
+          examples:
+            application/json:
+              id: 1
+definitions:
+  Order:
+    type: object
+    required: [items]
+    properties:
+      id:
+        type: integer
+        minimum: 1
+      createdAt:
+        type: string
+        format: date-time
+      items:
+        type: array
+        items:
+          $ref: '#/definitions/Item'
+  Item:
+    type: object
+    properties:
+      name:
+        type: string
+        example: apple
+      count:
+        type: integer
+`
+
+const openapi3 = `{"openapi":"3.0.0","info":{"title":"user","version":"1.0.0"},
+"servers":[{"url":"http://localhost:8080/api"}],
+"paths":{
+  "/users/me":{"get":{"responses":{"200":{"description":"ok","content":{"text/plain":{"example":"me"}}}}}},
+  "/users/{userId}":{"put":{
+    "parameters":[{"name":"userId","in":"path","required":true,"schema":{"type":"string","pattern":"^u[0-9]+$"}}],
+    "requestBody":{"required":true,"content":{"application/json":{"schema":{"$ref":"#/components/schemas/User"}}}},
+    "responses":{"200":{"description":"ok","content":{"application/json":{
+      "examples":{"a":{"value":{"name":"alice"}}},"schema":{"$ref":"#/components/schemas/User"}}}}}}}},
+"components":{"schemas":{"User":{"type":"object","additionalProperties":false,
+  "properties":{"name":{"type":"string","minLength":1},"age":{"type":"integer","nullable":true}}}}}}`
+
+func newRequest(method, path, query, body string) *Request {
+	q, _ := url.ParseQuery(query)
+	return &Request{
+		Method: method,
+		Path:   path,
+		Query:  q,
+		Header: http.Header{},
+		Body:   []byte(body),
+	}
+}
+
+func TestMockSwagger2(t *testing.T) {
+	resp, err := Mock(swagger2, newRequest(http.MethodGet, "/orders/1", "fields=id,items", ""))
+	if err != nil || resp.StatusCode != http.StatusOK || resp.ContentType != "application/json" {
+		t.Fatalf("Mock GET failed, %v %v", resp, err)
+	}
+	var order map[string]interface{}
+	if err := json.Unmarshal(resp.Body, &order); err != nil {
+		t.Fatalf("Mock GET failed, %s", resp.Body)
+	}
+	items, _ := order["items"].([]interface{})
+	if order["id"] != float64(1) || order["createdAt"] != "1970-01-01T00:00:00Z" || len(items) != 1 ||
+		items[0].(map[string]interface{})["name"] != "apple" {
+		t.Fatalf("Mock GET generated unexpected body, %s", resp.Body)
+	}
+
+	req := newRequest(http.MethodGet, "/1", "", "")
+	req.Header.Set(HEADER_MOCK_STATUS, "404")
+	resp, err = Mock(swagger2, req)
+	if err != nil || resp.StatusCode != http.StatusNotFound || string(resp.Body) != `{"message":"not found"}` {
+		t.Fatalf("Mock GET with status failed, %v %v", resp, err)
+	}
+
+	_, err = Mock(swagger2, newRequest(http.MethodGet, "/orders/x", "fields=name", ""))
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 2 {
+		t.Fatalf("Mock GET invalid request failed, %v", err)
+	}
+
+	resp, err = Mock(swagger2, newRequest(http.MethodPost, "/orders/", "", `{"items":[{"name":"a","count":1}]}`))
+	if err != nil || resp.StatusCode != http.StatusCreated || string(resp.Body) != `{"id":1}` {
+		t.Fatalf("Mock POST failed, %v %v", resp, err)
+	}
+
+	_, err = Mock(swagger2, newRequest(http.MethodPost, "/orders/", "", `{"id":0,"items":[{"count":"1"}]}`))
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 2 {
+		t.Fatalf("Mock POST invalid body failed, %v", err)
+	}
+
+	_, err = Mock(swagger2, newRequest(http.MethodPost, "/orders/", "", ""))
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 1 {
+		t.Fatalf("Mock POST without body failed, %v", err)
+	}
+
+	_, err = Mock(swagger2, newRequest(http.MethodDelete, "/orders/1", "", ""))
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("Mock undeclared operation failed, %v", err)
+	}
+}
+
+func TestMockOpenAPI3(t *testing.T) {
+	resp, err := Mock(openapi3, newRequest(http.MethodGet, "/api/users/me", "", ""))
+	if err != nil || resp.ContentType != "text/plain" || string(resp.Body) != "me" {
+		t.Fatalf("Mock literal path failed, %v %v", resp, err)
+	}
+
+	resp, err = Mock(openapi3, newRequest(http.MethodPut, "/api/users/u1", "", `{"name":"bob","age":null}`))
+	if err != nil || resp.StatusCode != http.StatusOK || string(resp.Body) != `{"name":"alice"}` {
+		t.Fatalf("Mock PUT failed, %v %v", resp, err)
+	}
+
+	_, err = Mock(openapi3, newRequest(http.MethodPut, "/api/users/x", "", `{"name":"","other":1}`))
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 3 {
+		t.Fatalf("Mock PUT invalid request failed, %v", err)
+	}
+
+	if _, err := Mock("syntax = \"proto3\";", newRequest(http.MethodGet, "/", "", "")); err != ErrNotOpenAPI {
+		t.Fatalf("Mock not OpenAPI schema failed, %v", err)
+	}
+}
+
+const recursive = `{"swagger":"2.0","paths":{
+  "/tree":{"get":{"responses":{"200":{"description":"ok","schema":{"$ref":"#/definitions/Node"}}}}},
+  "/wide":{"patch":{
+    "parameters":[{"name":"body","in":"body","schema":{"$ref":"#/definitions/Wide0"}}],
+    "responses":{"200":{"description":"ok","schema":{"$ref":"#/definitions/Wide0"}}}}}},
+"definitions":{
+  "Node":{"type":"object","properties":{"name":{"type":"string"},
+    "left":{"$ref":"#/definitions/Node"},"right":{"$ref":"#/definitions/Node"}}},
+  %s}}`
+
+func TestMockRecursiveSchema(t *testing.T) {
+	// each WideN refers to WideN+1 by two properties, the schema expands to
+	// 2^32 nodes without the limit
+	wides := make([]string, 0, maxSchemaDepth)
+	for i := 0; i < maxSchemaDepth; i++ {
+		wides = append(wides, fmt.Sprintf(
+			`"Wide%d":{"type":"object","properties":{"a":{"$ref":"#/definitions/Wide%d"},"b":{"$ref":"#/definitions/Wide%d"}}}`,
+			i, i+1, i+1))
+	}
+	wides = append(wides, fmt.Sprintf(`"Wide%d":{"type":"string"}`, maxSchemaDepth))
+	content := fmt.Sprintf(recursive, strings.Join(wides, ","))
+
+	resp, err := Mock(content, newRequest(http.MethodGet, "/tree", "", ""))
+	if err != nil || string(resp.Body) != `{"left":null,"name":"string","right":null}` {
+		t.Fatalf("Mock recursive schema failed, %v %v", resp, err)
+	}
+
+	resp, err = Mock(content, newRequest(http.MethodPatch, "/wide", "", `{"a":{"a":{}},"b":{}}`))
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Mock wide schema failed, %v %v", resp, err)
+	}
+	if len(resp.Body) > maxSchemaNodes*16 {
+		t.Fatalf("Mock wide schema generated too many nodes, %d bytes", len(resp.Body))
+	}
+}
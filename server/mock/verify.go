@@ -0,0 +1,90 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Expectation is the response expected by the client of the operation,
+// e.g. the response of a pact interaction
+type Expectation struct {
+	// StatusCode is not checked if it is zero
+	StatusCode int
+	// Body is not checked if it is empty
+	Body []byte
+}
+
+// responseSchema returns the json schema of the response body
+func (doc *document) responseSchema(response map[string]interface{}) map[string]interface{} {
+	if !doc.openapi3 {
+		return asMap(response["schema"])
+	}
+	content := asMap(response["content"])
+	return asMap(asMap(content[jsonMediaType(sortedKeys(content))])["schema"])
+}
+
+func (doc *document) validateExpectation(op *operation, expect *Expectation) ValidationError {
+	if expect == nil || expect.StatusCode == 0 {
+		return nil
+	}
+	status := strconv.Itoa(expect.StatusCode)
+	responses := asMap(op.node["responses"])
+	response, ok := responses[status]
+	if !ok {
+		if response, ok = responses["default"]; !ok {
+			return ValidationError{fmt.Sprintf("response %s is not declared", status)}
+		}
+	}
+	if len(bytes.TrimSpace(expect.Body)) == 0 {
+		return nil
+	}
+	schema := doc.responseSchema(doc.resolve(asMap(response)))
+	if schema == nil {
+		return ValidationError{fmt.Sprintf("response %s does not declare a body", status)}
+	}
+	var v interface{}
+	if err := json.Unmarshal(expect.Body, &v); err != nil {
+		// not a json body, e.g. plain text
+		return nil
+	}
+	return doc.validateValue("response body", schema, v, 0)
+}
+
+// Verify checks the request and the expected response against the operation
+// declared in the OpenAPI/Swagger schema without calling the provider. It
+// returns NotFoundError if the operation is not declared, or ValidationError
+// with all the violations
+func Verify(content string, req *Request, expect *Expectation) error {
+	doc, err := parseDocument(content)
+	if err != nil {
+		return err
+	}
+	op, err := doc.findOperation(req.Method, req.Path)
+	if err != nil {
+		return err
+	}
+	errs := doc.validateRequest(op, req)
+	errs = append(errs, doc.validateExpectation(op, expect)...)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
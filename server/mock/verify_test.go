@@ -0,0 +1,53 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package mock
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	err := Verify(swagger2, newRequest(http.MethodGet, "/orders/1", "fields=id", ""),
+		&Expectation{StatusCode: http.StatusOK, Body: []byte(`{"id":1,"items":[{"name":"apple","count":1}]}`)})
+	if err != nil {
+		t.Fatalf("Verify swagger 2.0 failed, %v", err)
+	}
+
+	err = Verify(swagger2, newRequest(http.MethodGet, "/orders/1", "", ""),
+		&Expectation{StatusCode: http.StatusOK, Body: []byte(`{"id":"1"}`)})
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 2 {
+		t.Fatalf("Verify response body failed, %v", err)
+	}
+
+	err = Verify(swagger2, newRequest(http.MethodGet, "/orders/1", "", ""),
+		&Expectation{StatusCode: http.StatusInternalServerError})
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 1 {
+		t.Fatalf("Verify undeclared response failed, %v", err)
+	}
+
+	err = Verify(openapi3, newRequest(http.MethodPut, "/api/users/u1", "", `{"name":"bob"}`),
+		&Expectation{StatusCode: http.StatusOK, Body: []byte(`{"name":"bob","other":1}`)})
+	if errs, ok := err.(ValidationError); !ok || len(errs) != 1 {
+		t.Fatalf("Verify OpenAPI 3.0 failed, %v", err)
+	}
+
+	err = Verify(openapi3, newRequest(http.MethodDelete, "/api/users/u1", "", ""), nil)
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("Verify undeclared operation failed, %v", err)
+	}
+}
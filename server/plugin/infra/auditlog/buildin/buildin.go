@@ -0,0 +1,132 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package buildin
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	mgr "github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"github.com/astaxie/beego"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func init() {
+	mgr.RegisterPlugin(mgr.Plugin{mgr.AUDIT_LOG, "buildin", New})
+}
+
+func New() mgr.PluginInstance {
+	file := os.ExpandEnv(beego.AppConfig.String("auditlog_file"))
+	if len(file) == 0 {
+		util.Logger().Infof("auditlog_file is not set, the audit log is disabled")
+		return &BuildinAuditLog{}
+	}
+	// the relative path is under the directory of the log files
+	if !filepath.IsAbs(file) && len(core.ServerInfo.Config.LogFilePath) != 0 {
+		file = filepath.Join(filepath.Dir(os.ExpandEnv(core.ServerInfo.Config.LogFilePath)), file)
+	}
+	util.Logger().Infof("the audit log is written to %s", file)
+	return &BuildinAuditLog{
+		file: newFile(file,
+			beego.AppConfig.DefaultInt("auditlog_rotate_size", 20),
+			beego.AppConfig.DefaultInt("auditlog_backup_count", 50)),
+	}
+}
+
+// BuildinAuditLog writes the entries to the rotated file as JSON lines
+type BuildinAuditLog struct {
+	file *file
+}
+
+func (al *BuildinAuditLog) Record(r *http.Request, responseHeaders http.Header) {
+	df, ok := mgr.DynamicPluginFunc(mgr.AUDIT_LOG, "Record").(func(*http.Request, http.Header))
+	if ok {
+		df(r, responseHeaders)
+		return
+	}
+
+	ctx := r.Context()
+	entry := &auditlog.Entry{
+		Timestamp:  time.Now().Unix(),
+		Protocol:   auditlog.PROTOCOL_REST,
+		Operator:   util.ParseOperator(ctx),
+		RemoteIP:   util.GetRealIP(r),
+		Method:     r.Method,
+		API:        r.RequestURI,
+		Domain:     util.ParseDomain(ctx),
+		Project:    util.ParseProject(ctx),
+		ServiceId:  r.URL.Query().Get(":serviceId"),
+		InstanceId: r.URL.Query().Get(":instanceId"),
+		StatusCode: responseHeaders.Get(rest.HEADER_RESPONSE_STATUS),
+	}
+	if pattern, ok := ctx.Value(rest.CTX_MATCH_PATTERN).(string); ok {
+		entry.API = pattern
+	}
+	if len(entry.Operator) == 0 {
+		if serviceId, _ := ctx.Value(identity.CTX_SERVICE).(string); len(serviceId) > 0 {
+			entry.Operator = "service:" + serviceId
+		}
+	}
+	if len(entry.StatusCode) == 0 {
+		entry.StatusCode = "200"
+	}
+	if changes := auditlog.ChangesOf(ctx); changes != nil {
+		entry.Changes = changes.List()
+	}
+	al.RecordEntry(entry)
+}
+
+func (al *BuildinAuditLog) RecordEntry(entry *auditlog.Entry) {
+	df, ok := mgr.DynamicPluginFunc(mgr.AUDIT_LOG, "RecordEntry").(func(*auditlog.Entry))
+	if ok {
+		df(entry)
+		return
+	}
+
+	if al.file == nil {
+		return
+	}
+	if len(entry.ServiceId) == 0 {
+		// the service is created
+		for _, change := range entry.Changes {
+			if change.Entity == auditlog.ENTITY_SERVICE {
+				entry.ServiceId = change.ServiceId
+				break
+			}
+		}
+	}
+	if err := al.file.Write(entry); err != nil {
+		util.Logger().Errorf(err, "write audit log failed, %s %s", entry.Method, entry.API)
+	}
+}
+
+func (al *BuildinAuditLog) Query(option *auditlog.QueryOption) ([]*auditlog.Entry, error) {
+	df, ok := mgr.DynamicPluginFunc(mgr.AUDIT_LOG, "Query").(func(*auditlog.QueryOption) ([]*auditlog.Entry, error))
+	if ok {
+		return df(option)
+	}
+
+	if al.file == nil {
+		return nil, nil
+	}
+	return al.file.Read(option)
+}
@@ -0,0 +1,202 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package buildin
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	"golang.org/x/net/context"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	rotatePeriod = 30 * time.Second
+	// backupTimeSlack covers the entries written a while after they are created
+	backupTimeSlack  = time.Minute
+	backupTimeLayout = "20060102150405"
+)
+
+// file appends the entries to the file rotated by the log rotation,
+// the rotated files are compressed to file.<timestamp>.zip
+type file struct {
+	path string
+	lock sync.Mutex
+}
+
+func (f *file) Write(entry *auditlog.Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	// reopen the file for each entry, as the rotation truncates it
+	fd, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	_, err = fd.Write(data)
+	return err
+}
+
+// Read scans the backups and the file in time order, the backups out of
+// the time range of the option are skipped by their rotation time
+func (f *file) Read(option *auditlog.QueryOption) ([]*auditlog.Entry, error) {
+	backups, err := util.FilterFileList(filepath.Dir(f.path),
+		fmt.Sprintf(`^%s\.[0-9]{17}\.zip$`, regexp.QuoteMeta(filepath.Base(f.path))), 0444)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(backups)
+	backups = backupsInRange(backups, option)
+
+	var entries []*auditlog.Entry
+	collect := func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			entry := &auditlog.Entry{}
+			if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+				// skip the broken line, e.g. the process exits while writing
+				continue
+			}
+			if !option.Match(entry) {
+				continue
+			}
+			entries = append(entries, entry)
+			if option.Limit > 0 && len(entries) > option.Limit {
+				entries = entries[1:]
+			}
+		}
+		return scanner.Err()
+	}
+
+	for _, backup := range backups {
+		if err := readZip(backup, collect); err != nil {
+			return nil, err
+		}
+	}
+
+	// the entries are appended in whole lines, so the file is read without
+	// blocking the writes, a line being written is skipped as a broken one
+	fd, err := os.Open(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	defer fd.Close()
+	if err := collect(fd); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// backupsInRange returns the sorted backups which may contain the entries
+// between the start and the end of the option, a backup contains the
+// entries written after the previous rotation and before its own one
+func backupsInRange(backups []string, option *auditlog.QueryOption) []string {
+	if option.Start <= 0 && option.End <= 0 {
+		return backups
+	}
+	var (
+		result []string
+		prev   time.Time
+	)
+	for _, backup := range backups {
+		rotated, err := backupTime(backup)
+		if err != nil {
+			// can not tell, read it anyway
+			result = append(result, backup)
+			continue
+		}
+		if option.End > 0 && !prev.IsZero() && prev.Add(-backupTimeSlack).Unix() > option.End {
+			break
+		}
+		prev = rotated
+		if option.Start > 0 && rotated.Add(backupTimeSlack).Unix() < option.Start {
+			continue
+		}
+		result = append(result, backup)
+	}
+	return result
+}
+
+// backupTime returns the rotation time of the backup file.<timestamp>.zip
+func backupTime(path string) (time.Time, error) {
+	name := strings.TrimSuffix(filepath.Base(path), ".zip")
+	i := strings.LastIndex(name, ".")
+	if i < 0 || len(name)-i-1 < len(backupTimeLayout) {
+		return time.Time{}, fmt.Errorf("invalid backup name %s", path)
+	}
+	return time.ParseInLocation(backupTimeLayout, name[i+1:i+1+len(backupTimeLayout)], time.Local)
+}
+
+func readZip(path string, collect func(io.Reader) error) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, zf := range zr.File {
+		r, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		err = collect(r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newFile(path string, rotateSize, backupCount int) *file {
+	f := &file{path: path}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		util.Logger().Errorf(err, "create the directory of audit log %s failed", path)
+	}
+	util.Go(func(ctx context.Context) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(rotatePeriod):
+				f.lock.Lock()
+				util.LogRotateFile(path, rotateSize, backupCount)
+				f.lock.Unlock()
+			}
+		}
+	})
+	return f
+}
@@ -0,0 +1,102 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package buildin
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := &file{path: filepath.Join(dir, "audit.json")}
+
+	entries, err := f.Read(&auditlog.QueryOption{})
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Read the file not exist failed, %v", err)
+	}
+
+	// the backup rotated before
+	zf, err := os.Create(filepath.Join(dir, "audit.json.20180101000000000.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(zf)
+	w, err := zw.Create("audit.json.20180101000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(&auditlog.Entry{Timestamp: 1, ServiceId: "1"})
+	w.Write(append(data, '\n'))
+	zw.Close()
+	zf.Close()
+
+	for i := int64(2); i <= 4; i++ {
+		if err := f.Write(&auditlog.Entry{Timestamp: i, ServiceId: "2"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err = f.Read(&auditlog.QueryOption{})
+	if err != nil || len(entries) != 4 || entries[0].Timestamp != 1 || entries[3].Timestamp != 4 {
+		t.Fatalf("Read all failed, %v", err)
+	}
+
+	entries, err = f.Read(&auditlog.QueryOption{Limit: 2})
+	if err != nil || len(entries) != 2 || entries[0].Timestamp != 3 {
+		t.Fatalf("Read the latest failed, %v", err)
+	}
+
+	entries, err = f.Read(&auditlog.QueryOption{ServiceId: "1"})
+	if err != nil || len(entries) != 1 || entries[0].Timestamp != 1 {
+		t.Fatalf("Read the backup failed, %v", err)
+	}
+
+	entries, err = f.Read(&auditlog.QueryOption{Start: 3, End: 3})
+	if err != nil || len(entries) != 1 || entries[0].Timestamp != 3 {
+		t.Fatalf("Read by time failed, %v", err)
+	}
+
+	// the backups out of the time range are never opened
+	if err := ioutil.WriteFile(filepath.Join(dir, "audit.json.20300101000000000.zip"),
+		[]byte("broken"), 0440); err != nil {
+		t.Fatal(err)
+	}
+	entries, err = f.Read(&auditlog.QueryOption{End: 1})
+	if err != nil || len(entries) != 1 || entries[0].Timestamp != 1 {
+		t.Fatalf("Read by end failed, %v", err)
+	}
+	start := time.Date(2031, 1, 1, 0, 0, 0, 0, time.Local).Unix()
+	entries, err = f.Read(&auditlog.QueryOption{Start: start})
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Read by start failed, %v", err)
+	}
+	if _, err := f.Read(&auditlog.QueryOption{}); err == nil {
+		t.Fatalf("Read the broken backup should fail")
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	mgr "github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"github.com/apache/incubator-servicecomb-service-center/server/rbac"
+	"golang.org/x/net/context"
+	"net/http"
+)
+
+func init() {
+	mgr.RegisterPlugin(mgr.Plugin{mgr.AUTH, rbac.PLUGIN_NAME, New})
+}
+
+func New() mgr.PluginInstance {
+	rbac.InitRootAccount(core.AddDefaultContextValue(context.Background()))
+	return &RBACAuth{}
+}
+
+// RBACAuth authenticates the requests by the tokens issued to the accounts,
+// and authorizes them by the roles of the accounts
+type RBACAuth struct {
+}
+
+func (ra *RBACAuth) Identify(r *http.Request) error {
+	return rbac.Identify(r)
+}
@@ -26,8 +26,8 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	mgr "github.com/apache/incubator-servicecomb-service-center/server/plugin"
 	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"github.com/astaxie/beego"
 	"golang.org/x/net/context"
-	"strings"
 )
 
 const (
@@ -39,9 +39,14 @@ const (
 )
 
 func init() {
-	quota.DefaultSchemaQuota = SCHEMA_NUM_MAX_LIMIT_PER_SERVICE
-	quota.DefaultTagQuota = TAG_NUM_MAX_LIMIT_PER_SERVICE
-	quota.DefaultRuleQuota = RULE_NUM_MAX_LIMIT_PER_SERVICE
+	// the defaults apply to each domain without quota record
+	quota.DefaultLimits = &quota.Limits{
+		Service:  beego.AppConfig.DefaultInt64("quota_default_service", SERVICE_NUM_MAX_LIMIT),
+		Instance: beego.AppConfig.DefaultInt64("quota_default_instance", INSTANCE_NUM_MAX_LIMIT),
+		Rule:     beego.AppConfig.DefaultInt64("quota_default_rule", RULE_NUM_MAX_LIMIT_PER_SERVICE),
+		Schema:   beego.AppConfig.DefaultInt64("quota_default_schema", SCHEMA_NUM_MAX_LIMIT_PER_SERVICE),
+		Tag:      beego.AppConfig.DefaultInt64("quota_default_tag", TAG_NUM_MAX_LIMIT_PER_SERVICE),
+	}
 
 	mgr.RegisterPlugin(mgr.Plugin{mgr.QUOTA, "buildin", New})
 }
@@ -60,17 +65,16 @@ func (q *BuildInQuota) Apply4Quotas(ctx context.Context, res *quota.ApplyQuotaRe
 		return df(ctx, res)
 	}
 
-	data := &QuotaApplyData{
-		domain:    strings.Split(res.DomainProject, "/")[0],
-		quotaSize: res.QuotaSize,
+	domainLimits, projectLimits, err := serviceUtil.GetQuotaLimits(ctx, res.DomainProject)
+	if err != nil {
+		util.Logger().Errorf(err, "get the quota limits of %s failed", res.DomainProject)
+		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrUnavailableBackend, err.Error()))
 	}
 	switch res.QuotaType {
-	case quota.MicroServiceInstanceQuotaType:
-		return instanceQuotaCheck(ctx, data)
-	case quota.MicroServiceQuotaType:
-		return serviceQuotaCheck(ctx, data)
+	case quota.MicroServiceInstanceQuotaType, quota.MicroServiceQuotaType:
+		return totalQuotaCheck(ctx, res, domainLimits, projectLimits)
 	default:
-		return ResourceLimitHandler(ctx, res)
+		return ResourceLimitHandler(ctx, res, projectLimits.Get(res.QuotaType))
 	}
 }
 
@@ -81,122 +85,105 @@ func (q *BuildInQuota) RemandQuotas(ctx context.Context, quotaType quota.Resourc
 		df(ctx, quotaType)
 		return
 	}
+	// the used quota is counted from the registry on applying, so the quota
+	// of the deleted resources is released as soon as they are removed
+	util.Logger().Debugf("remand %s quota of %s", quotaType, util.ParseDomainProject(ctx))
 }
 
-func ResourceLimitHandler(ctx context.Context, res *quota.ApplyQuotaResource) *quota.ApplyQuotaResult {
-	var key string
-	var max int64 = 0
-	var indexer *backend.Indexer
+func (q *BuildInQuota) GetUsage(ctx context.Context, domainProject string, serviceId string) ([]*quota.Usage, error) {
+	df, ok := mgr.DynamicPluginFunc(mgr.QUOTA, "GetUsage").(func(context.Context, string, string) ([]*quota.Usage, error))
+	if ok {
+		return df(ctx, domainProject, serviceId)
+	}
 
-	domainProject := res.DomainProject
-	serviceId := res.ServiceId
-	switch res.QuotaType {
-	case quota.RuleQuotaType:
-		key = core.GenerateServiceRuleKey(domainProject, serviceId, "")
-		max = RULE_NUM_MAX_LIMIT_PER_SERVICE
-		indexer = backend.Store().Rule()
-	case quota.SchemaQuotaType:
-		key = core.GenerateServiceSchemaKey(domainProject, serviceId, "")
-		max = SCHEMA_NUM_MAX_LIMIT_PER_SERVICE
-		indexer = backend.Store().Schema()
-	case quota.TagQuotaType:
-		applyNum := res.QuotaSize
-		max = TAG_NUM_MAX_LIMIT_PER_SERVICE
-		tags, err := serviceUtil.GetTagsUtils(ctx, domainProject, serviceId)
+	domainLimits, projectLimits, err := serviceUtil.GetQuotaLimits(ctx, domainProject)
+	if err != nil {
+		return nil, err
+	}
+	domain, project := serviceUtil.SplitDomainProject(domainProject)
+	usages := make([]*quota.Usage, 0, len(quota.ResourceTypes)+2)
+	for _, t := range []quota.ResourceType{quota.MicroServiceQuotaType, quota.MicroServiceInstanceQuotaType} {
+		used, err := serviceUtil.GetQuotaUsed(ctx, t, domain, "")
 		if err != nil {
-			return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrInternal, err.Error()))
+			return nil, err
 		}
-		curNum := int64(len(tags))
-		if curNum+applyNum > max {
-			mes := fmt.Sprintf("no quota to apply %s , max quota is %d, current used quota is %d, apply quota num is %d",
-				res.QuotaType, max, curNum, applyNum)
-			util.Logger().Errorf(nil, "%s, serviceId is %s", mes, serviceId)
-			return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrNotEnoughQuota, mes))
+		usages = append(usages, &quota.Usage{
+			Type: t.String(), Scope: quota.SCOPE_DOMAIN, Used: used, Limit: domainLimits.Get(t)})
+
+		used, err = serviceUtil.GetQuotaUsed(ctx, t, domain, project)
+		if err != nil {
+			return nil, err
 		}
-		return quota.NewApplyQuotaResult(nil, nil)
-	default:
+		usages = append(usages, &quota.Usage{
+			Type: t.String(), Scope: quota.SCOPE_PROJECT, Used: used, Limit: projectLimits.Get(t)})
+	}
+	if len(serviceId) == 0 {
+		return usages, nil
+	}
+	for _, t := range []quota.ResourceType{quota.RuleQuotaType, quota.SchemaQuotaType, quota.TagQuotaType} {
+		used, err := getServiceResourceNum(ctx, t, domainProject, serviceId)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, &quota.Usage{
+			Type: t.String(), Scope: quota.SCOPE_SERVICE, Used: used, Limit: projectLimits.Get(t)})
+	}
+	return usages, nil
+}
+
+// ResourceLimitHandler checks the per-service resources, max is zero if unlimited
+func ResourceLimitHandler(ctx context.Context, res *quota.ApplyQuotaResource, max int64) *quota.ApplyQuotaResult {
+	if res.QuotaType != quota.RuleQuotaType && res.QuotaType != quota.SchemaQuotaType &&
+		res.QuotaType != quota.TagQuotaType {
 		mes := fmt.Sprintf("not define quota type %s", res.QuotaType)
 		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrInternal, mes))
 	}
+	if max <= 0 {
+		return quota.NewApplyQuotaResult(nil, nil)
+	}
 
-	resp, err := indexer.Search(ctx,
-		registry.WithStrKey(key),
-		registry.WithPrefix(),
-		registry.WithCountOnly())
+	serviceId := res.ServiceId
+	curNum, err := getServiceResourceNum(ctx, res.QuotaType, res.DomainProject, serviceId)
 	if err != nil {
 		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrInternal, err.Error()))
 	}
-	num := resp.Count + int64(res.QuotaSize)
+	num := curNum + res.QuotaSize
 	util.Logger().Debugf("resource num is %d", num)
 	if num > max {
 		mes := fmt.Sprintf("no quota to apply %s, max quota is %d, current used quota is %d, apply quota num is %d",
-			res.QuotaType, max, resp.Count, res.QuotaSize)
-		util.Logger().Errorf(nil, "%s,  serviceId %s", mes, serviceId)
+			res.QuotaType, max, curNum, res.QuotaSize)
+		util.Logger().Errorf(nil, "%s, serviceId %s", mes, serviceId)
 		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrNotEnoughQuota, mes))
 	}
 	return quota.NewApplyQuotaResult(nil, nil)
 }
 
-type QuotaApplyData struct {
-	domain        string
-	project       string
-	domainProject string
-	quotaSize     int64
-}
-
-type GetCurUsedNum func(context.Context, *QuotaApplyData) (int64, error)
-type GetLimitQuota func() int64
-
-type QuotaCheckResult struct {
-	IsOk   bool
-	CurNum int64
-	Err    error
-}
-
-func NewQuotaCheckResult(isOk bool, curNum int64, err error) QuotaCheckResult {
-	return QuotaCheckResult{
-		isOk,
-		curNum,
-		err,
-	}
-}
-
-func quotaCheck(ctx context.Context, data *QuotaApplyData, getLimitQuota GetLimitQuota, getCurUsedNum GetCurUsedNum) QuotaCheckResult {
-	limitQuota := getLimitQuota()
-	curNum, err := getCurUsedNum(ctx, data)
-	if err != nil {
-		return NewQuotaCheckResult(false, 0, err)
-	}
-	if curNum+data.quotaSize > limitQuota {
-		return NewQuotaCheckResult(false, curNum, nil)
-	}
-	return NewQuotaCheckResult(true, curNum, nil)
-}
-
-func instanceQuotaCheck(ctx context.Context, data *QuotaApplyData) *quota.ApplyQuotaResult {
-	rst := quotaCheck(ctx, data, getInstanceMaxLimit, getAllInstancesNum)
-	err := rst.Err
-	if rst.Err != nil {
-		util.Logger().Errorf(rst.Err, "instance quota check failed")
-		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrInternal, err.Error()))
-	}
-	if !rst.IsOk {
-		mes := fmt.Sprintf("no quota to create instance, max num is %d, curNum is %d, apply num is %d",
-			getInstanceMaxLimit(), rst.CurNum, data.quotaSize)
-		util.Logger().Errorf(nil, mes)
-		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrNotEnoughQuota, mes))
+func getServiceResourceNum(ctx context.Context, quotaType quota.ResourceType, domainProject, serviceId string) (int64, error) {
+	var (
+		key     string
+		indexer *backend.Indexer
+	)
+	switch quotaType {
+	case quota.RuleQuotaType:
+		key = core.GenerateServiceRuleKey(domainProject, serviceId, "")
+		indexer = backend.Store().Rule()
+	case quota.SchemaQuotaType:
+		key = core.GenerateServiceSchemaKey(domainProject, serviceId, "")
+		indexer = backend.Store().Schema()
+	case quota.TagQuotaType:
+		tags, err := serviceUtil.GetTagsUtils(ctx, domainProject, serviceId)
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(tags)), nil
+	default:
+		return 0, nil
 	}
-	return quota.NewApplyQuotaResult(nil, nil)
-}
-
-func getInstanceMaxLimit() int64 {
-	return INSTANCE_NUM_MAX_LIMIT
-}
 
-func getInstancesNum(ctx context.Context, key string) (int64, error) {
-	resp, err := backend.Store().Instance().Search(ctx,
+	resp, err := indexer.Search(ctx,
 		registry.WithStrKey(key),
 		registry.WithPrefix(),
+		registry.WithNoCache(),
 		registry.WithCountOnly())
 	if err != nil {
 		return 0, err
@@ -204,43 +191,42 @@ func getInstancesNum(ctx context.Context, key string) (int64, error) {
 	return resp.Count, nil
 }
 
-func getAllInstancesNum(ctx context.Context, data *QuotaApplyData) (int64, error) {
-	key := core.GetInstanceRootKey("")
-	return getInstancesNum(ctx, key)
-}
-
-func serviceQuotaCheck(ctx context.Context, data *QuotaApplyData) *quota.ApplyQuotaResult {
-	rst := quotaCheck(ctx, data, getServiceMaxLimit, getAllServicesNum)
-	err := rst.Err
-	if err != nil {
-		util.Logger().Errorf(err, "service quota check failed")
-		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrInternal, err.Error()))
-	}
-	if !rst.IsOk {
-		mes := fmt.Sprintf("no quota to create service, max quota is %d, current used quota is %d, apply quota num is %d",
-			getServiceMaxLimit(), rst.CurNum, data.quotaSize)
-		util.Logger().Errorf(err, mes)
-		return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrNotEnoughQuota, mes))
-	}
-	return quota.NewApplyQuotaResult(nil, nil)
+type scopeLimit struct {
+	scope   string
+	name    string
+	project string
+	limit   int64
 }
 
-func getServiceMaxLimit() int64 {
-	return SERVICE_NUM_MAX_LIMIT
-}
+// totalQuotaCheck checks the total services or instances of the domain and
+// the project, the limit zero means unlimited
+func totalQuotaCheck(ctx context.Context, res *quota.ApplyQuotaResource,
+	domainLimits, projectLimits *quota.Limits) *quota.ApplyQuotaResult {
+	domain, project := serviceUtil.SplitDomainProject(res.DomainProject)
+	domainLimit, projectLimit := domainLimits.Get(res.QuotaType), projectLimits.Get(res.QuotaType)
 
-func getServicesNum(ctx context.Context, key string) (int64, error) {
-	resp, err := backend.Store().Service().Search(ctx,
-		registry.WithStrKey(key),
-		registry.WithPrefix(),
-		registry.WithCountOnly())
-	if err != nil {
-		return 0, err
+	checks := []scopeLimit{{quota.SCOPE_DOMAIN, domain, "", domainLimit}}
+	// the project never uses more than the domain, so it is checked only if
+	// its limit is lower
+	if projectLimit > 0 && (domainLimit <= 0 || projectLimit < domainLimit) {
+		checks = append(checks, scopeLimit{quota.SCOPE_PROJECT, res.DomainProject, project, projectLimit})
 	}
-	return resp.Count, nil
-}
 
-func getAllServicesNum(ctx context.Context, data *QuotaApplyData) (int64, error) {
-	key := core.GetServiceRootKey("")
-	return getServicesNum(ctx, key)
+	for _, c := range checks {
+		if c.limit <= 0 {
+			continue
+		}
+		curNum, err := serviceUtil.GetQuotaUsed(ctx, res.QuotaType, domain, c.project)
+		if err != nil {
+			util.Logger().Errorf(err, "%s quota check failed", res.QuotaType)
+			return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrInternal, err.Error()))
+		}
+		if curNum+res.QuotaSize > c.limit {
+			mes := fmt.Sprintf("no quota to create %s in %s %s, max quota is %d, current used quota is %d, apply quota num is %d",
+				res.QuotaType, c.scope, c.name, c.limit, curNum, res.QuotaSize)
+			util.Logger().Errorf(nil, mes)
+			return quota.NewApplyQuotaResult(nil, scerr.NewError(scerr.ErrNotEnoughQuota, mes))
+		}
+	}
+	return quota.NewApplyQuotaResult(nil, nil)
 }
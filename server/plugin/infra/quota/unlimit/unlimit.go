@@ -30,9 +30,8 @@ func init() {
 	if quataType != "unlimit" {
 		return
 	}
-	quota.DefaultSchemaQuota = 0
-	quota.DefaultTagQuota = 0
-	quota.DefaultRuleQuota = 0
+	// the request sizes are not limited by the defaults either
+	quota.DefaultLimits = &quota.Limits{}
 }
 
 type Unlimit struct {
@@ -48,3 +47,8 @@ func (q *Unlimit) Apply4Quotas(ctx context.Context, res *quota.ApplyQuotaResourc
 
 func (q *Unlimit) RemandQuotas(ctx context.Context, quotaType quota.ResourceType) {
 }
+
+// GetUsage reports nothing, because no resource is limited
+func (q *Unlimit) GetUsage(ctx context.Context, domainProject string, serviceId string) ([]*quota.Usage, error) {
+	return nil, nil
+}
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aes
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/cipher"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	mgr "github.com/apache/incubator-servicecomb-service-center/server/plugin"
+)
+
+func init() {
+	mgr.RegisterPlugin(mgr.Plugin{mgr.CIPHER, "aes", New})
+}
+
+func New() mgr.PluginInstance {
+	keyring, err := core.LoadCipherKeyring()
+	if err != nil {
+		util.Logger().Errorf(err, "load cipher keyring failed")
+		return &AESCipher{err: err}
+	}
+	util.Logger().Infof("aes cipher loaded %d keys, encrypt by key %s", keyring.Len(), keyring.Primary())
+	return &AESCipher{keyring: keyring}
+}
+
+// AESCipher encrypts the values by AES-256-GCM, it never returns the plain
+// text if the keyring is not loaded
+type AESCipher struct {
+	keyring *cipher.Keyring
+	err     error
+}
+
+func (c *AESCipher) Encrypt(src string) (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	return c.keyring.Encrypt(src)
+}
+
+func (c *AESCipher) Decrypt(src string) (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	return c.keyring.Decrypt(src)
+}
+
+func (c *AESCipher) IsStale(src string) bool {
+	if c.err != nil {
+		return false
+	}
+	return c.keyring.IsStale(src)
+}
@@ -27,11 +27,13 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 )
 
 var (
 	clientTLSConfig *tls.Config
 	serverTLSConfig *tls.Config
+	reloader        *tlsutil.Reloader
 	mux             sync.Mutex
 )
 
@@ -61,6 +63,55 @@ func GetPassphase() (pass string, decrypt string) {
 	return pass, decrypt
 }
 
+// GetCRLPath returns the path of the CRL file, the relative one is under
+// the SSL_ROOT
+func GetCRLPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return GetSSLPath(path)
+}
+
+// fileOptions returns the options of the files loaded by the reloader, it
+// is called on every reload, so the rotated key passphase is read again
+func fileOptions() []tlsutil.SSLConfigOption {
+	passphase, decrypt := GetPassphase()
+	interval, err := time.ParseDuration(core.ServerInfo.Config.SslReloadInterval)
+	if err != nil && len(core.ServerInfo.Config.SslReloadInterval) > 0 {
+		util.Logger().Warnf(err, "invalid ssl_reload_interval(%s), disable the ssl files reload.",
+			core.ServerInfo.Config.SslReloadInterval)
+	}
+
+	opts := []tlsutil.SSLConfigOption{
+		tlsutil.WithKeyPass(decrypt),
+		tlsutil.WithCert(GetSSLPath("server.cer")),
+		tlsutil.WithKey(GetSSLPath("server_key.pem")),
+		tlsutil.WithReloadInterval(interval),
+	}
+	if core.ServerInfo.Config.SslVerifyPeer {
+		opts = append(opts, tlsutil.WithCA(GetSSLPath("trust.cer")))
+		if crl := core.ServerInfo.Config.SslCrlFile; len(crl) > 0 {
+			opts = append(opts, tlsutil.WithCRL(GetCRLPath(crl)))
+		}
+	}
+	util.Logger().Debugf("load ssl files, pphase %d, reload interval %s.", len(passphase), interval)
+	return opts
+}
+
+// getReloader returns the reloader of the ssl files, and starts to watch
+// the files at the first time
+func getReloader() (_ *tlsutil.Reloader, err error) {
+	if reloader != nil {
+		return reloader, nil
+	}
+	reloader, err = tlsutil.NewReloader(fileOptions)
+	if err != nil {
+		return nil, err
+	}
+	util.Go(reloader.Run)
+	return reloader, nil
+}
+
 func GetClientTLSConfig() (_ *tls.Config, err error) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -68,7 +119,10 @@ func GetClientTLSConfig() (_ *tls.Config, err error) {
 		return clientTLSConfig, nil
 	}
 
-	passphase, decrypt := GetPassphase()
+	r, err := getReloader()
+	if err != nil {
+		return nil, err
+	}
 
 	opts := append(tlsutil.DefaultClientTLSOptions(),
 		tlsutil.WithVerifyPeer(core.ServerInfo.Config.SslVerifyPeer),
@@ -78,21 +132,14 @@ func GetClientTLSConfig() (_ *tls.Config, err error) {
 				beego.AppConfig.DefaultString("ssl_client_min_version", core.ServerInfo.Config.SslMinVersion)),
 			tls.VersionTLS12),
 		tlsutil.WithCipherSuits(tlsutil.ParseDefaultSSLCipherSuites(beego.AppConfig.String("ssl_client_ciphers"))),
-		tlsutil.WithKeyPass(decrypt),
-		tlsutil.WithCA(GetSSLPath("trust.cer")),
-		tlsutil.WithCert(GetSSLPath("server.cer")),
-		tlsutil.WithKey(GetSSLPath("server_key.pem")),
 	)
-	clientTLSConfig, err = tlsutil.GetClientTLSConfig(opts...)
+	clientTLSConfig = r.ClientConfig(opts...)
 
-	if clientTLSConfig != nil {
-		util.Logger().Infof("client ssl configs enabled, verifyclient %t, minv %#x, cipers %d, pphase %d.",
-			core.ServerInfo.Config.SslVerifyPeer,
-			clientTLSConfig.MinVersion,
-			len(clientTLSConfig.CipherSuites),
-			len(passphase))
-	}
-	return clientTLSConfig, err
+	util.Logger().Infof("client ssl configs enabled, verifyclient %t, minv %#x, cipers %d.",
+		core.ServerInfo.Config.SslVerifyPeer,
+		clientTLSConfig.MinVersion,
+		len(clientTLSConfig.CipherSuites))
+	return clientTLSConfig, nil
 }
 
 func GetServerTLSConfig() (_ *tls.Config, err error) {
@@ -102,26 +149,22 @@ func GetServerTLSConfig() (_ *tls.Config, err error) {
 		return serverTLSConfig, nil
 	}
 
-	passphase, decrypt := GetPassphase()
+	r, err := getReloader()
+	if err != nil {
+		return nil, err
+	}
 
 	opts := append(tlsutil.DefaultServerTLSOptions(),
 		tlsutil.WithVerifyPeer(core.ServerInfo.Config.SslVerifyPeer),
 		tlsutil.WithVersion(tlsutil.ParseSSLProtocol(core.ServerInfo.Config.SslMinVersion), tls.VersionTLS12),
 		tlsutil.WithCipherSuits(tlsutil.ParseDefaultSSLCipherSuites(core.ServerInfo.Config.SslCiphers)),
-		tlsutil.WithKeyPass(decrypt),
-		tlsutil.WithCA(GetSSLPath("trust.cer")),
-		tlsutil.WithCert(GetSSLPath("server.cer")),
-		tlsutil.WithKey(GetSSLPath("server_key.pem")),
 	)
+	serverTLSConfig = r.ServerConfig(opts...)
 
-	serverTLSConfig, err = tlsutil.GetServerTLSConfig(opts...)
-
-	if serverTLSConfig != nil {
-		util.Logger().Infof("server ssl configs enabled, verifyClient %t, minv %#x, ciphers %d, phase %d.",
-			core.ServerInfo.Config.SslVerifyPeer,
-			serverTLSConfig.MinVersion,
-			len(serverTLSConfig.CipherSuites),
-			len(passphase))
-	}
-	return serverTLSConfig, err
+	util.Logger().Infof("server ssl configs enabled, verifyClient %t, minv %#x, ciphers %d, crl %t.",
+		core.ServerInfo.Config.SslVerifyPeer,
+		serverTLSConfig.MinVersion,
+		len(serverTLSConfig.CipherSuites),
+		len(core.ServerInfo.Config.SslCrlFile) > 0)
+	return serverTLSConfig, nil
 }
@@ -33,10 +33,10 @@ func TestGetServerTLSConfig(t *testing.T) {
 	if err != nil {
 		t.Fatalf("GetServerTLSConfig failed")
 	}
-	if len(serverTLSConfig.Certificates) == 0 {
+	if cert, err := serverTLSConfig.GetCertificate(nil); err != nil || cert == nil {
 		t.Fatalf("GetServerTLSConfig failed")
 	}
-	if serverTLSConfig.ClientCAs == nil {
+	if serverTLSConfig.VerifyPeerCertificate == nil {
 		t.Fatalf("GetServerTLSConfig failed")
 	}
 	if len(serverTLSConfig.CipherSuites) != 4 {
@@ -48,7 +48,7 @@ func TestGetServerTLSConfig(t *testing.T) {
 	if serverTLSConfig.MaxVersion != tls.VersionTLS12 {
 		t.Fatalf("GetServerTLSConfig failed")
 	}
-	if serverTLSConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+	if serverTLSConfig.ClientAuth != tls.RequireAnyClientCert {
 		t.Fatalf("GetServerTLSConfig failed")
 	}
 }
@@ -58,7 +58,7 @@ func TestGetClientTLSConfig(t *testing.T) {
 	if err != nil {
 		t.Fatalf("GetClientTLSConfig failed")
 	}
-	if len(clientTLSConfig.Certificates) == 0 {
+	if cert, err := clientTLSConfig.GetClientCertificate(nil); err != nil || len(cert.Certificate) == 0 {
 		t.Fatalf("GetClientTLSConfig failed")
 	}
 	if clientTLSConfig.RootCAs == nil {
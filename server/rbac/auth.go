@@ -0,0 +1,164 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"golang.org/x/net/context"
+	"net/http"
+	"strings"
+)
+
+const TOKEN_PATH = "/v4/token"
+
+// IsAnonymous returns true if the request path can be accessed without token,
+// only the token, version and health endpoints are anonymous
+func IsAnonymous(path string) bool {
+	switch path {
+	case TOKEN_PATH, "/version", "/health":
+		return true
+	}
+	// /v4/:project/registry/version and /v4/:project/registry/health
+	segments := strings.Split(path, "/")
+	return len(segments) == 5 && len(segments[0]) == 0 && segments[1] == "v4" &&
+		len(segments[2]) > 0 && segments[3] == "registry" &&
+		(segments[4] == "version" || segments[4] == "health")
+}
+
+// Identify authenticates the bearer token of the request, and checks the roles of
+// the account have the permission of the request. The domain of the request is
+// bound from the account rather than the header
+func Identify(r *http.Request) error {
+	if IsAnonymous(r.URL.Path) {
+		return nil
+	}
+
+	ctx := r.Context()
+	account, err := authenticate(ctx, r.Header.Get("Authorization"))
+	if err != nil {
+		return err
+	}
+	if !isSelfPasswordChange(account, r) {
+		err = authorize(ctx, account, ResourceOf(r.URL.Path), VerbOf(r.Method))
+		if err != nil {
+			return err
+		}
+	}
+
+	util.SetRequestContext(r, "domain", account.Domain)
+	util.SetRequestContext(r, "operator", account.Name)
+	util.SetRequestContext(r, "admin", account.HasRole(ROLE_ADMIN))
+	return nil
+}
+
+// IdentifyMethod is the same as Identify for the grpc method, the bearer token
+// is the 'authorization' metadata of the call
+func IdentifyMethod(ctx context.Context, fullMethod string, authorization string) (context.Context, error) {
+	account, err := authenticate(ctx, authorization)
+	if err != nil {
+		return nil, err
+	}
+	resource, verb := ResourceOfMethod(fullMethod)
+	err = authorize(ctx, account, resource, verb)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = util.SetDomain(ctx, account.Domain)
+	ctx = util.SetOperator(ctx, account.Name)
+	return util.SetAdmin(ctx, account.HasRole(ROLE_ADMIN)), nil
+}
+
+func authorize(ctx context.Context, account *Account, resource string, verb string) error {
+	allow, err := CheckPermission(ctx, account, resource, verb)
+	if err != nil {
+		util.Logger().Errorf(err, "check the permission of account %s failed", account.Name)
+		return scerr.NewError(scerr.ErrInternal, err.Error())
+	}
+	if !allow {
+		return scerr.NewError(scerr.ErrForbidden,
+			"account "+account.Name+" can not "+verb+" the "+resource)
+	}
+	return nil
+}
+
+func authenticate(ctx context.Context, authorization string) (*Account, error) {
+	const prefix = "Bearer "
+	if !strings.HasPrefix(authorization, prefix) {
+		return nil, scerr.NewError(scerr.ErrUnauthorized, "request does not contain the bearer token")
+	}
+
+	secret, err := TokenSecret(ctx)
+	if err != nil {
+		util.Logger().Errorf(err, "get the token secret failed")
+		return nil, scerr.NewError(scerr.ErrInternal, err.Error())
+	}
+	claims, err := ParseToken(secret, strings.TrimPrefix(authorization, prefix))
+	if err != nil {
+		return nil, scerr.NewError(scerr.ErrUnauthorized, err.Error())
+	}
+
+	// the deleted account is refused even if its token does not expire
+	account, err := GetAccount(ctx, claims.Subject)
+	if err != nil {
+		util.Logger().Errorf(err, "get account %s failed", claims.Subject)
+		return nil, scerr.NewError(scerr.ErrInternal, err.Error())
+	}
+	if account == nil {
+		return nil, scerr.NewError(scerr.ErrUnauthorized, "account "+claims.Subject+" does not exist")
+	}
+	// the token issued before the password is changed, or the account is
+	// re-created, is refused
+	if claims.Generation != account.TokenGeneration {
+		return nil, scerr.NewError(scerr.ErrUnauthorized, "token of account "+claims.Subject+" is revoked")
+	}
+	return account, nil
+}
+
+// Allowed returns true if the account of the request has the permission of the
+// verb on the resource, every request is allowed if rbac is disabled
+func Allowed(ctx context.Context, resource string, verb string) (bool, error) {
+	if !Enabled() {
+		return true, nil
+	}
+	account, err := GetAccount(ctx, util.ParseOperator(ctx))
+	if err != nil || account == nil {
+		return false, err
+	}
+	return CheckPermission(ctx, account, resource, verb)
+}
+
+// CheckPermission returns true if any role of the account has the permission
+func CheckPermission(ctx context.Context, account *Account, resource string, verb string) (bool, error) {
+	for _, name := range account.Roles {
+		role, err := GetRole(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		if role != nil && role.Allow(resource, verb) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// every account can change its own password
+func isSelfPasswordChange(account *Account, r *http.Request) bool {
+	return r.Method == http.MethodPut &&
+		r.URL.Path == "/v4/accounts/"+account.Name+"/password"
+}
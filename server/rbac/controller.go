@@ -0,0 +1,317 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+func init() {
+	rest.RegisterServent(&RBACController{})
+}
+
+type TokenRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type TokenResponse struct {
+	Token    string `json:"token"`
+	ExpireAt string `json:"expireAt"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword,omitempty"`
+	Password        string `json:"password"`
+}
+
+type GetAccountsResponse struct {
+	Accounts []*Account `json:"accounts"`
+}
+
+type GetRolesResponse struct {
+	Roles []*Role `json:"roles"`
+}
+
+type RBACController struct {
+}
+
+func (this *RBACController) URLPatterns() []rest.Route {
+	return []rest.Route{
+		{rest.HTTP_METHOD_POST, TOKEN_PATH, this.CreateToken},
+		{rest.HTTP_METHOD_GET, "/v4/accounts", this.ListAccounts},
+		{rest.HTTP_METHOD_POST, "/v4/accounts", this.CreateAccount},
+		{rest.HTTP_METHOD_GET, "/v4/accounts/:name", this.GetAccount},
+		{rest.HTTP_METHOD_DELETE, "/v4/accounts/:name", this.DeleteAccount},
+		{rest.HTTP_METHOD_PUT, "/v4/accounts/:name/password", this.ChangePassword},
+		{rest.HTTP_METHOD_GET, "/v4/roles", this.ListRoles},
+		{rest.HTTP_METHOD_GET, "/v4/roles/:name", this.GetRole},
+		{rest.HTTP_METHOD_PUT, "/v4/roles/:name", this.PutRole},
+		{rest.HTTP_METHOD_DELETE, "/v4/roles/:name", this.DeleteRole},
+	}
+}
+
+func (this *RBACController) CreateToken(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	request := &TokenRequest{}
+	if !readRequest(w, r, request) {
+		return
+	}
+	ctx := r.Context()
+	account, err := GetAccount(ctx, request.Name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if account == nil || !VerifyPassword(account, request.Password) {
+		controller.WriteError(w, scerr.ErrUnauthorized, "invalid account name or password")
+		return
+	}
+	secret, err := TokenSecret(ctx)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	ttl := TokenTTL()
+	token, err := IssueToken(secret, account, ttl)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	controller.WriteResponse(w, nil, &TokenResponse{
+		Token:    token,
+		ExpireAt: time.Now().Add(ttl).Format(time.RFC3339),
+	})
+}
+
+func (this *RBACController) ListAccounts(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	accounts, err := ListAccounts(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	controller.WriteResponse(w, nil, &GetAccountsResponse{Accounts: accounts})
+}
+
+func (this *RBACController) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	account := &Account{}
+	if !readRequest(w, r, account) {
+		return
+	}
+	if len(account.Domain) == 0 {
+		account.Domain = util.ParseDomain(r.Context())
+	}
+	err := CreateAccount(r.Context(), account)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	util.Logger().Infof("account %s is created by %s", account.Name, util.ParseOperator(r.Context()))
+	controller.WriteResponse(w, nil, nil)
+}
+
+func (this *RBACController) GetAccount(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	account, ok := getAccount(w, r)
+	if !ok {
+		return
+	}
+	account.Password, account.TokenGeneration = "", ""
+	controller.WriteResponse(w, nil, account)
+}
+
+func (this *RBACController) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	account, ok := getAccount(w, r)
+	if !ok {
+		return
+	}
+	operator := util.ParseOperator(r.Context())
+	if account.Name == operator {
+		controller.WriteError(w, scerr.ErrForbidden, "can not delete the account itself")
+		return
+	}
+	err := DeleteAccount(r.Context(), account.Name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	util.Logger().Infof("account %s is deleted by %s", account.Name, operator)
+	controller.WriteResponse(w, nil, nil)
+}
+
+func (this *RBACController) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	request := &ChangePasswordRequest{}
+	if !readRequest(w, r, request) {
+		return
+	}
+	account, ok := getAccount(w, r)
+	if !ok {
+		return
+	}
+	// the administrator can reset the password of other accounts
+	if account.Name == util.ParseOperator(r.Context()) &&
+		!VerifyPassword(account, request.CurrentPassword) {
+		controller.WriteError(w, scerr.ErrUnauthorized, "invalid current password")
+		return
+	}
+	err := UpdatePassword(r.Context(), account, request.Password)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	controller.WriteResponse(w, nil, nil)
+}
+
+func (this *RBACController) ListRoles(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	roles, err := ListRoles(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	controller.WriteResponse(w, nil, &GetRolesResponse{Roles: roles})
+}
+
+func (this *RBACController) GetRole(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	name := r.URL.Query().Get(":name")
+	role, err := GetRole(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if role == nil {
+		controller.WriteError(w, scerr.ErrRoleNotExists, name)
+		return
+	}
+	controller.WriteResponse(w, nil, role)
+}
+
+func (this *RBACController) PutRole(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	role := &Role{}
+	if !readRequest(w, r, role) {
+		return
+	}
+	role.Name = r.URL.Query().Get(":name")
+	err := PutRole(r.Context(), role)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	controller.WriteResponse(w, nil, nil)
+}
+
+func (this *RBACController) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	if !checkEnabled(w) {
+		return
+	}
+	name := r.URL.Query().Get(":name")
+	role, err := GetRole(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if role == nil {
+		controller.WriteError(w, scerr.ErrRoleNotExists, name)
+		return
+	}
+	err = DeleteRole(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	controller.WriteResponse(w, nil, nil)
+}
+
+// the accounts can not be managed if the rbac auth plugin is not in use,
+// otherwise anyone could create them
+func checkEnabled(w http.ResponseWriter) bool {
+	if !Enabled() {
+		controller.WriteError(w, scerr.ErrForbidden, "the rbac auth plugin is not enabled")
+		return false
+	}
+	return true
+}
+
+func readRequest(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return false
+	}
+	err = json.Unmarshal(body, v)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return false
+	}
+	return true
+}
+
+func getAccount(w http.ResponseWriter, r *http.Request) (*Account, bool) {
+	name := r.URL.Query().Get(":name")
+	account, err := GetAccount(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return nil, false
+	}
+	if account == nil {
+		controller.WriteError(w, scerr.ErrAccountNotExists, name)
+		return nil, false
+	}
+	return account, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	if e, ok := err.(*scerr.Error); ok {
+		controller.WriteError(w, e.Code, e.Detail)
+		return
+	}
+	util.Logger().Errorf(err, "rbac request failed")
+	controller.WriteError(w, scerr.ErrInternal, err.Error())
+}
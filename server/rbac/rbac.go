@@ -0,0 +1,276 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"github.com/astaxie/beego"
+	"net/http"
+	"strings"
+)
+
+const PLUGIN_NAME = "rbac"
+
+const (
+	RESOURCE_ALL        = "*"
+	RESOURCE_SERVICE    = "service"
+	RESOURCE_INSTANCE   = "instance"
+	RESOURCE_SCHEMA     = "schema"
+	RESOURCE_RULE       = "rule"
+	RESOURCE_TAG        = "tag"
+	RESOURCE_DEPENDENCY = "dependency"
+	RESOURCE_GOVERN     = "govern"
+	RESOURCE_ACCOUNT    = "account"
+	RESOURCE_QUOTA      = "quota"
+	RESOURCE_AUDIT      = "audit"
+	RESOURCE_DOMAIN     = "domain"
+	RESOURCE_PROJECT    = "project"
+	RESOURCE_BROKER     = "broker"
+	RESOURCE_WEBHOOK    = "webhook"
+	RESOURCE_RETENTION  = "retention"
+	RESOURCE_MOCK       = "mock"
+)
+
+const (
+	VERB_ALL    = "*"
+	VERB_GET    = "get"
+	VERB_CREATE = "create"
+	VERB_UPDATE = "update"
+	VERB_DELETE = "delete"
+)
+
+const (
+	ROLE_ADMIN     = "admin"
+	ROLE_DEVELOPER = "developer"
+	ROLE_VIEWER    = "viewer"
+)
+
+var registryResources = []string{
+	RESOURCE_SERVICE,
+	RESOURCE_INSTANCE,
+	RESOURCE_SCHEMA,
+	RESOURCE_RULE,
+	RESOURCE_TAG,
+	RESOURCE_DEPENDENCY,
+}
+
+// the roles can not be modified or deleted
+var buildinRoles = map[string]*Role{
+	ROLE_ADMIN: {
+		Name:        ROLE_ADMIN,
+		Permissions: []*Permission{{Resource: RESOURCE_ALL, Verbs: []string{VERB_ALL}}},
+	},
+	ROLE_DEVELOPER: {
+		Name: ROLE_DEVELOPER,
+		Permissions: append(newPermissions(registryResources, VERB_ALL),
+			&Permission{Resource: RESOURCE_GOVERN, Verbs: []string{VERB_GET}},
+			&Permission{Resource: RESOURCE_PROJECT, Verbs: []string{VERB_GET, VERB_CREATE}},
+			&Permission{Resource: RESOURCE_BROKER, Verbs: []string{VERB_ALL}},
+			&Permission{Resource: RESOURCE_WEBHOOK, Verbs: []string{VERB_ALL}},
+			&Permission{Resource: RESOURCE_RETENTION, Verbs: []string{VERB_GET}},
+			&Permission{Resource: RESOURCE_MOCK, Verbs: []string{VERB_ALL}}),
+	},
+	ROLE_VIEWER: {
+		Name: ROLE_VIEWER,
+		Permissions: append(newPermissions(registryResources, VERB_GET),
+			&Permission{Resource: RESOURCE_GOVERN, Verbs: []string{VERB_GET}},
+			&Permission{Resource: RESOURCE_PROJECT, Verbs: []string{VERB_GET}},
+			&Permission{Resource: RESOURCE_BROKER, Verbs: []string{VERB_GET}},
+			&Permission{Resource: RESOURCE_WEBHOOK, Verbs: []string{VERB_GET}},
+			&Permission{Resource: RESOURCE_RETENTION, Verbs: []string{VERB_GET}},
+			// mocking a schema changes nothing whatever the method is
+			&Permission{Resource: RESOURCE_MOCK, Verbs: []string{VERB_ALL}}),
+	},
+}
+
+type Permission struct {
+	Resource string   `json:"resource"`
+	Verbs    []string `json:"verbs"`
+}
+
+type Role struct {
+	Name        string        `json:"name"`
+	Permissions []*Permission `json:"permissions"`
+}
+
+// Allow returns true if the role has the permission of the verb on the resource.
+// The empty resource means the request path is unknown, it is only allowed for
+// the role having the permission on all the resources
+func (role *Role) Allow(resource string, verb string) bool {
+	for _, p := range role.Permissions {
+		if p.Resource != RESOURCE_ALL && (len(resource) == 0 || p.Resource != resource) {
+			continue
+		}
+		for _, v := range p.Verbs {
+			if v == VERB_ALL || v == verb {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type Account struct {
+	Name       string   `json:"name"`
+	Password   string   `json:"password,omitempty"`
+	Domain     string   `json:"domain,omitempty"`
+	Roles      []string `json:"roles,omitempty"`
+	CreateTime string   `json:"createTime,omitempty"`
+	// changed when the account is created or its password is changed,
+	// the tokens of the other generations are refused
+	TokenGeneration string `json:"tokenGeneration,omitempty"`
+}
+
+func (account *Account) HasRole(name string) bool {
+	for _, role := range account.Roles {
+		if role == name {
+			return true
+		}
+	}
+	return false
+}
+
+func newPermissions(resources []string, verbs ...string) []*Permission {
+	permissions := make([]*Permission, 0, len(resources))
+	for _, resource := range resources {
+		permissions = append(permissions, &Permission{Resource: resource, Verbs: verbs})
+	}
+	return permissions
+}
+
+// Enabled returns true if the rbac auth plugin is configured
+func Enabled() bool {
+	return beego.AppConfig.String("auth_plugin") == PLUGIN_NAME
+}
+
+// ResourceOf returns the resource accessed by the request path
+func ResourceOf(path string) string {
+	segments := strings.Split(path, "/")
+	// the project named 'projects' or 'domains' has the longer registry paths
+	if len(segments) <= 4 && len(segments) > 2 && segments[1] == "v4" {
+		switch segments[2] {
+		case "domains":
+			return RESOURCE_DOMAIN
+		case "projects":
+			return RESOURCE_PROJECT
+		}
+	}
+	// the broker and mock APIs are not under /v4
+	if len(segments) > 1 {
+		switch segments[1] {
+		case "", "pacts", "participants", "verification-results", "can-i-deploy", "matrix", "doc":
+			return RESOURCE_BROKER
+		case "webhooks":
+			return RESOURCE_WEBHOOK
+		case "retention":
+			return RESOURCE_RETENTION
+		case "mock":
+			return RESOURCE_MOCK
+		}
+	}
+	resource := ""
+	for _, segment := range segments {
+		switch segment {
+		case "accounts", "roles":
+			return RESOURCE_ACCOUNT
+		case "quotas":
+			return RESOURCE_QUOTA
+		case "audit":
+			return RESOURCE_AUDIT
+		case "govern", "usage":
+			return RESOURCE_GOVERN
+		case "schemas":
+			return RESOURCE_SCHEMA
+		case "instances", "heartbeats", "heartbeat":
+			return RESOURCE_INSTANCE
+		case "rules":
+			return RESOURCE_RULE
+		case "tags":
+			return RESOURCE_TAG
+		case "dependencies", "providers", "consumers":
+			return RESOURCE_DEPENDENCY
+		case "microservices", "existence":
+			// the sub resources of the service are in the following segments
+			resource = RESOURCE_SERVICE
+		}
+	}
+	return resource
+}
+
+// the resources and verbs of the grpc methods
+var grpcMethods = map[string][2]string{
+	"exist":                              {RESOURCE_SERVICE, VERB_GET},
+	"create":                             {RESOURCE_SERVICE, VERB_CREATE},
+	"delete":                             {RESOURCE_SERVICE, VERB_DELETE},
+	"getOne":                             {RESOURCE_SERVICE, VERB_GET},
+	"getServices":                        {RESOURCE_SERVICE, VERB_GET},
+	"updateProperties":                   {RESOURCE_SERVICE, VERB_UPDATE},
+	"deleteServices":                     {RESOURCE_SERVICE, VERB_DELETE},
+	"addRule":                            {RESOURCE_RULE, VERB_CREATE},
+	"getRule":                            {RESOURCE_RULE, VERB_GET},
+	"updateRule":                         {RESOURCE_RULE, VERB_UPDATE},
+	"deleteRule":                         {RESOURCE_RULE, VERB_DELETE},
+	"addTags":                            {RESOURCE_TAG, VERB_CREATE},
+	"getTags":                            {RESOURCE_TAG, VERB_GET},
+	"updateTag":                          {RESOURCE_TAG, VERB_UPDATE},
+	"deleteTags":                         {RESOURCE_TAG, VERB_DELETE},
+	"getSchemaInfo":                      {RESOURCE_SCHEMA, VERB_GET},
+	"getAllSchemaInfo":                   {RESOURCE_SCHEMA, VERB_GET},
+	"deleteSchema":                       {RESOURCE_SCHEMA, VERB_DELETE},
+	"modifySchema":                       {RESOURCE_SCHEMA, VERB_UPDATE},
+	"modifySchemas":                      {RESOURCE_SCHEMA, VERB_UPDATE},
+	"addDependenciesForMicroServices":    {RESOURCE_DEPENDENCY, VERB_CREATE},
+	"createDependenciesForMicroServices": {RESOURCE_DEPENDENCY, VERB_UPDATE},
+	"getProviderDependencies":            {RESOURCE_DEPENDENCY, VERB_GET},
+	"getConsumerDependencies":            {RESOURCE_DEPENDENCY, VERB_GET},
+	"register":                           {RESOURCE_INSTANCE, VERB_CREATE},
+	"unregister":                         {RESOURCE_INSTANCE, VERB_DELETE},
+	"heartbeat":                          {RESOURCE_INSTANCE, VERB_UPDATE},
+	"heartbeatSet":                       {RESOURCE_INSTANCE, VERB_UPDATE},
+	"find":                               {RESOURCE_INSTANCE, VERB_GET},
+	"getInstances":                       {RESOURCE_INSTANCE, VERB_GET},
+	"getOneInstance":                     {RESOURCE_INSTANCE, VERB_GET},
+	"updateStatus":                       {RESOURCE_INSTANCE, VERB_UPDATE},
+	"updateInstanceProperties":           {RESOURCE_INSTANCE, VERB_UPDATE},
+	"watch":                              {RESOURCE_INSTANCE, VERB_GET},
+	"getServiceDetail":                   {RESOURCE_GOVERN, VERB_GET},
+	"getServicesInfo":                    {RESOURCE_GOVERN, VERB_GET},
+	"getApplications":                    {RESOURCE_GOVERN, VERB_GET},
+}
+
+// ResourceOfMethod returns the resource and verb of the grpc method, e.g.
+// '/com.huawei.paas.cse.serviceregistry.api.ServiceCtrl/create', the resource
+// is empty if the method is unknown
+func ResourceOfMethod(fullMethod string) (string, string) {
+	rv := grpcMethods[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+	return rv[0], rv[1]
+}
+
+// VerbOf returns the verb of the request method
+func VerbOf(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return VERB_GET
+	case http.MethodPost:
+		return VERB_CREATE
+	case http.MethodPut, http.MethodPatch:
+		return VERB_UPDATE
+	case http.MethodDelete:
+		return VERB_DELETE
+	default:
+		return ""
+	}
+}
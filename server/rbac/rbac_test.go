@@ -0,0 +1,170 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResourceOf(t *testing.T) {
+	cases := map[string]string{
+		"/v4/default/registry/microservices":                         RESOURCE_SERVICE,
+		"/v4/default/registry/existence":                             RESOURCE_SERVICE,
+		"/v4/default/registry/microservices/1/instances/2/heartbeat": RESOURCE_INSTANCE,
+		"/v4/default/registry/heartbeats":                            RESOURCE_INSTANCE,
+		"/v4/default/registry/microservices/1/schemas/a":             RESOURCE_SCHEMA,
+		"/v4/default/registry/microservices/1/rules":                 RESOURCE_RULE,
+		"/v4/default/registry/microservices/1/tags/a":                RESOURCE_TAG,
+		"/v4/default/registry/microservices/1/providers":             RESOURCE_DEPENDENCY,
+		"/v4/default/registry/dependencies":                          RESOURCE_DEPENDENCY,
+		"/v4/default/govern/microservices/1":                         RESOURCE_GOVERN,
+		"/registry/v3/microservices/1/instances":                     RESOURCE_INSTANCE,
+		"/v4/accounts/root/password":                                 RESOURCE_ACCOUNT,
+		"/v4/roles":                                                  RESOURCE_ACCOUNT,
+		"/v4/quotas/domains/default":                                 RESOURCE_QUOTA,
+		"/v4/default/audit/records":                                  RESOURCE_AUDIT,
+		"/v4/domains/default":                                        RESOURCE_DOMAIN,
+		"/v4/projects":                                               RESOURCE_PROJECT,
+		"/v4/projects/registry/microservices":                        RESOURCE_SERVICE,
+		"/v4/default/registry/usage":                                 RESOURCE_GOVERN,
+		"/pacts/provider/1/consumer/2/version/1.0.0":                 RESOURCE_BROKER,
+		"/can-i-deploy":                                              RESOURCE_BROKER,
+		"/":                                                          RESOURCE_BROKER,
+		"/webhooks/1/executions":                                     RESOURCE_WEBHOOK,
+		"/retention":                                                 RESOURCE_RETENTION,
+		"/mock/1/a/orders":                                           RESOURCE_MOCK,
+		"/unknown":                                                   "",
+	}
+	for path, resource := range cases {
+		if r := ResourceOf(path); r != resource {
+			t.Fatalf("ResourceOf %s failed, expect %s, got %s", path, resource, r)
+		}
+	}
+
+	if r, v := ResourceOfMethod("/com.huawei.paas.cse.serviceregistry.api.ServiceInstanceCtrl/heartbeat"); r != RESOURCE_INSTANCE || v != VERB_UPDATE {
+		t.Fatalf("ResourceOfMethod heartbeat failed, got %s %s", r, v)
+	}
+	if r, _ := ResourceOfMethod("/com.huawei.paas.cse.serviceregistry.api.Unknown/unknown"); len(r) != 0 {
+		t.Fatalf("ResourceOfMethod unknown failed, got %s", r)
+	}
+
+	if VerbOf(http.MethodGet) != VERB_GET || VerbOf(http.MethodPost) != VERB_CREATE ||
+		VerbOf(http.MethodPut) != VERB_UPDATE || VerbOf(http.MethodDelete) != VERB_DELETE {
+		t.Fatalf("VerbOf failed")
+	}
+}
+
+func TestRoleAllow(t *testing.T) {
+	admin, developer, viewer := buildinRoles[ROLE_ADMIN], buildinRoles[ROLE_DEVELOPER], buildinRoles[ROLE_VIEWER]
+	if !admin.Allow(RESOURCE_ACCOUNT, VERB_CREATE) || !admin.Allow(RESOURCE_SERVICE, VERB_DELETE) {
+		t.Fatalf("admin is not allowed to manage everything")
+	}
+	if !developer.Allow(RESOURCE_INSTANCE, VERB_UPDATE) || developer.Allow(RESOURCE_GOVERN, VERB_DELETE) ||
+		developer.Allow(RESOURCE_ACCOUNT, VERB_GET) || !developer.Allow(RESOURCE_PROJECT, VERB_CREATE) ||
+		developer.Allow(RESOURCE_PROJECT, VERB_DELETE) || developer.Allow(RESOURCE_DOMAIN, VERB_GET) {
+		t.Fatalf("developer permissions are wrong")
+	}
+	if !viewer.Allow(RESOURCE_SCHEMA, VERB_GET) || viewer.Allow(RESOURCE_SCHEMA, VERB_CREATE) {
+		t.Fatalf("viewer permissions are wrong")
+	}
+	if viewer.Allow(RESOURCE_WEBHOOK, VERB_CREATE) || viewer.Allow(RESOURCE_RETENTION, VERB_CREATE) ||
+		developer.Allow(RESOURCE_RETENTION, VERB_CREATE) || !developer.Allow(RESOURCE_WEBHOOK, VERB_CREATE) {
+		t.Fatalf("broker permissions are wrong")
+	}
+	if viewer.Allow("", VERB_GET) || developer.Allow("", VERB_GET) || !admin.Allow("", VERB_DELETE) {
+		t.Fatalf("the request without resource is allowed")
+	}
+
+	custom := &Role{Name: "ops", Permissions: []*Permission{{Resource: RESOURCE_INSTANCE, Verbs: []string{VERB_GET, VERB_UPDATE}}}}
+	if !custom.Allow(RESOURCE_INSTANCE, VERB_UPDATE) || custom.Allow(RESOURCE_INSTANCE, VERB_DELETE) ||
+		custom.Allow(RESOURCE_SERVICE, VERB_GET) {
+		t.Fatalf("custom role permissions are wrong")
+	}
+}
+
+func TestToken(t *testing.T) {
+	secret := []byte("secret")
+	account := &Account{Name: "root", Domain: "default", TokenGeneration: "1"}
+	token, err := IssueToken(secret, account, time.Minute)
+	if err != nil {
+		t.Fatalf("IssueToken failed, %v", err)
+	}
+
+	claims, err := ParseToken(secret, token)
+	if err != nil || claims.Subject != "root" || claims.Domain != "default" || claims.Generation != "1" {
+		t.Fatalf("ParseToken failed, %v, %v", claims, err)
+	}
+
+	_, err = ParseToken([]byte("other"), token)
+	if err == nil {
+		t.Fatalf("ParseToken with wrong secret should fail")
+	}
+
+	token, _ = IssueToken(secret, account, -time.Minute)
+	_, err = ParseToken(secret, token)
+	if err == nil {
+		t.Fatalf("ParseToken of expired token should fail")
+	}
+}
+
+func TestAccountValidator(t *testing.T) {
+	valid := []*Account{
+		{Name: "a", Password: "12345678", Domain: "default"},
+		{Name: "ops-1.admin", Password: "12345678", Domain: "tenant_1", Roles: []string{ROLE_VIEWER}},
+	}
+	for _, account := range valid {
+		if err := AccountValidator().Validate(account); err != nil {
+			t.Fatalf("AccountValidator %s failed, %v", account.Name, err)
+		}
+	}
+	invalid := []*Account{
+		{Name: "", Password: "12345678", Domain: "default"},
+		{Name: "a/b", Password: "12345678", Domain: "default"},
+		{Name: "-a", Password: "12345678", Domain: "default"},
+		{Name: "a", Password: "12345678", Domain: ""},
+		{Name: "a", Password: "12345678", Domain: "x/y"},
+		{Name: "a", Password: "1234567", Domain: "default"},
+		{Name: "a", Password: strings.Repeat("1", MAX_PASSWORD_LENGTH+1), Domain: "default"},
+	}
+	for _, account := range invalid {
+		err := AccountValidator().Validate(account)
+		if err == nil {
+			t.Fatalf("AccountValidator %s/%s should fail", account.Domain, account.Name)
+		}
+		if strings.Contains(err.Error(), account.Password) {
+			t.Fatalf("AccountValidator shows the password, %v", err)
+		}
+	}
+}
+
+func TestIsAnonymous(t *testing.T) {
+	for _, path := range []string{TOKEN_PATH, "/version", "/health",
+		"/v4/default/registry/version", "/v4/default/registry/health"} {
+		if !IsAnonymous(path) {
+			t.Fatalf("IsAnonymous %s failed", path)
+		}
+	}
+	for _, path := range []string{"/v4/default/registry/microservices/1/tags/version",
+		"/v4/default/registry/microservices/1/schemas/health", "/v4/token/x", "/v4//registry/version"} {
+		if IsAnonymous(path) {
+			t.Fatalf("IsAnonymous %s failed", path)
+		}
+	}
+}
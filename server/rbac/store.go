@@ -0,0 +1,305 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"github.com/astaxie/beego"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/net/context"
+	"os"
+	"sort"
+	"time"
+)
+
+const (
+	REGISTRY_ACCOUNT_KEY = "accounts"
+	REGISTRY_ROLE_KEY    = "roles"
+	REGISTRY_SECRET_KEY  = "token-secret"
+
+	MIN_PASSWORD_LENGTH = 8
+	// bcrypt ignores the bytes beyond 72
+	MAX_PASSWORD_LENGTH = 72
+)
+
+func GetAccountRootKey() string {
+	return util.StringJoin([]string{
+		core.GetRootKey(),
+		REGISTRY_ACCOUNT_KEY,
+	}, "/")
+}
+
+func GenerateAccountKey(name string) string {
+	return util.StringJoin([]string{
+		GetAccountRootKey(),
+		name,
+	}, "/")
+}
+
+func GetRoleRootKey() string {
+	return util.StringJoin([]string{
+		core.GetRootKey(),
+		REGISTRY_ROLE_KEY,
+	}, "/")
+}
+
+func GenerateRoleKey(name string) string {
+	return util.StringJoin([]string{
+		GetRoleRootKey(),
+		name,
+	}, "/")
+}
+
+func GetTokenSecretKey() string {
+	return util.StringJoin([]string{
+		core.GetRootKey(),
+		REGISTRY_SECRET_KEY,
+	}, "/")
+}
+
+// InitRootAccount creates the root account with the admin role when the password
+// is specified by the SC_ROOT_PASSWORD environment variable, the existing one is
+// never overwritten
+func InitRootAccount(ctx context.Context) {
+	password := os.Getenv("SC_ROOT_PASSWORD")
+	if len(password) == 0 {
+		util.Logger().Warnf(nil, "SC_ROOT_PASSWORD is not set, skip initializing the root account")
+		return
+	}
+	account := &Account{
+		Name:     beego.AppConfig.DefaultString("rbac_root_account", "root"),
+		Password: password,
+		Domain:   core.REGISTRY_DOMAIN,
+		Roles:    []string{ROLE_ADMIN},
+	}
+	err := CreateAccount(ctx, account)
+	if err != nil {
+		if e, ok := err.(*scerr.Error); ok && e.Code == scerr.ErrAccountAlreadyExists {
+			return
+		}
+		util.Logger().Errorf(err, "initialize the root account %s failed", account.Name)
+		return
+	}
+	util.Logger().Infof("initialized the root account %s", account.Name)
+}
+
+// CreateAccount saves the account with the hashed password
+func CreateAccount(ctx context.Context, account *Account) error {
+	if err := AccountValidator().Validate(account); err != nil {
+		return scerr.NewError(scerr.ErrInvalidParams, err.Error())
+	}
+	// the domain of service center may be created after the root account
+	if account.Domain != core.REGISTRY_DOMAIN {
+		exist, err := serviceUtil.DomainExist(
+			util.SetContext(util.CloneContext(ctx), serviceUtil.CTX_NOCACHE, "1"), account.Domain)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return scerr.NewError(scerr.ErrDomainNotExists, account.Domain)
+		}
+	}
+	for _, name := range account.Roles {
+		role, err := GetRole(ctx, name)
+		if err != nil {
+			return err
+		}
+		if role == nil {
+			return scerr.NewError(scerr.ErrRoleNotExists, name)
+		}
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(account.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	saved := *account
+	saved.Password = util.BytesToStringWithNoCopy(hash)
+	saved.CreateTime = time.Now().Format(time.RFC3339)
+	saved.TokenGeneration = util.GenerateUuid()
+	data, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	ok, err := backend.Registry().PutNoOverride(ctx,
+		registry.WithStrKey(GenerateAccountKey(account.Name)),
+		registry.WithValue(data))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return scerr.NewError(scerr.ErrAccountAlreadyExists, account.Name)
+	}
+	return nil
+}
+
+// GetAccount returns the account with the hashed password, nil if not exist
+func GetAccount(ctx context.Context, name string) (*Account, error) {
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(GenerateAccountKey(name)))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	account := &Account{}
+	err = json.Unmarshal(resp.Kvs[0].Value, account)
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ListAccounts returns the accounts without the passwords, sorted by the name
+func ListAccounts(ctx context.Context) ([]*Account, error) {
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(GetAccountRootKey()+"/"),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*Account, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		account := &Account{}
+		err = json.Unmarshal(kv.Value, account)
+		if err != nil {
+			return nil, err
+		}
+		account.Password, account.TokenGeneration = "", ""
+		accounts = append(accounts, account)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Name < accounts[j].Name
+	})
+	return accounts, nil
+}
+
+func DeleteAccount(ctx context.Context, name string) error {
+	_, err := backend.Registry().Do(ctx, registry.DEL,
+		registry.WithStrKey(GenerateAccountKey(name)))
+	return err
+}
+
+// UpdatePassword replaces the password of the account, and revokes the tokens
+// issued before
+func UpdatePassword(ctx context.Context, account *Account, password string) error {
+	if ok, _ := AccountValidator().GetRule("Password").Match(password); !ok {
+		return scerr.NewError(scerr.ErrInvalidParams,
+			fmt.Sprintf("the password is %d to %d characters", MIN_PASSWORD_LENGTH, MAX_PASSWORD_LENGTH))
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	saved := *account
+	saved.Password = util.BytesToStringWithNoCopy(hash)
+	saved.TokenGeneration = util.GenerateUuid()
+	data, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(GenerateAccountKey(account.Name)),
+		registry.WithValue(data))
+	return err
+}
+
+// VerifyPassword returns true if the password matches the hashed one of the account
+func VerifyPassword(account *Account, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(password)) == nil
+}
+
+// GetRole returns the build-in or custom role, nil if not exist
+func GetRole(ctx context.Context, name string) (*Role, error) {
+	if role, ok := buildinRoles[name]; ok {
+		return role, nil
+	}
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(GenerateRoleKey(name)))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	role := &Role{}
+	err = json.Unmarshal(resp.Kvs[0].Value, role)
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// ListRoles returns the build-in and custom roles, sorted by the name
+func ListRoles(ctx context.Context) ([]*Role, error) {
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(GetRoleRootKey()+"/"),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*Role, 0, len(buildinRoles)+len(resp.Kvs))
+	for _, role := range buildinRoles {
+		roles = append(roles, role)
+	}
+	for _, kv := range resp.Kvs {
+		role := &Role{}
+		err = json.Unmarshal(kv.Value, role)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return roles, nil
+}
+
+// PutRole creates or replaces the custom role
+func PutRole(ctx context.Context, role *Role) error {
+	if _, ok := buildinRoles[role.Name]; ok {
+		return scerr.NewError(scerr.ErrForbidden, "can not modify the build-in role "+role.Name)
+	}
+	if len(role.Name) == 0 {
+		return scerr.NewError(scerr.ErrInvalidParams, "role name is required")
+	}
+	data, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(GenerateRoleKey(role.Name)),
+		registry.WithValue(data))
+	return err
+}
+
+func DeleteRole(ctx context.Context, name string) error {
+	if _, ok := buildinRoles[name]; ok {
+		return scerr.NewError(scerr.ErrForbidden, "can not delete the build-in role "+name)
+	}
+	_, err := backend.Registry().Do(ctx, registry.DEL,
+		registry.WithStrKey(GenerateRoleKey(name)))
+	return err
+}
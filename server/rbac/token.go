@@ -0,0 +1,128 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/astaxie/beego"
+	"github.com/dgrijalva/jwt-go"
+	"golang.org/x/net/context"
+	"sync"
+	"time"
+)
+
+const DEFAULT_TOKEN_TTL = 30 * time.Minute
+
+var (
+	secret     []byte
+	secretLock sync.Mutex
+)
+
+// Claims is the payload of the token, the subject is the account name
+type Claims struct {
+	Domain     string `json:"domain,omitempty"`
+	Generation string `json:"gen,omitempty"`
+	jwt.StandardClaims
+}
+
+// IssueToken returns the token of the account signed by the secret
+func IssueToken(secret []byte, account *Account, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &Claims{
+		Domain:     account.Domain,
+		Generation: account.TokenGeneration,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   account.Name,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(ttl).Unix(),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+}
+
+// ParseToken verifies the signature and the expiration of the token
+func ParseToken(secret []byte, token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(claims.Subject) == 0 {
+		return nil, errors.New("token does not contain the account")
+	}
+	return claims, nil
+}
+
+// TokenTTL returns the configured lifetime of the issued tokens
+func TokenTTL() time.Duration {
+	s := beego.AppConfig.String("rbac_token_ttl")
+	if len(s) == 0 {
+		return DEFAULT_TOKEN_TTL
+	}
+	ttl, err := time.ParseDuration(s)
+	if err != nil || ttl <= 0 {
+		util.Logger().Errorf(err, "invalid rbac token ttl %s, reset to default ttl %s", s, DEFAULT_TOKEN_TTL)
+		return DEFAULT_TOKEN_TTL
+	}
+	return ttl
+}
+
+// TokenSecret returns the secret to sign the tokens. It is generated and saved
+// in the registry at the first time, so the service center instances share it
+func TokenSecret(ctx context.Context) ([]byte, error) {
+	secretLock.Lock()
+	defer secretLock.Unlock()
+	if secret != nil {
+		return secret, nil
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	_, err := backend.Registry().PutNoOverride(ctx,
+		registry.WithStrKey(GetTokenSecretKey()),
+		registry.WithStrValue(hex.EncodeToString(b)))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(GetTokenSecretKey()))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, errors.New("token secret does not exist")
+	}
+	b, err = hex.DecodeString(util.BytesToStringWithNoCopy(resp.Kvs[0].Value))
+	if err != nil {
+		return nil, err
+	}
+	secret = b
+	return secret, nil
+}
@@ -0,0 +1,41 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rbac
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/validate"
+	"regexp"
+)
+
+var accountValidator validate.Validator
+
+var (
+	// the account name is a segment of the registry key and the request path,
+	// the domain is the same as the tenant name
+	accountNameRegex, _ = regexp.Compile(`^[a-zA-Z0-9]$|^[a-zA-Z0-9][a-zA-Z0-9_\-.]*[a-zA-Z0-9]$`)
+)
+
+// AccountValidator validates the account to create, the password is hidden
+// in the error
+func AccountValidator() *validate.Validator {
+	return accountValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Name", &validate.ValidateRule{Min: 1, Max: 64, Regexp: accountNameRegex})
+		v.AddRule("Password", &validate.ValidateRule{Min: MIN_PASSWORD_LENGTH, Max: MAX_PASSWORD_LENGTH, Hide: true})
+		v.AddRule("Domain", &validate.ValidateRule{Min: 1, Max: 64, Regexp: accountNameRegex})
+		v.AddRule("Roles", &validate.ValidateRule{Max: 64})
+	})
+}
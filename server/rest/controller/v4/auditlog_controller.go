@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v4
+
+import (
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultAuditRecordsLimit = 100
+
+type GetAuditRecordsResponse struct {
+	Records []*auditlog.Entry `json:"records"`
+}
+
+type AuditLogService struct {
+	//
+}
+
+func (this *AuditLogService) URLPatterns() []rest.Route {
+	return []rest.Route{
+		{rest.HTTP_METHOD_GET, "/v4/:project/audit/records", this.GetRecords},
+	}
+}
+
+// GetRecords returns the latest records of the project matching the query
+func (this *AuditLogService) GetRecords(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	option := &auditlog.QueryOption{
+		Domain:     util.ParseDomain(r.Context()),
+		Project:    util.ParseProject(r.Context()),
+		ServiceId:  query.Get("serviceId"),
+		InstanceId: query.Get("instanceId"),
+		Entity:     query.Get("entity"),
+		Limit:      defaultAuditRecordsLimit,
+	}
+	var err error
+	if option.Start, err = parseAuditTime(query.Get("start")); err != nil {
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	if option.End, err = parseAuditTime(query.Get("end")); err != nil {
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	if limit := query.Get("limit"); len(limit) > 0 {
+		option.Limit, err = strconv.Atoi(limit)
+		if err != nil || option.Limit < 0 {
+			controller.WriteError(w, scerr.ErrInvalidParams, "invalid limit "+limit)
+			return
+		}
+	}
+
+	records, err := plugin.Plugins().AuditLog().Query(option)
+	if err != nil {
+		util.Logger().Errorf(err, "query audit records of %s failed", util.ParseDomainProject(r.Context()))
+		controller.WriteError(w, scerr.ErrInternal, err.Error())
+		return
+	}
+	if records == nil {
+		records = []*auditlog.Entry{}
+	}
+	controller.WriteResponse(w, nil, &GetAuditRecordsResponse{Records: records})
+}
+
+// parseAuditTime parses the unix time in seconds or the RFC3339 time
+func parseAuditTime(s string) (int64, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return sec, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, must be the unix time in seconds or RFC3339", s)
+	}
+	return t.Unix(), nil
+}
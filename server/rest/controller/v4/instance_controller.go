@@ -142,6 +142,9 @@ func (this *MicroServiceInstanceService) FindInstances(w http.ResponseWriter, r
 	iv, _ := r.Context().Value(serviceUtil.CTX_REQUEST_REVISION).(string)
 	ov, _ := r.Context().Value(serviceUtil.CTX_RESPONSE_REVISION).(string)
 	w.Header().Set(serviceUtil.HEADER_REV, fmt.Sprint(ov))
+	if dv, _ := r.Context().Value(serviceUtil.CTX_RESPONSE_DEPRECATION).(string); len(dv) > 0 {
+		w.Header().Set(serviceUtil.HEADER_WARNING, serviceUtil.WarningOf(dv))
+	}
 	if len(iv) > 0 && iv == ov {
 		w.WriteHeader(http.StatusNotModified)
 		return
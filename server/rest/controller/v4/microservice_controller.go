@@ -41,6 +41,8 @@ func (this *MicroServiceService) URLPatterns() []rest.Route {
 		{rest.HTTP_METHOD_GET, "/v4/:project/registry/microservices/:serviceId", this.GetServiceOne},
 		{rest.HTTP_METHOD_POST, "/v4/:project/registry/microservices", this.Register},
 		{rest.HTTP_METHOD_PUT, "/v4/:project/registry/microservices/:serviceId/properties", this.Update},
+		{rest.HTTP_METHOD_PUT, "/v4/:project/registry/microservices/:serviceId/deprecation", this.UpdateDeprecation},
+		{rest.HTTP_METHOD_PUT, "/v4/:project/registry/microservices/:serviceId/credential", this.RotateCredential},
 		{rest.HTTP_METHOD_DELETE, "/v4/:project/registry/microservices/:serviceId", this.Unregister},
 		{rest.HTTP_METHOD_DELETE, "/v4/:project/registry/microservices", this.UnregisterServices},
 	}
@@ -86,6 +88,49 @@ func (this *MicroServiceService) Update(w http.ResponseWriter, r *http.Request)
 	controller.WriteResponse(w, resp.Response, nil)
 }
 
+func (this *MicroServiceService) UpdateDeprecation(w http.ResponseWriter, r *http.Request) {
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request := &pb.UpdateDeprecationRequest{
+		ServiceId: r.URL.Query().Get(":serviceId"),
+	}
+	err = json.Unmarshal(message, request)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := core.ServiceAPI.UpdateDeprecation(r.Context(), request)
+	controller.WriteResponse(w, resp.Response, nil)
+}
+
+func (this *MicroServiceService) RotateCredential(w http.ResponseWriter, r *http.Request) {
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request := &pb.RotateServiceCredentialRequest{}
+	if len(message) > 0 {
+		err = json.Unmarshal(message, request)
+		if err != nil {
+			util.Logger().Error("Unmarshal error", err)
+			controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+			return
+		}
+	}
+	request.ServiceId = r.URL.Query().Get(":serviceId")
+	resp, _ := core.ServiceAPI.RotateServiceCredential(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
 func (this *MicroServiceService) Unregister(w http.ResponseWriter, r *http.Request) {
 	serviceId := r.URL.Query().Get(":serviceId")
 	force := r.URL.Query().Get("force")
@@ -36,6 +36,7 @@ type RuleService struct {
 func (this *RuleService) URLPatterns() []rest.Route {
 	return []rest.Route{
 		{rest.HTTP_METHOD_POST, "/v4/:project/registry/microservices/:serviceId/rules", this.AddRule},
+		{rest.HTTP_METHOD_POST, "/v4/:project/registry/microservices/:serviceId/rules/explain", this.ExplainRules},
 		{rest.HTTP_METHOD_GET, "/v4/:project/registry/microservices/:serviceId/rules", this.GetRules},
 		{rest.HTTP_METHOD_PUT, "/v4/:project/registry/microservices/:serviceId/rules/:rule_id", this.UpdateRule},
 		{rest.HTTP_METHOD_DELETE, "/v4/:project/registry/microservices/:serviceId/rules/:rule_id", this.DeleteRule},
@@ -59,6 +60,7 @@ func (this *RuleService) AddRule(w http.ResponseWriter, r *http.Request) {
 	resp, err := core.ServiceAPI.AddRule(r.Context(), &pb.AddServiceRulesRequest{
 		ServiceId: r.URL.Query().Get(":serviceId"),
 		Rules:     rule["rules"],
+		DryRun:    r.URL.Query().Get("dryRun") == "true",
 	})
 	respInternal := resp.Response
 	resp.Response = nil
@@ -107,3 +109,26 @@ func (this *RuleService) GetRules(w http.ResponseWriter, r *http.Request) {
 	resp.Response = nil
 	controller.WriteResponse(w, respInternal, resp)
 }
+
+func (this *RuleService) ExplainRules(w http.ResponseWriter, r *http.Request) {
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+
+	request := &pb.ExplainRulesRequest{}
+	err = json.Unmarshal(message, request)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request.ProviderServiceId = r.URL.Query().Get(":serviceId")
+
+	resp, _ := core.ServiceAPI.ExplainRules(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
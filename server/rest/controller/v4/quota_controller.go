@@ -0,0 +1,160 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v4
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
+	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"github.com/apache/incubator-servicecomb-service-center/server/rbac"
+	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"io/ioutil"
+	"net/http"
+)
+
+type GetQuotaResponse struct {
+	// Limits is the quota record, it is empty if not set
+	Limits *quota.Limits `json:"limits,omitempty"`
+	// Effective is the limits in effect after inheriting the upper level
+	Effective *quota.Limits `json:"effective"`
+}
+
+type GetQuotaUsageResponse struct {
+	Usages []*quota.Usage `json:"usages"`
+}
+
+type QuotaService struct {
+	//
+}
+
+func (this *QuotaService) URLPatterns() []rest.Route {
+	return []rest.Route{
+		{rest.HTTP_METHOD_GET, "/v4/:project/registry/usage", this.GetUsage},
+		{rest.HTTP_METHOD_GET, "/v4/quotas/domains/:domain", this.GetQuota},
+		{rest.HTTP_METHOD_PUT, "/v4/quotas/domains/:domain", this.PutQuota},
+		{rest.HTTP_METHOD_DELETE, "/v4/quotas/domains/:domain", this.DeleteQuota},
+		{rest.HTTP_METHOD_GET, "/v4/quotas/domains/:domain/projects/:project", this.GetQuota},
+		{rest.HTTP_METHOD_PUT, "/v4/quotas/domains/:domain/projects/:project", this.PutQuota},
+		{rest.HTTP_METHOD_DELETE, "/v4/quotas/domains/:domain/projects/:project", this.DeleteQuota},
+	}
+}
+
+func (this *QuotaService) GetUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	usages, err := plugin.Plugins().Quota().GetUsage(ctx, util.ParseDomainProject(ctx), r.URL.Query().Get("serviceId"))
+	if err != nil {
+		util.Logger().Errorf(err, "get quota usage of %s failed", util.ParseDomainProject(ctx))
+		controller.WriteError(w, scerr.ErrUnavailableBackend, err.Error())
+		return
+	}
+	if usages == nil {
+		usages = []*quota.Usage{}
+	}
+	controller.WriteResponse(w, nil, &GetQuotaUsageResponse{Usages: usages})
+}
+
+func (this *QuotaService) GetQuota(w http.ResponseWriter, r *http.Request) {
+	if !checkAdmin(w, r) {
+		return
+	}
+	domain, project := r.URL.Query().Get(":domain"), r.URL.Query().Get(":project")
+	// the record just put may be not yet in the cache
+	ctx := util.SetContext(util.CloneContext(r.Context()), serviceUtil.CTX_NOCACHE, "1")
+	limits, err := serviceUtil.GetQuotaRecord(ctx, domain, project)
+	if err != nil {
+		util.Logger().Errorf(err, "get quota of %s/%s failed", domain, project)
+		controller.WriteError(w, scerr.ErrUnavailableBackend, err.Error())
+		return
+	}
+	domainLimits, projectLimits, err := serviceUtil.GetQuotaLimits(ctx,
+		util.StringJoin([]string{domain, project}, "/"))
+	if err != nil {
+		util.Logger().Errorf(err, "get quota of %s/%s failed", domain, project)
+		controller.WriteError(w, scerr.ErrUnavailableBackend, err.Error())
+		return
+	}
+	effective := domainLimits
+	if len(project) > 0 {
+		effective = projectLimits
+	}
+	controller.WriteResponse(w, nil, &GetQuotaResponse{Limits: limits, Effective: effective})
+}
+
+func (this *QuotaService) PutQuota(w http.ResponseWriter, r *http.Request) {
+	if !checkAdmin(w, r) {
+		return
+	}
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	limits := &quota.Limits{}
+	err = json.Unmarshal(message, limits)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	if err := limits.Validate(); err != nil {
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+
+	domain, project := r.URL.Query().Get(":domain"), r.URL.Query().Get(":project")
+	err = serviceUtil.PutQuotaRecord(r.Context(), domain, project, limits)
+	if err != nil {
+		util.Logger().Errorf(err, "put quota of %s/%s failed", domain, project)
+		controller.WriteError(w, scerr.ErrUnavailableBackend, err.Error())
+		return
+	}
+	util.Logger().Infof("put quota of %s/%s successfully, %v, operator: %s",
+		domain, project, *limits, util.ParseOperator(r.Context()))
+	controller.WriteResponse(w, nil, nil)
+}
+
+// DeleteQuota resets the limits to inherit the upper level
+func (this *QuotaService) DeleteQuota(w http.ResponseWriter, r *http.Request) {
+	if !checkAdmin(w, r) {
+		return
+	}
+	domain, project := r.URL.Query().Get(":domain"), r.URL.Query().Get(":project")
+	err := serviceUtil.DeleteQuotaRecord(r.Context(), domain, project)
+	if err != nil {
+		util.Logger().Errorf(err, "delete quota of %s/%s failed", domain, project)
+		controller.WriteError(w, scerr.ErrUnavailableBackend, err.Error())
+		return
+	}
+	util.Logger().Infof("delete quota of %s/%s successfully, operator: %s",
+		domain, project, util.ParseOperator(r.Context()))
+	controller.WriteResponse(w, nil, nil)
+}
+
+// checkAdmin refuses the request managing the other domains, unless the permission
+// is checked by the rbac auth plugin or the request is sent by the administrator
+func checkAdmin(w http.ResponseWriter, r *http.Request) bool {
+	if !rbac.Enabled() && !util.IsAdmin(r.Context()) {
+		controller.WriteError(w, scerr.ErrForbidden, "only the administrators can manage the domains")
+		return false
+	}
+	return true
+}
@@ -24,6 +24,7 @@ import (
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
 	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -38,8 +39,13 @@ func (this *SchemaService) URLPatterns() []rest.Route {
 		{rest.HTTP_METHOD_GET, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId", this.GetSchemas},
 		{rest.HTTP_METHOD_PUT, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId", this.ModifySchema},
 		{rest.HTTP_METHOD_DELETE, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId", this.DeleteSchemas},
+		{rest.HTTP_METHOD_POST, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId/compatibility", this.CheckSchemaCompatibility},
+		{rest.HTTP_METHOD_GET, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId/revisions", this.GetSchemaRevisions},
+		{rest.HTTP_METHOD_GET, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId/diff", this.DiffSchemaRevisions},
+		{rest.HTTP_METHOD_POST, "/v4/:project/registry/microservices/:serviceId/schemas/:schemaId/rollback", this.RollbackSchema},
 		{rest.HTTP_METHOD_POST, "/v4/:project/registry/microservices/:serviceId/schemas", this.ModifySchemas},
 		{rest.HTTP_METHOD_GET, "/v4/:project/registry/microservices/:serviceId/schemas", this.GetAllSchemas},
+		{rest.HTTP_METHOD_GET, "/v4/:project/registry/schemas/search", this.SearchSchemas},
 	}
 }
 
@@ -74,6 +80,98 @@ func (this *SchemaService) ModifySchema(w http.ResponseWriter, r *http.Request)
 	request.ServiceId = r.URL.Query().Get(":serviceId")
 	request.SchemaId = r.URL.Query().Get(":schemaId")
 	resp, err := core.ServiceAPI.ModifySchema(r.Context(), request)
+	writeSchemaWarning(w, r)
+	controller.WriteResponse(w, resp.Response, nil)
+}
+
+func (this *SchemaService) CheckSchemaCompatibility(w http.ResponseWriter, r *http.Request) {
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+
+	request := &pb.CheckSchemaCompatibilityRequest{}
+	err = json.Unmarshal(message, request)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request.ServiceId = r.URL.Query().Get(":serviceId")
+	request.SchemaId = r.URL.Query().Get(":schemaId")
+	resp, _ := core.ServiceAPI.CheckSchemaCompatibility(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (this *SchemaService) GetSchemaRevisions(w http.ResponseWriter, r *http.Request) {
+	withSchema := r.URL.Query().Get("withSchema")
+	if withSchema != "0" && withSchema != "1" && strings.TrimSpace(withSchema) != "" {
+		controller.WriteError(w, scerr.ErrInvalidParams, "parameter withSchema must be 1 or 0")
+		return
+	}
+	request := &pb.GetSchemaRevisionsRequest{
+		ServiceId:  r.URL.Query().Get(":serviceId"),
+		SchemaId:   r.URL.Query().Get(":schemaId"),
+		WithSchema: withSchema == "1",
+	}
+	resp, _ := core.ServiceAPI.GetSchemaRevisions(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (this *SchemaService) DiffSchemaRevisions(w http.ResponseWriter, r *http.Request) {
+	request := &pb.DiffSchemaRevisionsRequest{
+		ServiceId: r.URL.Query().Get(":serviceId"),
+		SchemaId:  r.URL.Query().Get(":schemaId"),
+		Base:      r.URL.Query().Get("base"),
+		Target:    r.URL.Query().Get("target"),
+	}
+	resp, _ := core.ServiceAPI.DiffSchemaRevisions(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (this *SchemaService) SearchSchemas(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	request := &pb.SearchSchemasRequest{
+		Keyword:     query.Get("keyword"),
+		Method:      query.Get("method"),
+		Path:        query.Get("path"),
+		OperationId: query.Get("operationId"),
+		Tag:         query.Get("tag"),
+		Model:       query.Get("model"),
+	}
+	resp, _ := core.ServiceAPI.SearchSchemas(r.Context(), request)
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (this *SchemaService) RollbackSchema(w http.ResponseWriter, r *http.Request) {
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+
+	request := &pb.RollbackSchemaRequest{}
+	err = json.Unmarshal(message, request)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	request.ServiceId = r.URL.Query().Get(":serviceId")
+	request.SchemaId = r.URL.Query().Get(":schemaId")
+	resp, _ := core.ServiceAPI.RollbackSchema(r.Context(), request)
+	writeSchemaWarning(w, r)
 	controller.WriteResponse(w, resp.Response, nil)
 }
 
@@ -94,9 +192,16 @@ func (this *SchemaService) ModifySchemas(w http.ResponseWriter, r *http.Request)
 	}
 	request.ServiceId = serviceId
 	resp, err := core.ServiceAPI.ModifySchemas(r.Context(), request)
+	writeSchemaWarning(w, r)
 	controller.WriteResponse(w, resp.Response, nil)
 }
 
+func writeSchemaWarning(w http.ResponseWriter, r *http.Request) {
+	if warn, _ := r.Context().Value(serviceUtil.CTX_RESPONSE_SCHEMA_WARN).(string); len(warn) > 0 {
+		w.Header().Set(serviceUtil.HEADER_WARNING, serviceUtil.WarningOf(warn))
+	}
+}
+
 func (this *SchemaService) DeleteSchemas(w http.ResponseWriter, r *http.Request) {
 	request := &pb.DeleteSchemaRequest{
 		ServiceId: r.URL.Query().Get(":serviceId"),
@@ -0,0 +1,137 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package v4
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/rest"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/rest/controller"
+	"io/ioutil"
+	"net/http"
+)
+
+type TenantService struct {
+	//
+}
+
+func (this *TenantService) URLPatterns() []rest.Route {
+	return []rest.Route{
+		{rest.HTTP_METHOD_GET, "/v4/domains", this.GetDomains},
+		{rest.HTTP_METHOD_POST, "/v4/domains", this.CreateDomain},
+		{rest.HTTP_METHOD_DELETE, "/v4/domains/:domain", this.DeleteDomain},
+		{rest.HTTP_METHOD_GET, "/v4/projects", this.GetProjects},
+		{rest.HTTP_METHOD_POST, "/v4/projects", this.CreateProject},
+		{rest.HTTP_METHOD_DELETE, "/v4/projects/:project", this.DeleteProject},
+	}
+}
+
+func (this *TenantService) GetDomains(w http.ResponseWriter, r *http.Request) {
+	if !checkAdmin(w, r) {
+		return
+	}
+	resp, _ := core.ServiceAPI.GetDomains(r.Context(), &pb.GetDomainsRequest{})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (this *TenantService) CreateDomain(w http.ResponseWriter, r *http.Request) {
+	if !checkAdmin(w, r) {
+		return
+	}
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	domain := &pb.Domain{}
+	err = json.Unmarshal(message, domain)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := core.ServiceAPI.CreateDomain(r.Context(), &pb.CreateDomainRequest{Domain: domain})
+	controller.WriteResponse(w, resp.Response, nil)
+}
+
+func (this *TenantService) DeleteDomain(w http.ResponseWriter, r *http.Request) {
+	if !checkAdmin(w, r) {
+		return
+	}
+	force := r.URL.Query().Get("force")
+	b, ok := trueOrFalse[force]
+	if force != "" && !ok {
+		controller.WriteError(w, scerr.ErrInvalidParams, "parameter force must be false or true")
+		return
+	}
+	resp, _ := core.ServiceAPI.DeleteDomain(r.Context(), &pb.DeleteDomainRequest{
+		Domain: r.URL.Query().Get(":domain"),
+		Force:  b,
+	})
+	controller.WriteResponse(w, resp.Response, nil)
+}
+
+func (this *TenantService) GetProjects(w http.ResponseWriter, r *http.Request) {
+	resp, _ := core.ServiceAPI.GetProjects(r.Context(), &pb.GetProjectsRequest{
+		Domain: util.ParseDomain(r.Context()),
+	})
+	respInternal := resp.Response
+	resp.Response = nil
+	controller.WriteResponse(w, respInternal, resp)
+}
+
+func (this *TenantService) CreateProject(w http.ResponseWriter, r *http.Request) {
+	message, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		util.Logger().Error("body err", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	project := &pb.Project{}
+	err = json.Unmarshal(message, project)
+	if err != nil {
+		util.Logger().Error("Unmarshal error", err)
+		controller.WriteError(w, scerr.ErrInvalidParams, err.Error())
+		return
+	}
+	resp, _ := core.ServiceAPI.CreateProject(r.Context(), &pb.CreateProjectRequest{
+		Domain:  util.ParseDomain(r.Context()),
+		Project: project,
+	})
+	controller.WriteResponse(w, resp.Response, nil)
+}
+
+func (this *TenantService) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	force := r.URL.Query().Get("force")
+	b, ok := trueOrFalse[force]
+	if force != "" && !ok {
+		controller.WriteError(w, scerr.ErrInvalidParams, "parameter force must be false or true")
+		return
+	}
+	resp, _ := core.ServiceAPI.DeleteProject(r.Context(), &pb.DeleteProjectRequest{
+		Domain:  util.ParseDomain(r.Context()),
+		Project: r.URL.Query().Get(":project"),
+		Force:   b,
+	})
+	controller.WriteResponse(w, resp.Response, nil)
+}
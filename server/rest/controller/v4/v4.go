@@ -33,4 +33,7 @@ func initRouter() {
 	roa.RegisterServent(&RuleService{})
 	roa.RegisterServent(&MicroServiceInstanceService{})
 	roa.RegisterServent(&WatchService{})
+	roa.RegisterServent(&QuotaService{})
+	roa.RegisterServent(&AuditLogService{})
+	roa.RegisterServent(&TenantService{})
 }
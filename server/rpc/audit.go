@@ -0,0 +1,89 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package rpc
+
+import (
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+	"net"
+	"strings"
+	"time"
+)
+
+var mutatingPrefixes = []string{"create", "delete", "update", "add", "register", "unregister", "modify", "rotate"}
+
+// isAudited returns true if the grpc method is mutating, e.g.
+// '/com.huawei.paas.cse.serviceregistry.api.ServiceCtrl/create'
+func isAudited(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range mutatingPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func newAuditEntry(ctx context.Context, fullMethod string, req, resp interface{}, err error,
+	changes *auditlog.Changes) *auditlog.Entry {
+	entry := &auditlog.Entry{
+		Timestamp:  time.Now().Unix(),
+		Protocol:   auditlog.PROTOCOL_GRPC,
+		Operator:   util.ParseOperator(ctx),
+		Method:     fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+		API:        fullMethod,
+		Domain:     util.ParseDomain(ctx),
+		Project:    util.ParseProject(ctx),
+		StatusCode: "200",
+		Changes:    changes.List(),
+	}
+	if len(entry.Operator) == 0 {
+		if serviceId, _ := ctx.Value(identity.CTX_SERVICE).(string); len(serviceId) > 0 {
+			entry.Operator = "service:" + serviceId
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.RemoteIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(entry.RemoteIP); err == nil {
+			entry.RemoteIP = host
+		}
+	}
+	if r, ok := req.(interface {
+		GetServiceId() string
+	}); ok {
+		entry.ServiceId = r.GetServiceId()
+	}
+	if r, ok := req.(interface {
+		GetInstanceId() string
+	}); ok {
+		entry.InstanceId = r.GetInstanceId()
+	}
+	// the status code is the same as the REST API
+	if r, ok := resp.(interface {
+		GetResponse() *pb.Response
+	}); ok && r.GetResponse().GetCode() != pb.Response_SUCCESS {
+		entry.StatusCode = fmt.Sprint(r.GetResponse().GetCode() / 1000)
+	} else if err != nil {
+		entry.StatusCode = "500"
+	}
+	return entry
+}
@@ -20,9 +20,17 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/rpc"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	"github.com/apache/incubator-servicecomb-service-center/server/core"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
+	"github.com/apache/incubator-servicecomb-service-center/server/rbac"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"net"
 )
 
@@ -36,7 +44,7 @@ func (srv *Server) Serve() error {
 }
 
 func NewServer(ipAddr string) (_ *Server, err error) {
-	var grpcSrv *grpc.Server
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(unaryInterceptor)}
 	if core.ServerInfo.Config.SslEnabled {
 		tlsConfig, err := plugin.Plugins().TLS().ServerConfig()
 		if err != nil {
@@ -44,10 +52,12 @@ func NewServer(ipAddr string) (_ *Server, err error) {
 			return nil, err
 		}
 		creds := credentials.NewTLS(tlsConfig)
-		grpcSrv = grpc.NewServer(grpc.Creds(creds))
-	} else {
-		grpcSrv = grpc.NewServer()
+		opts = append(opts, grpc.Creds(creds))
 	}
+	if identity.Enabled() || rbac.Enabled() {
+		opts = append(opts, grpc.StreamInterceptor(authStreamInterceptor))
+	}
+	grpcSrv := grpc.NewServer(opts...)
 
 	rpc.RegisterServer(grpcSrv)
 
@@ -62,3 +72,98 @@ func NewServer(ipAddr string) (_ *Server, err error) {
 		innerListener: ls,
 	}, nil
 }
+
+// authStream overrides the context of the stream with the authenticated one
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// unaryInterceptor authenticates the call, and records the mutating calls
+// in the audit log
+func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		util.Logger().Errorf(err, "authenticate grpc request failed, %s", info.FullMethod)
+		return nil, err
+	}
+	if !isAudited(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	changes := &auditlog.Changes{}
+	ctx = auditlog.WithChanges(ctx, changes)
+	resp, err := handler(ctx, req)
+	plugin.Plugins().AuditLog().RecordEntry(newAuditEntry(ctx, info.FullMethod, req, resp, err, changes))
+	return resp, err
+}
+
+func authStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		util.Logger().Errorf(err, "authenticate grpc stream failed, %s", info.FullMethod)
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate binds the identity of the client certificate if enabled, then
+// the account of the bearer token in the 'authorization' metadata if the rbac
+// auth plugin is enabled
+func authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	var err error
+	if identity.Enabled() {
+		ctx, err = withPeerIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !rbac.Enabled() {
+		return ctx, nil
+	}
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["authorization"]) > 0 {
+		authorization = md["authorization"][0]
+	}
+	domain, admin := util.ParseDomain(ctx), util.IsAdmin(ctx)
+	ctx, err = rbac.IdentifyMethod(ctx, fullMethod, authorization)
+	if err == nil {
+		if identity.Enabled() && !admin && util.ParseDomain(ctx) != domain {
+			return nil, grpc.Errorf(codes.PermissionDenied, "the domain of the client certificate is "+domain+
+				", but the call is authenticated to domain "+util.ParseDomain(ctx))
+		}
+		return ctx, nil
+	}
+	if e, ok := err.(*scerr.Error); ok {
+		switch e.Code {
+		case scerr.ErrUnauthorized:
+			return nil, grpc.Errorf(codes.Unauthenticated, e.Detail)
+		case scerr.ErrForbidden:
+			return nil, grpc.Errorf(codes.PermissionDenied, e.Detail)
+		}
+	}
+	return nil, grpc.Errorf(codes.Internal, err.Error())
+}
+
+// withPeerIdentity binds the identity of the client certificate to the context
+func withPeerIdentity(ctx context.Context) (context.Context, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, grpc.Errorf(codes.Unauthenticated, "unknown peer")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, grpc.Errorf(codes.Unauthenticated, "client certificate is required")
+	}
+	id, err := identity.FromCertificates(tlsInfo.State.PeerCertificates)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, err.Error())
+	}
+	return identity.WithIdentity(ctx, id), nil
+}
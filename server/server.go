@@ -145,6 +145,9 @@ func (s *ServiceCenterServer) initialize() {
 
 	// auto compact backend
 	s.autoCompactBackend()
+
+	// re-encrypt the values in backend
+	s.autoReencryptBackend()
 }
 
 func (s *ServiceCenterServer) autoCompactBackend() {
@@ -179,6 +182,42 @@ func (s *ServiceCenterServer) autoCompactBackend() {
 	})
 }
 
+func (s *ServiceCenterServer) autoReencryptBackend() {
+	if !core.ServerInfo.Config.EncryptEnabled || len(core.ServerInfo.Config.EncryptReencryptInterval) == 0 {
+		return
+	}
+	interval, err := time.ParseDuration(core.ServerInfo.Config.EncryptReencryptInterval)
+	if err != nil || interval <= 0 {
+		util.Logger().Errorf(err, "invalid re-encrypt interval %s, reset to default interval 24h",
+			core.ServerInfo.Config.EncryptReencryptInterval)
+		interval = 24 * time.Hour
+	}
+	s.goroutine.Do(func(ctx context.Context) {
+		util.Logger().Infof("enabled the background re-encryption, run once every %s", interval)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+				lock, err := mux.Try(mux.GLOBAL_LOCK)
+				if lock == nil {
+					util.Logger().Warnf(err, "can not re-encrypt backend by this service center instance now")
+					continue
+				}
+
+				report, err := backend.Reencrypt(ctx)
+				if err != nil {
+					util.Logger().Errorf(err, "re-encrypt backend failed")
+				}
+				util.Logger().Infof("re-encrypt backend finished, checked: %d, re-encrypted: %d, failed: %d",
+					report.Checked, report.Reencrypted, report.Failed)
+
+				lock.Unlock()
+			}
+		}
+	})
+}
+
 func (s *ServiceCenterServer) startNotifyService() {
 	s.notifyService.Config = nf.NotifyServiceConfig{
 		AddTimeout:    30 * time.Second,
@@ -0,0 +1,101 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"golang.org/x/net/context"
+)
+
+// RotateServiceCredential replaces the owner credential of the service, the
+// request must present the current credential unless it is sent by an
+// administrator. Only the administrators can set the credential of the service
+// registered without credential
+func (s *MicroServiceService) RotateServiceCredential(ctx context.Context, in *pb.RotateServiceCredentialRequest) (*pb.RotateServiceCredentialResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "rotate service credential failed, serviceId is %s: invalid parameters.", in.ServiceId)
+		return &pb.RotateServiceCredentialResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+	if !serviceUtil.ServiceOwnershipEnabled() {
+		util.Logger().Errorf(nil, "rotate service credential failed, serviceId is %s: service ownership is disabled.", in.ServiceId)
+		return &pb.RotateServiceCredentialResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Service ownership is disabled."),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
+		util.Logger().Errorf(nil, "rotate service credential failed, serviceId is %s: service not exist.", in.ServiceId)
+		return &pb.RotateServiceCredentialResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
+		}, nil
+	}
+	if err := serviceUtil.CheckServiceCredentialRotation(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "rotate service credential failed, serviceId is %s: check owner failed.", in.ServiceId)
+		return &pb.RotateServiceCredentialResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
+
+	credential := in.Credential
+	if len(credential) == 0 {
+		credential, err = serviceUtil.NewServiceCredential()
+		if err != nil {
+			util.Logger().Errorf(err, "rotate service credential failed, serviceId is %s: generate credential failed.", in.ServiceId)
+			return &pb.RotateServiceCredentialResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+	}
+
+	serviceKey := apt.GenerateServiceKey(domainProject, in.ServiceId)
+	resp, err := backend.Registry().TxnWithCmp(ctx,
+		[]registry.PluginOp{serviceUtil.ServiceCredentialOp(domainProject, in.ServiceId, credential)},
+		[]registry.CompareOp{registry.OpCmp(
+			registry.CmpVer(util.StringToBytesWithNoCopy(serviceKey)),
+			registry.CMP_NOT_EQUAL, 0)},
+		nil)
+	if err != nil {
+		util.Logger().Errorf(err, "rotate service credential failed, serviceId is %s: commit data into etcd failed.", in.ServiceId)
+		return &pb.RotateServiceCredentialResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !resp.Succeeded {
+		util.Logger().Errorf(nil, "rotate service credential failed, serviceId is %s: service does not exist.", in.ServiceId)
+		return &pb.RotateServiceCredentialResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
+		}, nil
+	}
+
+	util.Logger().Infof("rotate service credential successful, serviceId is %s. operator: %s",
+		in.ServiceId, util.ParseOperator(ctx))
+	return &pb.RotateServiceCredentialResponse{
+		Response:   pb.CreateResponse(pb.Response_SUCCESS, "Rotate service credential successfully."),
+		Credential: credential,
+	}, nil
+}
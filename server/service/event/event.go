@@ -26,4 +26,5 @@ func init() {
 	backend.AddEventHandler(NewRuleEventHandler())
 	backend.AddEventHandler(NewTagEventHandler())
 	backend.AddEventHandler(NewDependencyEventHandler())
+	backend.AddEventHandler(NewSchemaEventHandler())
 }
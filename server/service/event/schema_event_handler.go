@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package event
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/async"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"golang.org/x/net/context"
+)
+
+type SchemaIndexTask struct {
+	key string
+	err error
+
+	DomainProject string
+	ServiceId     string
+	SchemaId      string
+}
+
+func (apt *SchemaIndexTask) Key() string {
+	return apt.key
+}
+
+func (apt *SchemaIndexTask) Do(ctx context.Context) error {
+	apt.err = serviceUtil.RefreshSchemaIndex(ctx, apt.DomainProject, apt.ServiceId, apt.SchemaId)
+	if apt.err != nil {
+		util.Logger().Errorf(apt.err, "refresh search index of schema %s/%s failed", apt.ServiceId, apt.SchemaId)
+	}
+	return apt.err
+}
+
+func (apt *SchemaIndexTask) Err() error {
+	return apt.err
+}
+
+type SchemaEventHandler struct {
+}
+
+func (h *SchemaEventHandler) Type() backend.StoreType {
+	return backend.SCHEMA
+}
+
+func (h *SchemaEventHandler) OnEvent(evt backend.KvEvent) {
+	kv := evt.Object.(*mvccpb.KeyValue)
+	serviceId, schemaId, domainProject, _ := pb.GetInfoFromServiceSchemaKV(kv)
+	if len(schemaId) == 0 {
+		return
+	}
+
+	if evt.Type == pb.EVT_DELETE {
+		serviceUtil.SchemaSearchIndex().RemoveSchema(domainProject, serviceId, schemaId)
+	}
+	// the event only carries the content address, the task loads the latest
+	// content, and the task of the same schema overrides the pending one
+	async.Service().Add(context.Background(),
+		NewSchemaIndexAsyncTask(domainProject, serviceId, schemaId))
+}
+
+func NewSchemaEventHandler() *SchemaEventHandler {
+	return &SchemaEventHandler{}
+}
+
+func NewSchemaIndexAsyncTask(domainProject, serviceId, schemaId string) *SchemaIndexTask {
+	return &SchemaIndexTask{
+		key:           "SchemaIndexAsyncTask_" + domainProject + "/" + serviceId + "/" + schemaId,
+		DomainProject: domainProject,
+		ServiceId:     serviceId,
+		SchemaId:      schemaId,
+	}
+}
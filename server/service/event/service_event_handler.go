@@ -17,6 +17,7 @@
 package event
 
 import (
+	"encoding/json"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
@@ -35,12 +36,12 @@ func (h *ServiceEventHandler) Type() backend.StoreType {
 
 func (h *ServiceEventHandler) OnEvent(evt backend.KvEvent) {
 	action := evt.Type
-	if action != pb.EVT_CREATE && action != pb.EVT_INIT {
-		return
-	}
-
 	kv := evt.Object.(*mvccpb.KeyValue)
 	serviceId, domainProject, data := pb.GetInfoFromSvcKV(kv)
+	if action == pb.EVT_DELETE {
+		serviceUtil.SchemaSearchIndex().RemoveService(domainProject, serviceId)
+		return
+	}
 	if data == nil {
 		util.Logger().Errorf(nil,
 			"unmarshal service file failed, service %s [%s] event, data is nil",
@@ -48,6 +49,17 @@ func (h *ServiceEventHandler) OnEvent(evt backend.KvEvent) {
 		return
 	}
 
+	var ms pb.MicroService
+	if err := json.Unmarshal(data, &ms); err != nil {
+		util.Logger().Errorf(err, "unmarshal service %s file failed", serviceId)
+		return
+	}
+	serviceUtil.SchemaSearchIndex().SetServicePaths(domainProject, serviceId, ms.Paths)
+
+	if action != pb.EVT_CREATE && action != pb.EVT_INIT {
+		return
+	}
+
 	newDomain := domainProject[:strings.Index(domainProject, "/")]
 	newProject := domainProject[strings.Index(domainProject, "/")+1:]
 	err := serviceUtil.NewDomainProject(context.Background(), newDomain, newProject)
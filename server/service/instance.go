@@ -25,6 +25,8 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
@@ -32,6 +34,7 @@ import (
 	"golang.org/x/net/context"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -80,6 +83,9 @@ func (s *InstanceService) preProcessRegisterInstance(ctx context.Context, instan
 	if service == nil || err != nil {
 		return scerr.NewError(scerr.ErrServiceNotExists, "Invalid 'serviceId' in request body.")
 	}
+	if pb.IsRetired(service) {
+		return scerr.NewError(scerr.ErrServiceRetired, "Can not register instance of a retired service version.")
+	}
 	instance.Version = service.Version
 	return nil
 }
@@ -93,6 +99,18 @@ func (s *InstanceService) Register(ctx context.Context, in *pb.RegisterInstanceR
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
 		}, nil
 	}
+	if err := identity.CheckService(ctx, in.Instance.ServiceId); err != nil {
+		util.Logger().Errorf(err, "register instance failed, operator %s.", remoteIP)
+		return &pb.RegisterInstanceResponse{
+			Response: pb.CreateResponse(scerr.ErrPermissionDeny, err.Error()),
+		}, nil
+	}
+	if err := serviceUtil.CheckServiceOwner(ctx, util.ParseDomainProject(ctx), in.Instance.ServiceId); err != nil {
+		util.Logger().Errorf(err, "register instance failed, operator %s.", remoteIP)
+		return &pb.RegisterInstanceResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	instance := in.GetInstance()
 	instanceFlag := util.StringJoin([]string{instance.ServiceId, instance.HostName}, "/")
@@ -212,6 +230,7 @@ func (s *InstanceService) Register(ctx context.Context, in *pb.RegisterInstanceR
 				instanceFlag, instanceId, remoteIP)
 		}
 	}
+	auditlog.AddChange(ctx, auditlog.ENTITY_INSTANCE, instance.ServiceId, instanceId, nil, instanceAuditOf(instance))
 	util.Logger().Infof("register instance successful service %s, instanceId %s, operator %s.",
 		instanceFlag, instanceId, remoteIP)
 	return &pb.RegisterInstanceResponse{
@@ -229,21 +248,33 @@ func (s *InstanceService) Unregister(ctx context.Context, in *pb.UnregisterInsta
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
 		}, nil
 	}
-
+	if err := identity.CheckService(ctx, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "unregister instance failed, operator %s.", remoteIP)
+		return &pb.UnregisterInstanceResponse{
+			Response: pb.CreateResponse(scerr.ErrPermissionDeny, err.Error()),
+		}, nil
+	}
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "unregister instance failed, operator %s.", remoteIP)
+		return &pb.UnregisterInstanceResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
+
 	serviceId := in.ServiceId
 	instanceId := in.InstanceId
 
 	instanceFlag := util.StringJoin([]string{serviceId, instanceId}, "/")
 
-	isExist, err := serviceUtil.InstanceExistById(ctx, domainProject, serviceId, instanceId)
+	instance, err := serviceUtil.GetInstance(ctx, domainProject, serviceId, instanceId)
 	if err != nil {
 		util.Logger().Errorf(err, "unregister instance failed, instance %s, operator %s: query instance failed.", instanceFlag, remoteIP)
 		return &pb.UnregisterInstanceResponse{
 			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
 		}, err
 	}
-	if !isExist {
+	if instance == nil {
 		util.Logger().Errorf(nil, "unregister instance failed, instance %s, operator %s: instance not exist.", instanceFlag, remoteIP)
 		return &pb.UnregisterInstanceResponse{
 			Response: pb.CreateResponse(scerr.ErrInstanceNotExists, "Service instance does not exist."),
@@ -263,6 +294,7 @@ func (s *InstanceService) Unregister(ctx context.Context, in *pb.UnregisterInsta
 		}, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_INSTANCE, serviceId, instanceId, instanceAuditOf(instance), nil)
 	util.Logger().Infof("unregister instance successful isntance %s, operator %s.", instanceFlag, remoteIP)
 	return &pb.UnregisterInstanceResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Unregister service instance successfully."),
@@ -294,8 +326,20 @@ func (s *InstanceService) Heartbeat(ctx context.Context, in *pb.HeartbeatRequest
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
 		}, nil
 	}
+	if err := identity.CheckService(ctx, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "heartbeat failed, operator %s.", remoteIP)
+		return &pb.HeartbeatResponse{
+			Response: pb.CreateResponse(scerr.ErrPermissionDeny, err.Error()),
+		}, nil
+	}
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "heartbeat failed, operator %s.", remoteIP)
+		return &pb.HeartbeatResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 	instanceFlag := util.StringJoin([]string{in.ServiceId, in.InstanceId}, "/")
 
 	_, ttl, err, isInnerErr := serviceUtil.HeartbeatUtil(ctx, domainProject, in.ServiceId, in.InstanceId)
@@ -330,6 +374,14 @@ func (s *InstanceService) HeartbeatSet(ctx context.Context, in *pb.HeartbeatSetR
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Request format invalid."),
 		}, nil
 	}
+	for _, heartbeatElement := range in.Instances {
+		if err := identity.CheckService(ctx, heartbeatElement.ServiceId); err != nil {
+			util.Logger().Errorf(err, "heartbeats failed.")
+			return &pb.HeartbeatSetResponse{
+				Response: pb.CreateResponse(scerr.ErrPermissionDeny, err.Error()),
+			}, nil
+		}
+	}
 	domainProject := util.ParseDomainProject(ctx)
 
 	heartBeatCount := len(in.Instances)
@@ -384,6 +436,12 @@ func getHeartbeatFunc(ctx context.Context, domainProject string, instancesHbRst
 			InstanceId: element.InstanceId,
 			ErrMessage: "",
 		}
+		if ownerErr := serviceUtil.CheckServiceOwner(ctx, domainProject, element.ServiceId); ownerErr != nil {
+			hbRst.ErrMessage = ownerErr.Error()
+			util.Logger().Errorf(ownerErr, "heartbeat set failed, %s/%s", element.ServiceId, element.InstanceId)
+			instancesHbRst <- hbRst
+			return
+		}
 		_, _, err, _ := serviceUtil.HeartbeatUtil(ctx, domainProject, element.ServiceId, element.InstanceId)
 		if err != nil {
 			hbRst.ErrMessage = err.Error()
@@ -530,11 +588,10 @@ func (s *InstanceService) Find(ctx context.Context, in *pb.FindInstancesRequest)
 		// it means the shared micro-services must be the same env with SC.
 		provider.Environment = apt.Service.Environment
 		findFlag += "(provider is shared service in " + provider.Environment + " environment)"
-	} else {
-		// provider is not a shared micro-service,
-		// only allow shared micro-service instances found in different domains.
-		util.SetTargetDomainProject(ctx, util.ParseDomain(ctx), util.ParseProject(ctx))
-		provider.Tenant = util.ParseTargetDomainProject(ctx)
+	} else if provider.Tenant != domainProject {
+		// provider is not a shared micro-service but in the different domain,
+		// it must be exported to the domain of consumer, see getInstancePreCheck.
+		findFlag += "(provider is in " + provider.Tenant + " domain)"
 	}
 
 	// cache
@@ -549,6 +606,7 @@ func (s *InstanceService) Find(ctx context.Context, in *pb.FindInstancesRequest)
 				instances = instances[:0]
 			}
 			util.SetContext(ctx, serviceUtil.CTX_RESPONSE_REVISION, item.Rev)
+			s.setDeprecationWarning(ctx, provider.Tenant, item.Instances)
 			return &pb.FindInstancesResponse{
 				Response:  pb.CreateResponse(pb.Response_SUCCESS, "Query service instances successfully."),
 				Instances: instances,
@@ -565,6 +623,17 @@ func (s *InstanceService) Find(ctx context.Context, in *pb.FindInstancesRequest)
 		}, err
 	}
 
+	if len(ids) == 0 && !apt.IsShared(provider) {
+		// the provider may be exported from the other environments
+		ids, err = serviceUtil.FindExportedServiceIds(ctx, in.VersionRule, provider)
+		if err != nil {
+			util.Logger().Errorf(err, "find instance failed, %s: get exported providers failed.", findFlag)
+			return &pb.FindInstancesResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+	}
+
 	if len(ids) == 0 {
 		mes := fmt.Sprintf("provider not exist, %s", findFlag)
 		util.Logger().Errorf(nil, "find instance failed, %s", mes)
@@ -616,12 +685,43 @@ func (s *InstanceService) Find(ctx context.Context, in *pb.FindInstancesRequest)
 		Rev:       rev,
 	})
 	util.SetContext(ctx, serviceUtil.CTX_RESPONSE_REVISION, rev)
+	s.setDeprecationWarning(ctx, provider.Tenant, instances)
 	return &pb.FindInstancesResponse{
 		Response:  pb.CreateResponse(pb.Response_SUCCESS, "Query service instances successfully."),
 		Instances: instances,
 	}, nil
 }
 
+// setDeprecationWarning puts the deprecation notices of the providers
+// which the instances belong to into the context.
+func (s *InstanceService) setDeprecationWarning(ctx context.Context, domainProject string, instances []*pb.MicroServiceInstance) {
+	var warnings []string
+	checked := make(map[string]struct{}, len(instances))
+	for _, instance := range instances {
+		if _, ok := checked[instance.ServiceId]; ok {
+			continue
+		}
+		checked[instance.ServiceId] = struct{}{}
+
+		service, err := serviceUtil.GetService(ctx, domainProject, instance.ServiceId)
+		if service == nil || err != nil || !pb.IsDeprecated(service) {
+			continue
+		}
+		warning := fmt.Sprintf("%s/%s/%s is %s", service.AppId, service.ServiceName, service.Version,
+			strings.ToLower(service.Deprecation.State))
+		if len(service.Deprecation.SunsetDate) > 0 {
+			warning += ", sunset on " + service.Deprecation.SunsetDate
+		}
+		if len(service.Deprecation.Replacement) > 0 {
+			warning += ", use " + service.Deprecation.Replacement + " instead"
+		}
+		warnings = append(warnings, warning)
+	}
+	if len(warnings) > 0 {
+		util.SetContext(ctx, serviceUtil.CTX_RESPONSE_DEPRECATION, strings.Join(warnings, "; "))
+	}
+}
+
 func (s *InstanceService) UpdateStatus(ctx context.Context, in *pb.UpdateInstanceStatusRequest) (*pb.UpdateInstanceStatusResponse, error) {
 	domainProject := util.ParseDomainProject(ctx)
 	updateStatusFlag := util.StringJoin([]string{in.ServiceId, in.InstanceId, in.Status}, "/")
@@ -631,6 +731,18 @@ func (s *InstanceService) UpdateStatus(ctx context.Context, in *pb.UpdateInstanc
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
 		}, nil
 	}
+	if err := identity.CheckService(ctx, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update instance status failed, %s.", updateStatusFlag)
+		return &pb.UpdateInstanceStatusResponse{
+			Response: pb.CreateResponse(scerr.ErrPermissionDeny, err.Error()),
+		}, nil
+	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update instance status failed, %s.", updateStatusFlag)
+		return &pb.UpdateInstanceStatusResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	instance, err := serviceUtil.GetInstance(ctx, domainProject, in.ServiceId, in.InstanceId)
 	if err != nil {
@@ -646,6 +758,7 @@ func (s *InstanceService) UpdateStatus(ctx context.Context, in *pb.UpdateInstanc
 		}, nil
 	}
 
+	before := *instance
 	instance.Status = in.Status
 
 	if err := serviceUtil.UpdateInstance(ctx, domainProject, instance); err != nil {
@@ -659,6 +772,8 @@ func (s *InstanceService) UpdateStatus(ctx context.Context, in *pb.UpdateInstanc
 		return resp, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_INSTANCE, in.ServiceId, in.InstanceId,
+		instanceAuditOf(&before), instanceAuditOf(instance))
 	util.Logger().Infof("update instance status successful: %s.", updateStatusFlag)
 	return &pb.UpdateInstanceStatusResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Update service instance status successfully."),
@@ -674,6 +789,18 @@ func (s *InstanceService) UpdateInstanceProperties(ctx context.Context, in *pb.U
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
 		}, nil
 	}
+	if err := identity.CheckService(ctx, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update instance properties failed, %s.", instanceFlag)
+		return &pb.UpdateInstancePropsResponse{
+			Response: pb.CreateResponse(scerr.ErrPermissionDeny, err.Error()),
+		}, nil
+	}
+	if err := serviceUtil.CheckServiceOwner(ctx, util.ParseDomainProject(ctx), in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update instance properties failed, %s.", instanceFlag)
+		return &pb.UpdateInstancePropsResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	instance, err := serviceUtil.GetInstance(ctx, domainProject, in.ServiceId, in.InstanceId)
 	if err != nil {
@@ -689,6 +816,7 @@ func (s *InstanceService) UpdateInstanceProperties(ctx context.Context, in *pb.U
 		}, nil
 	}
 
+	before := *instance
 	instance.Properties = in.Properties
 
 	if err := serviceUtil.UpdateInstance(ctx, domainProject, instance); err != nil {
@@ -702,6 +830,8 @@ func (s *InstanceService) UpdateInstanceProperties(ctx context.Context, in *pb.U
 		return resp, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_INSTANCE, in.ServiceId, in.InstanceId,
+		instanceAuditOf(&before), instanceAuditOf(instance))
 	util.Logger().Infof("update instance properties successful: %s.", instanceFlag)
 	return &pb.UpdateInstancePropsResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Update service instance properties successfully."),
@@ -743,3 +873,14 @@ func (s *InstanceService) ClusterHealth(ctx context.Context) (*pb.GetInstancesRe
 		Instances: instances,
 	}, nil
 }
+
+// instanceAuditOf returns the instance recorded in the audit log with the
+// properties encrypted at rest redacted, nil if the instance does not exist
+func instanceAuditOf(instance *pb.MicroServiceInstance) interface{} {
+	if instance == nil {
+		return nil
+	}
+	audit := *instance
+	audit.Properties = backend.EncryptPolicyInUse().RedactProperties(backend.ENCRYPT_STORE_INSTANCE, instance.Properties)
+	return &audit
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/server/core"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/identity"
 	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -529,6 +530,52 @@ var _ = Describe("'Instance' service", func() {
 				Expect(resp.Response.Code).ToNot(Equal(pb.Response_SUCCESS))
 			})
 		})
+
+		Context("when the client is bound to a service", func() {
+			It("should be failed to access the instances of the other service", func() {
+				ctx := identity.WithIdentity(getContext(), &identity.Identity{
+					Domain:    "default",
+					Project:   "default",
+					ServiceId: serviceId,
+				})
+				resp, err := instanceResource.Heartbeat(ctx, &pb.HeartbeatRequest{
+					ServiceId:  serviceId,
+					InstanceId: instanceId1,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				ctx = identity.WithIdentity(getContext(), &identity.Identity{
+					Domain:    "default",
+					Project:   "default",
+					ServiceId: "other-service",
+				})
+				resp, err = instanceResource.Heartbeat(ctx, &pb.HeartbeatRequest{
+					ServiceId:  serviceId,
+					InstanceId: instanceId1,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				respSet, err := instanceResource.HeartbeatSet(ctx, &pb.HeartbeatSetRequest{
+					Instances: []*pb.HeartbeatSetElement{
+						{
+							ServiceId:  serviceId,
+							InstanceId: instanceId1,
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respSet.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				respUnregister, err := instanceResource.Unregister(ctx, &pb.UnregisterInstanceRequest{
+					ServiceId:  serviceId,
+					InstanceId: instanceId2,
+				})
+				Expect(err).To(BeNil())
+				Expect(respUnregister.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+			})
+		})
 	})
 
 	Describe("execute 'clusterHealth' operartion", func() {
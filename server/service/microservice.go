@@ -26,6 +26,7 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
@@ -62,8 +63,17 @@ func (s *MicroServiceService) Create(ctx context.Context, in *pb.CreateServiceRe
 		return rsp, err
 	}
 
+	// the tags, rules and instances are created as the owner of the new service
+	if len(rsp.Credential) > 0 {
+		ctx = serviceUtil.SetServiceCredential(util.CloneContext(ctx), rsp.Credential)
+	}
+
 	//create tag,rule,instances
-	return s.CreateServiceEx(ctx, in, rsp.ServiceId)
+	exRsp, err := s.CreateServiceEx(ctx, in, rsp.ServiceId)
+	if exRsp != nil {
+		exRsp.Credential = rsp.Credential
+	}
+	return exRsp, err
 }
 
 func (s *MicroServiceService) CreateServicePri(ctx context.Context, in *pb.CreateServiceRequest) (*pb.CreateServiceResponse, error) {
@@ -83,6 +93,13 @@ func (s *MicroServiceService) CreateServicePri(ctx context.Context, in *pb.Creat
 	}
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckBatchSize(ctx, domainProject, quota.SchemaQuotaType, len(service.Schemas)); err != nil {
+		util.Logger().Errorf(err, "create micro-service failed, %s: too many schemas. operator: %s",
+			serviceFlag, remoteIP)
+		return &pb.CreateServiceResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	serviceKey := &pb.MicroServiceKey{
 		Tenant:      domainProject,
@@ -117,6 +134,22 @@ func (s *MicroServiceService) CreateServicePri(ctx context.Context, in *pb.Creat
 	service.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
 	service.ModTimestamp = service.Timestamp
 
+	// the owner credential is issued if the request does not bring one
+	credential := ""
+	if serviceUtil.ServiceOwnershipEnabled() {
+		credential = in.Credential
+		if len(credential) == 0 {
+			credential, err = serviceUtil.NewServiceCredential()
+			if err != nil {
+				util.Logger().Errorf(err, "create micro-service failed, %s: generate credential failed. operator: %s",
+					serviceFlag, remoteIP)
+				return &pb.CreateServiceResponse{
+					Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+				}, err
+			}
+		}
+	}
+
 	data, err := json.Marshal(service)
 	if err != nil {
 		util.Logger().Errorf(err, "create micro-service failed, %s: json marshal service failed. operator: %s",
@@ -140,6 +173,10 @@ func (s *MicroServiceService) CreateServicePri(ctx context.Context, in *pb.Creat
 		registry.OpCmp(registry.CmpVer(keyBytes), registry.CMP_EQUAL, 0),
 	}
 
+	if len(credential) > 0 {
+		opts = append(opts, serviceUtil.ServiceCredentialOp(domainProject, serviceId, credential))
+	}
+
 	if len(serviceKey.Alias) > 0 {
 		opts = append(opts, registry.OpPut(registry.WithKey(aliasBytes), registry.WithStrValue(serviceId)))
 		uniqueCmpOpts = append(uniqueCmpOpts,
@@ -179,11 +216,13 @@ func (s *MicroServiceService) CreateServicePri(ctx context.Context, in *pb.Creat
 			util.Logger().Errorf(err, "report used quota failed.")
 		}
 	}
+	auditlog.AddChange(ctx, auditlog.ENTITY_SERVICE, serviceId, serviceId, nil, serviceAuditOf(service))
 	util.Logger().Infof("create micro-service successful, %s, serviceId: %s. operator: %s",
 		serviceFlag, service.ServiceId, remoteIP)
 	return &pb.CreateServiceResponse{
-		Response:  pb.CreateResponse(pb.Response_SUCCESS, "Register service successfully."),
-		ServiceId: serviceId,
+		Response:   pb.CreateResponse(pb.Response_SUCCESS, "Register service successfully."),
+		ServiceId:  serviceId,
+		Credential: credential,
 	}, nil
 }
 
@@ -222,6 +261,11 @@ func (s *MicroServiceService) DeleteServicePri(ctx context.Context, serviceId st
 		return pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."), nil
 	}
 
+	if ownerErr := serviceUtil.CheckServiceOwner(ctx, domainProject, serviceId); ownerErr != nil {
+		util.Logger().Errorf(ownerErr, "%s micro-service failed, serviceId is %s: check owner failed.", title, serviceId)
+		return pb.CreateResponseWithSCErr(ownerErr), nil
+	}
+
 	// 强制删除，则与该服务相关的信息删除，非强制删除： 如果作为该被依赖（作为provider，提供服务,且不是只存在自依赖）或者存在实例，则不能删除
 	if !force {
 		dr := serviceUtil.NewProviderDependencyRelation(ctx, domainProject, service)
@@ -289,11 +333,37 @@ func (s *MicroServiceService) DeleteServicePri(ctx context.Context, serviceId st
 	opts = append(opts, registry.OpDel(
 		registry.WithStrKey(apt.GenerateServiceSchemaSummaryKey(domainProject, serviceId, "")),
 		registry.WithPrefix()))
+	opts = append(opts, registry.OpDel(
+		registry.WithStrKey(apt.GenerateServiceSchemaTypeKey(domainProject, serviceId, "")),
+		registry.WithPrefix()))
+	opts = append(opts, registry.OpDel(
+		registry.WithStrKey(util.StringJoin([]string{apt.GetServiceSchemaRevisionRootKey(domainProject), serviceId, ""}, "/")),
+		registry.WithPrefix()))
+	schemaHashes, err := serviceUtil.GetServiceSchemaContentHashes(ctx, domainProject, serviceId)
+	if err != nil {
+		util.Logger().Errorf(err, "%s micro-service failed, serviceId is %s: get schemas failed.", title, serviceId)
+		return pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()), err
+	}
+	releasedHashes := make([]string, 0, len(schemaHashes))
+	for schemaId, hash := range schemaHashes {
+		opts = append(opts, registry.OpDel(
+			registry.WithStrKey(apt.GenerateSchemaRefKey(domainProject, hash, serviceId, schemaId))))
+		releasedHashes = append(releasedHashes, hash)
+	}
+	revisionRefOpts, revisionHashes, err := releaseServiceSchemaRevisionsOpera(ctx, domainProject, serviceId)
+	if err != nil {
+		util.Logger().Errorf(err, "%s micro-service failed, serviceId is %s: get schema revisions failed.", title, serviceId)
+		return pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()), err
+	}
 
 	//删除tags
 	opts = append(opts, registry.OpDel(
 		registry.WithStrKey(apt.GenerateServiceTagKey(domainProject, serviceId))))
 
+	//删除owner credential
+	opts = append(opts, registry.OpDel(
+		registry.WithStrKey(apt.GenerateServiceCredentialKey(domainProject, serviceId))))
+
 	//删除instances
 	opts = append(opts, registry.OpDel(
 		registry.WithStrKey(apt.GenerateInstanceKey(domainProject, serviceId, "")),
@@ -323,8 +393,20 @@ func (s *MicroServiceService) DeleteServicePri(ctx context.Context, serviceId st
 		return pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."), nil
 	}
 
+	// the revisions may be too many to remove in the txn, the contents of
+	// the references failed to remove are only kept longer
+	if len(revisionRefOpts) > 0 {
+		if err := backend.BatchCommit(ctx, revisionRefOpts); err != nil {
+			util.Logger().Errorf(err, "%s micro-service %s, release the schema revisions failed.", title, serviceId)
+		} else {
+			releasedHashes = append(releasedHashes, revisionHashes...)
+		}
+	}
+	serviceUtil.GCSchemaContent(ctx, domainProject, releasedHashes...)
+
 	serviceUtil.RemandServiceQuota(ctx)
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_SERVICE, serviceId, serviceId, serviceAuditOf(service), nil)
 	util.Logger().Infof("%s micro-service successful: serviceId is %s, operator is %s.", title, serviceId, util.GetIPFromContext(ctx))
 	return pb.CreateResponse(pb.Response_SUCCESS, "Unregister service successfully."), nil
 }
@@ -503,6 +585,13 @@ func (s *MicroServiceService) UpdateProperties(ctx context.Context, in *pb.Updat
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update service properties failed, serviceId is %s: check owner failed.", in.ServiceId)
+		return &pb.UpdateServicePropsResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
+	before := *service
 	service.Properties = make(map[string]string)
 	for propertyKey := range in.Properties {
 		service.Properties[propertyKey] = in.Properties[propertyKey]
@@ -537,12 +626,113 @@ func (s *MicroServiceService) UpdateProperties(ctx context.Context, in *pb.Updat
 		}, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_SERVICE, in.ServiceId, in.ServiceId,
+		serviceAuditOf(&before), serviceAuditOf(service))
 	util.Logger().Infof("update service properties successful: serviceId is %s.", in.ServiceId)
 	return &pb.UpdateServicePropsResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "update service successfully."),
 	}, nil
 }
 
+func (s *MicroServiceService) UpdateDeprecation(ctx context.Context, in *pb.UpdateDeprecationRequest) (*pb.UpdateDeprecationResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: invalid parameters.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	key := apt.GenerateServiceKey(domainProject, in.ServiceId)
+	service, err := serviceUtil.GetService(ctx, domainProject, in.ServiceId)
+	if err != nil {
+		util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: query service failed.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+	if service == nil {
+		util.Logger().Errorf(nil, "update service deprecation failed, serviceId is %s: service not exist.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "service does not exist."),
+		}, nil
+	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: check owner failed.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
+	if replacement := in.Deprecation.Replacement; len(replacement) > 0 {
+		replacementId, err := serviceUtil.GetServiceId(ctx, &pb.MicroServiceKey{
+			Tenant:      domainProject,
+			Environment: service.Environment,
+			AppId:       service.AppId,
+			ServiceName: service.ServiceName,
+			Version:     replacement,
+		})
+		if err != nil {
+			util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: query replacement version failed.", in.ServiceId)
+			return &pb.UpdateDeprecationResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+		if len(replacementId) == 0 || replacementId == in.ServiceId {
+			util.Logger().Errorf(nil, "update service deprecation failed, serviceId is %s: invalid replacement version %s.",
+				in.ServiceId, replacement)
+			return &pb.UpdateDeprecationResponse{
+				Response: pb.CreateResponse(scerr.ErrInvalidParams, "Replacement version does not exist."),
+			}, nil
+		}
+	}
+
+	before := *service
+	if len(in.Deprecation.State) == 0 {
+		// empty state means the version is supported again
+		service.Deprecation = nil
+	} else {
+		service.Deprecation = in.Deprecation
+	}
+	service.ModTimestamp = strconv.FormatInt(time.Now().Unix(), 10)
+
+	data, err := json.Marshal(service)
+	if err != nil {
+		util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: json marshal service failed.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+
+	resp, err := backend.Registry().TxnWithCmp(ctx,
+		[]registry.PluginOp{registry.OpPut(registry.WithStrKey(key), registry.WithValue(data))},
+		[]registry.CompareOp{registry.OpCmp(
+			registry.CmpVer(util.StringToBytesWithNoCopy(key)),
+			registry.CMP_NOT_EQUAL, 0)},
+		nil)
+	if err != nil {
+		util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: commit data into etcd failed.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !resp.Succeeded {
+		util.Logger().Errorf(err, "update service deprecation failed, serviceId is %s: service does not exist.", in.ServiceId)
+		return &pb.UpdateDeprecationResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
+		}, nil
+	}
+
+	auditlog.AddChange(ctx, auditlog.ENTITY_SERVICE, in.ServiceId, in.ServiceId,
+		serviceAuditOf(&before), serviceAuditOf(service))
+	util.Logger().Infof("update service deprecation successful: serviceId is %s, state is %s.",
+		in.ServiceId, in.Deprecation.State)
+	return &pb.UpdateDeprecationResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Update service deprecation successfully."),
+	}, nil
+}
+
 func (s *MicroServiceService) Exist(ctx context.Context, in *pb.GetExistenceRequest) (*pb.GetExistenceResponse, error) {
 	domainProject := util.ParseDomainProject(ctx)
 	switch in.Type {
@@ -617,6 +807,16 @@ func (s *MicroServiceService) Exist(ctx context.Context, in *pb.GetExistenceRequ
 				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
 			}, err
 		}
+		if len(schemaSummary) == 0 {
+			content, err := getSchemaContent(ctx, domainProject, in.ServiceId, in.SchemaId)
+			if err != nil {
+				util.Logger().Errorf(err, "schema exist failed, serviceId %s, schemaId %s: get schema failed.", in.ServiceId, in.SchemaId)
+				return &pb.GetExistenceResponse{
+					Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+				}, err
+			}
+			schemaSummary = serviceUtil.CanonicalSchemaSummary(content)
+		}
 		return &pb.GetExistenceResponse{
 			Response: pb.CreateResponse(pb.Response_SUCCESS, "Schema exist."),
 			SchemaId: in.SchemaId,
@@ -735,3 +935,14 @@ func (s *MicroServiceService) isCreateServiceEx(in *pb.CreateServiceRequest) boo
 	}
 	return true
 }
+
+// serviceAuditOf returns the service recorded in the audit log with the
+// properties encrypted at rest redacted, nil if the service does not exist
+func serviceAuditOf(service *pb.MicroService) interface{} {
+	if service == nil {
+		return nil
+	}
+	audit := *service
+	audit.Properties = backend.EncryptPolicyInUse().RedactProperties(backend.ENCRYPT_STORE_SERVICE, service.Properties)
+	return &audit
+}
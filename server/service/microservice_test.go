@@ -17,12 +17,18 @@
 package service_test
 
 import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	"github.com/apache/incubator-servicecomb-service-center/server/core"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
+	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/quota/buildin"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
 	"strconv"
 	"strings"
 )
@@ -1037,6 +1043,567 @@ var _ = Describe("'Micro-service' service", func() {
 		})
 	})
 
+	Describe("execute 'deprecate' operation", func() {
+		var (
+			consumerId, serviceId1, serviceId2 string
+		)
+
+		It("should be passed", func() {
+			respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					ServiceName: "deprecate_consumer",
+					AppId:       "deprecate_appId",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			consumerId = respCreateService.ServiceId
+
+			respCreateService, err = serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					ServiceName: "deprecate_service",
+					AppId:       "deprecate_appId",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			serviceId1 = respCreateService.ServiceId
+
+			respCreateService, err = serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					ServiceName: "deprecate_service",
+					AppId:       "deprecate_appId",
+					Version:     "2.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			serviceId2 = respCreateService.ServiceId
+		})
+
+		Context("when request is invalid", func() {
+			It("should be failed", func() {
+				By("deprecation is nil")
+				resp, err := serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId: serviceId1,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("invalid state")
+				resp, err = serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId:   serviceId1,
+					Deprecation: &pb.Deprecation{State: "OBSOLETE"},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("invalid sunset date")
+				resp, err = serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId:   serviceId1,
+					Deprecation: &pb.Deprecation{State: pb.MS_DEPRECATED, SunsetDate: "next year"},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("replacement does not exist")
+				resp, err = serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId:   serviceId1,
+					Deprecation: &pb.Deprecation{State: pb.MS_DEPRECATED, Replacement: "3.0.0"},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("service does not exist")
+				resp, err = serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId:   "notexistservice",
+					Deprecation: &pb.Deprecation{State: pb.MS_DEPRECATED},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrServiceNotExists))
+			})
+		})
+
+		Context("when version is deprecated", func() {
+			It("should warn the consumers", func() {
+				resp, err := serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId: serviceId1,
+					Deprecation: &pb.Deprecation{
+						State:       pb.MS_DEPRECATED,
+						SunsetDate:  "2018-12-31",
+						Replacement: "2.0.0",
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respGet, err := serviceResource.GetOne(getContext(), &pb.GetServiceRequest{
+					ServiceId: serviceId1,
+				})
+				Expect(err).To(BeNil())
+				Expect(respGet.Service.Deprecation.State).To(Equal(pb.MS_DEPRECATED))
+				Expect(respGet.Service.Deprecation.Replacement).To(Equal("2.0.0"))
+
+				respIns, err := instanceResource.Register(getContext(), &pb.RegisterInstanceRequest{
+					Instance: &pb.MicroServiceInstance{
+						ServiceId: serviceId1,
+						HostName:  "deprecate-host",
+						Endpoints: []string{"rest://127.0.0.1:8080"},
+						Status:    pb.MSI_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respIns.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				ctx := getContext()
+				respFind, err := instanceResource.Find(ctx, &pb.FindInstancesRequest{
+					ConsumerServiceId: consumerId,
+					AppId:             "deprecate_appId",
+					ServiceName:       "deprecate_service",
+					VersionRule:       "1.0.0",
+				})
+				Expect(err).To(BeNil())
+				Expect(respFind.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respFind.Instances)).To(Equal(1))
+				warning, _ := ctx.Value(serviceUtil.CTX_RESPONSE_DEPRECATION).(string)
+				Expect(warning).To(ContainSubstring("2.0.0"))
+			})
+		})
+
+		Context("when version is retired", func() {
+			It("should refuse to register instances", func() {
+				resp, err := serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId:   serviceId1,
+					Deprecation: &pb.Deprecation{State: pb.MS_RETIRED},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respIns, err := instanceResource.Register(getContext(), &pb.RegisterInstanceRequest{
+					Instance: &pb.MicroServiceInstance{
+						ServiceId: serviceId1,
+						HostName:  "retired-host",
+						Endpoints: []string{"rest://127.0.0.1:8081"},
+						Status:    pb.MSI_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respIns.Response.Code).To(Equal(scerr.ErrServiceRetired))
+			})
+		})
+
+		Context("when deprecation is cleared", func() {
+			It("should be passed", func() {
+				resp, err := serviceResource.UpdateDeprecation(getContext(), &pb.UpdateDeprecationRequest{
+					ServiceId:   serviceId1,
+					Deprecation: &pb.Deprecation{},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respGet, err := serviceResource.GetOne(getContext(), &pb.GetServiceRequest{
+					ServiceId: serviceId1,
+				})
+				Expect(err).To(BeNil())
+				Expect(respGet.Service.Deprecation).To(BeNil())
+
+				respIns, err := instanceResource.Register(getContext(), &pb.RegisterInstanceRequest{
+					Instance: &pb.MicroServiceInstance{
+						ServiceId: serviceId1,
+						HostName:  "retired-host",
+						Endpoints: []string{"rest://127.0.0.1:8081"},
+						Status:    pb.MSI_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respIns.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+		})
+
+		It("should be deleted", func() {
+			for _, id := range []string{consumerId, serviceId1, serviceId2} {
+				resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+					ServiceId: id,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			}
+		})
+	})
+
+	Describe("execute 'ownership' operation", func() {
+		Context("when the service ownership is enabled", func() {
+			var (
+				serviceId  string
+				credential string
+			)
+
+			BeforeEach(func() {
+				core.ServerInfo.Config.ServiceOwnership = true
+			})
+
+			AfterEach(func() {
+				core.ServerInfo.Config.ServiceOwnership = false
+			})
+
+			It("should issue the credential on creation", func() {
+				By("credential is invalid")
+				resp, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "ownership",
+						ServiceName: "owned_service",
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+					},
+					Credential: "short",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("credential is generated")
+				resp, err = serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "ownership",
+						ServiceName: "owned_service",
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+					},
+					Tags: map[string]string{"owner": "team-a"},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Credential).NotTo(BeEmpty())
+				serviceId, credential = resp.ServiceId, resp.Credential
+
+				respTags, err := serviceResource.GetTags(getContext(), &pb.GetServiceTagsRequest{
+					ServiceId: serviceId,
+				})
+				Expect(err).To(BeNil())
+				Expect(respTags.Tags["owner"]).To(Equal("team-a"))
+			})
+
+			It("should require the credential to modify the service", func() {
+				By("credential is missing")
+				resp, err := serviceResource.UpdateProperties(getContext(), &pb.UpdateServicePropsRequest{
+					ServiceId:  serviceId,
+					Properties: map[string]string{"k": "v"},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				respTags, err := serviceResource.AddTags(getContext(), &pb.AddServiceTagsRequest{
+					ServiceId: serviceId,
+					Tags:      map[string]string{"k": "v"},
+				})
+				Expect(err).To(BeNil())
+				Expect(respTags.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				respSchema, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: serviceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    "schema",
+				})
+				Expect(err).To(BeNil())
+				Expect(respSchema.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				respIns, err := instanceResource.Register(getContext(), &pb.RegisterInstanceRequest{
+					Instance: &pb.MicroServiceInstance{
+						ServiceId: serviceId,
+						HostName:  "UT-HOST",
+						Endpoints: []string{"rest://127.0.0.1:8080"},
+						Status:    pb.MSI_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respIns.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				By("credential is incorrect")
+				resp, err = serviceResource.UpdateProperties(
+					serviceUtil.SetServiceCredential(getContext(), strings.Repeat("x", 64)),
+					&pb.UpdateServicePropsRequest{
+						ServiceId:  serviceId,
+						Properties: map[string]string{"k": "v"},
+					})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				By("credential is correct")
+				resp, err = serviceResource.UpdateProperties(
+					serviceUtil.SetServiceCredential(getContext(), credential),
+					&pb.UpdateServicePropsRequest{
+						ServiceId:  serviceId,
+						Properties: map[string]string{"k": "v"},
+					})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				By("request is sent by admin")
+				resp, err = serviceResource.UpdateProperties(
+					util.SetAdmin(getContext(), true),
+					&pb.UpdateServicePropsRequest{
+						ServiceId:  serviceId,
+						Properties: map[string]string{"k": "v2"},
+					})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+
+			It("should rotate the credential", func() {
+				By("credential is missing")
+				resp, err := serviceResource.RotateServiceCredential(getContext(), &pb.RotateServiceCredentialRequest{
+					ServiceId: serviceId,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				By("new credential is specified")
+				newCredential := strings.Repeat("n", 32)
+				resp, err = serviceResource.RotateServiceCredential(
+					serviceUtil.SetServiceCredential(getContext(), credential),
+					&pb.RotateServiceCredentialRequest{
+						ServiceId:  serviceId,
+						Credential: newCredential,
+					})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Credential).To(Equal(newCredential))
+
+				By("old credential is invalid")
+				respDel, err := serviceResource.Delete(
+					serviceUtil.SetServiceCredential(getContext(), credential),
+					&pb.DeleteServiceRequest{ServiceId: serviceId, Force: true})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				By("admin rotates the lost credential")
+				resp, err = serviceResource.RotateServiceCredential(
+					util.SetAdmin(getContext(), true),
+					&pb.RotateServiceCredentialRequest{ServiceId: serviceId})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Credential).NotTo(BeEmpty())
+				Expect(resp.Credential).NotTo(Equal(newCredential))
+
+				respDel, err = serviceResource.Delete(
+					serviceUtil.SetServiceCredential(getContext(), resp.Credential),
+					&pb.DeleteServiceRequest{ServiceId: serviceId, Force: true})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+
+			It("should only allow the admin to claim the service without credential", func() {
+				core.ServerInfo.Config.ServiceOwnership = false
+				resp, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "ownership",
+						ServiceName: "unowned_service",
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Credential).To(BeEmpty())
+				serviceId := resp.ServiceId
+				core.ServerInfo.Config.ServiceOwnership = true
+
+				By("credential is rotated by the other caller")
+				respRotate, err := serviceResource.RotateServiceCredential(getContext(), &pb.RotateServiceCredentialRequest{
+					ServiceId: serviceId,
+				})
+				Expect(err).To(BeNil())
+				Expect(respRotate.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+
+				By("credential is rotated by admin")
+				respRotate, err = serviceResource.RotateServiceCredential(
+					util.SetAdmin(getContext(), true),
+					&pb.RotateServiceCredentialRequest{ServiceId: serviceId})
+				Expect(err).To(BeNil())
+				Expect(respRotate.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				By("heartbeat requires the credential")
+				respHb, err := instanceResource.Heartbeat(getContext(), &pb.HeartbeatRequest{
+					ServiceId:  serviceId,
+					InstanceId: "not-exist-ins",
+				})
+				Expect(err).To(BeNil())
+				Expect(respHb.Response.Code).To(Equal(scerr.ErrPermissionDeny))
+				respHb, err = instanceResource.Heartbeat(
+					serviceUtil.SetServiceCredential(getContext(), respRotate.Credential),
+					&pb.HeartbeatRequest{
+						ServiceId:  serviceId,
+						InstanceId: "not-exist-ins",
+					})
+				Expect(err).To(BeNil())
+				Expect(respHb.Response.Code).To(Equal(scerr.ErrInstanceNotExists))
+
+				respDel, err := serviceResource.Delete(
+					serviceUtil.SetServiceCredential(getContext(), respRotate.Credential),
+					&pb.DeleteServiceRequest{ServiceId: serviceId, Force: true})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+		})
+
+		Context("when the service ownership is disabled", func() {
+			It("should not issue the credential", func() {
+				resp, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "ownership",
+						ServiceName: "open_service",
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Credential).To(BeEmpty())
+
+				respRotate, err := serviceResource.RotateServiceCredential(getContext(), &pb.RotateServiceCredentialRequest{
+					ServiceId: resp.ServiceId,
+				})
+				Expect(err).To(BeNil())
+				Expect(respRotate.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				respDel, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+					ServiceId: resp.ServiceId,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+		})
+	})
+
+	Describe("execute 'quota' operation", func() {
+		Context("when the project has a quota record", func() {
+			var ctx context.Context
+
+			BeforeEach(func() {
+				ctx = util.SetContext(
+					util.SetDomainProject(context.Background(), "default", "quota_project"),
+					serviceUtil.CTX_NOCACHE, "1")
+				err := serviceUtil.PutQuotaRecord(ctx, "default", "quota_project", &quota.Limits{Service: 1})
+				Expect(err).To(BeNil())
+			})
+
+			AfterEach(func() {
+				err := serviceUtil.DeleteQuotaRecord(ctx, "default", "quota_project")
+				Expect(err).To(BeNil())
+			})
+
+			It("should limit the services and release the quota on delete", func() {
+				newService := func(name string) *pb.CreateServiceRequest {
+					return &pb.CreateServiceRequest{
+						Service: &pb.MicroService{
+							AppId:       "quota",
+							ServiceName: name,
+							Version:     "1.0.0",
+							Level:       "FRONT",
+							Status:      pb.MS_UP,
+						},
+					}
+				}
+				resp, err := serviceResource.Create(ctx, newService("quota_service_1"))
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				serviceId := resp.ServiceId
+
+				By("no quota to apply")
+				resp, err = serviceResource.Create(ctx, newService("quota_service_2"))
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrNotEnoughQuota))
+
+				usages, err := plugin.Plugins().Quota().GetUsage(ctx, "default/quota_project", "")
+				Expect(err).To(BeNil())
+				found := false
+				for _, usage := range usages {
+					if usage.Type == quota.MicroServiceQuotaType.String() && usage.Scope == quota.SCOPE_PROJECT {
+						Expect(usage.Used).To(Equal(int64(1)))
+						Expect(usage.Limit).To(Equal(int64(1)))
+						found = true
+					}
+				}
+				Expect(found).To(BeTrue())
+
+				By("quota is released after delete")
+				respDel, err := serviceResource.Delete(ctx, &pb.DeleteServiceRequest{
+					ServiceId: serviceId,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				resp, err = serviceResource.Create(ctx, newService("quota_service_2"))
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respDel, err = serviceResource.Delete(ctx, &pb.DeleteServiceRequest{
+					ServiceId: resp.ServiceId,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+		})
+	})
+
+	Describe("execute 'audit' operation", func() {
+		Context("when the call is audited", func() {
+			It("should record the changes", func() {
+				changes := &auditlog.Changes{}
+				ctx := auditlog.WithChanges(getContext(), changes)
+				resp, err := serviceResource.Create(ctx, &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "audit",
+						ServiceName: "audit_service",
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				serviceId := resp.ServiceId
+
+				respUpdate, err := serviceResource.UpdateProperties(ctx, &pb.UpdateServicePropsRequest{
+					ServiceId:  serviceId,
+					Properties: map[string]string{"a": "1"},
+				})
+				Expect(err).To(BeNil())
+				Expect(respUpdate.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respDel, err := serviceResource.Delete(ctx, &pb.DeleteServiceRequest{
+					ServiceId: serviceId,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				list := changes.List()
+				Expect(len(list)).To(Equal(3))
+				Expect(list[0].Entity).To(Equal(auditlog.ENTITY_SERVICE))
+				Expect(list[0].Id).To(Equal(serviceId))
+				Expect(list[0].Diff["serviceName"].Before).To(BeNil())
+				Expect(list[0].Diff["serviceName"].After).To(Equal("audit_service"))
+				Expect(list[1].Diff["properties"]).NotTo(BeNil())
+				Expect(list[2].Diff["serviceName"].After).To(BeNil())
+			})
+		})
+	})
+
 	Describe("execute 'delete' operartion", func() {
 		var (
 			serviceContainInstId string
@@ -20,7 +20,6 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/validate"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
-	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	"regexp"
 )
@@ -31,6 +30,9 @@ var (
 	getServiceReqValidator         validate.Validator
 	createServiceReqValidator      validate.Validator
 	updateServicePropsReqValidator validate.Validator
+	deprecationValidator           validate.Validator
+	updateDeprecationReqValidator  validate.Validator
+	rotateCredentialReqValidator   validate.Validator
 )
 
 var (
@@ -50,7 +52,12 @@ var (
 	registerByRegex, _ = regexp.Compile("^(" + util.StringJoin([]string{pb.REGISTERBY_SDK, pb.REGISTERBY_SIDECAR, pb.REGISTERBY_PLATFORM}, "|") + ")*$")
 	envRegex, _        = regexp.Compile("^(" + util.StringJoin([]string{
 		pb.ENV_DEV, pb.ENV_TEST, pb.ENV_ACCEPT, pb.ENV_PROD}, "|") + ")*$")
-	schemaIdRegex, _ = regexp.Compile(`^[a-zA-Z0-9]{1,160}$|^[a-zA-Z0-9][a-zA-Z0-9_\-.]{0,158}[a-zA-Z0-9]$`)
+	deprecationStateRegex, _ = regexp.Compile("^(" + pb.MS_DEPRECATED + "|" + pb.MS_RETIRED + ")?$")
+	replacementRegex, _      = regexp.Compile(`^(\d+(\.\d+){0,2})?$`)
+	sunsetDateRegex, _       = regexp.Compile(`^([0-9]{4}-[0-9]{2}-[0-9]{2})?$`)
+	schemaIdRegex, _         = regexp.Compile(`^[a-zA-Z0-9]{1,160}$|^[a-zA-Z0-9][a-zA-Z0-9_\-.]{0,158}[a-zA-Z0-9]$`)
+	// the owner credential is generated if empty
+	credentialRegex, _ = regexp.Compile(`^([\x21-\x7e]{16,128})?$`)
 )
 
 func MicroServiceKeyValidator() *validate.Validator {
@@ -93,14 +100,16 @@ func CreateServiceReqValidator() *validate.Validator {
 		microServiceValidator.AddRule("Description", &validate.ValidateRule{Max: 256})
 		microServiceValidator.AddRule("Level", &validate.ValidateRule{Regexp: levelRegex})
 		microServiceValidator.AddRule("Status", &validate.ValidateRule{Regexp: statusRegex})
-		microServiceValidator.AddRule("Schemas", &validate.ValidateRule{Max: quota.DefaultSchemaQuota, Regexp: schemaIdRegex})
+		microServiceValidator.AddRule("Schemas", &validate.ValidateRule{Max: MAX_SCHEMAS_PER_REQUEST, Regexp: schemaIdRegex})
 		microServiceValidator.AddSub("Paths", &pathValidator)
 		microServiceValidator.AddRule("Alias", &validate.ValidateRule{Max: 128, Regexp: aliasRegex})
 		microServiceValidator.AddRule("RegisterBy", &validate.ValidateRule{Max: 64, Regexp: registerByRegex})
 		microServiceValidator.AddSub("Framework", &frameworkValidator)
+		microServiceValidator.AddSub("Deprecation", DeprecationValidator())
 
 		v.AddRule("Service", &validate.ValidateRule{Min: 1})
 		v.AddSub("Service", &microServiceValidator)
+		v.AddRule("Credential", &validate.ValidateRule{Regexp: credentialRegex})
 	})
 
 }
@@ -111,3 +120,27 @@ func UpdateServicePropsReqValidator() *validate.Validator {
 		v.AddRule("Properties", &validate.ValidateRule{Min: 1})
 	})
 }
+
+func DeprecationValidator() *validate.Validator {
+	return deprecationValidator.Init(func(v *validate.Validator) {
+		v.AddRule("State", &validate.ValidateRule{Regexp: deprecationStateRegex})
+		v.AddRule("SunsetDate", &validate.ValidateRule{Regexp: sunsetDateRegex})
+		v.AddRule("Replacement", &validate.ValidateRule{Max: 64, Regexp: replacementRegex})
+		v.AddRule("Description", &validate.ValidateRule{Max: 256})
+	})
+}
+
+func UpdateDeprecationReqValidator() *validate.Validator {
+	return updateDeprecationReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
+		v.AddRule("Deprecation", &validate.ValidateRule{Min: 1})
+		v.AddSub("Deprecation", DeprecationValidator())
+	})
+}
+
+func RotateCredentialReqValidator() *validate.Validator {
+	return rotateCredentialReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
+		v.AddRule("Credential", &validate.ValidateRule{Regexp: credentialRegex})
+	})
+}
@@ -18,11 +18,13 @@ package service
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
@@ -40,8 +42,22 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
 		}, nil
 	}
+	for _, rule := range in.Rules {
+		if err := ValidateRuleContent(rule); err != nil {
+			util.Logger().Errorf(err, "add rule failed, serviceId is %s.", in.ServiceId)
+			return &pb.AddServiceRulesResponse{
+				Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+			}, nil
+		}
+	}
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckBatchSize(ctx, domainProject, quota.RuleQuotaType, len(in.Rules)); err != nil {
+		util.Logger().Errorf(err, "add rule failed, serviceId is %s.", in.ServiceId)
+		return &pb.AddServiceRulesResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	// service id存在性校验
 	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
@@ -50,6 +66,12 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 			Response: pb.CreateResponse(scerr.ErrInvalidParams, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "add rule failed, serviceId is %s: check owner failed.", in.ServiceId)
+		return &pb.AddServiceRulesResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 	res := quota.NewApplyQuotaResource(quota.RuleQuotaType, domainProject, in.ServiceId, int64(len(in.Rules)))
 	rst := plugin.Plugins().Quota().Apply4Quotas(ctx, res)
 	errQuota := rst.Err
@@ -64,27 +86,10 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 		return response, nil
 	}
 
-	ruleType, _, err := serviceUtil.GetServiceRuleType(ctx, domainProject, in.ServiceId)
-	if err != nil {
-		return &pb.AddServiceRulesResponse{
-			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
-		}, err
-	}
 	ruleIds := make([]string, 0, len(in.Rules))
+	rulesAdd := make([]*pb.ServiceRule, 0, len(in.Rules))
 	opts := make([]registry.PluginOp, 0, 2*len(in.Rules))
 	for _, rule := range in.Rules {
-		//黑白名单只能存在一种，黑名单 or 白名单
-		if len(ruleType) == 0 {
-			ruleType = rule.RuleType
-		} else {
-			if ruleType != rule.RuleType {
-				util.Logger().Errorf(nil, "add rule failed, serviceId is %s:can only exist one type, BLACK or WHITE.", in.ServiceId)
-				return &pb.AddServiceRulesResponse{
-					Response: pb.CreateResponse(scerr.ErrBlackAndWhiteRule, "Service can only contain one rule type, BLACK or WHITE."),
-				}, nil
-			}
-		}
-
 		//同一服务，attribute和pattern确定一个rule
 		if serviceUtil.RuleExist(ctx, domainProject, in.ServiceId, rule.Attribute, rule.Pattern) {
 			util.Logger().Infof("This rule more exists, %s ", in.ServiceId)
@@ -101,11 +106,16 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 			Description:  rule.Description,
 			Timestamp:    timestamp,
 			ModTimestamp: timestamp,
+			Priority:     rule.Priority,
+			PatternType:  rule.PatternType,
+			ValidFrom:    rule.ValidFrom,
+			ValidTo:      rule.ValidTo,
 		}
 
 		key := apt.GenerateServiceRuleKey(domainProject, in.ServiceId, ruleAdd.RuleId)
 		indexKey := apt.GenerateRuleIndexKey(domainProject, in.ServiceId, ruleAdd.Attribute, ruleAdd.Pattern)
 		ruleIds = append(ruleIds, ruleAdd.RuleId)
+		rulesAdd = append(rulesAdd, ruleAdd)
 
 		data, err := json.Marshal(ruleAdd)
 		if err != nil {
@@ -125,6 +135,13 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 		}, nil
 	}
 
+	if in.DryRun {
+		util.Logger().Infof("add rule dry run successful, serviceId is %s: %d rules can be added.", in.ServiceId, len(ruleIds))
+		return &pb.AddServiceRulesResponse{
+			Response: pb.CreateResponse(pb.Response_SUCCESS, "Service rules are valid."),
+		}, nil
+	}
+
 	resp, err := backend.BatchCommitWithCmp(ctx, opts,
 		[]registry.CompareOp{registry.OpCmp(
 			registry.CmpVer(util.StringToBytesWithNoCopy(apt.GenerateServiceKey(domainProject, in.ServiceId))),
@@ -143,6 +160,9 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 		}, nil
 	}
 
+	for _, rule := range rulesAdd {
+		auditlog.AddChange(ctx, auditlog.ENTITY_RULE, in.ServiceId, rule.RuleId, nil, rule)
+	}
 	util.Logger().Infof("add rule successful, serviceId %s, ruleIds %v.", in.ServiceId, ruleIds)
 	return &pb.AddServiceRulesResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Add service rules successfully."),
@@ -152,6 +172,9 @@ func (s *MicroServiceService) AddRule(ctx context.Context, in *pb.AddServiceRule
 
 func (s *MicroServiceService) UpdateRule(ctx context.Context, in *pb.UpdateServiceRuleRequest) (*pb.UpdateServiceRuleResponse, error) {
 	err := Validate(in)
+	if err == nil {
+		err = ValidateRuleContent(in.Rule)
+	}
 	if err != nil {
 		util.Logger().Errorf(err, "update rule failed, serviceId is %s, ruleId is %s.", in.ServiceId, in.RuleId)
 		return &pb.UpdateServiceRuleResponse{
@@ -168,19 +191,10 @@ func (s *MicroServiceService) UpdateRule(ctx context.Context, in *pb.UpdateServi
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
-
-	//是否能改变ruleType
-	ruleType, ruleNum, err := serviceUtil.GetServiceRuleType(ctx, domainProject, in.ServiceId)
-	if err != nil {
-		util.Logger().Errorf(err, "update rule failed, serviceId is %s, ruleId is %s: get rule type failed.", in.ServiceId, in.RuleId)
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update rule failed, serviceId is %s, ruleId is %s: check owner failed.", in.ServiceId, in.RuleId)
 		return &pb.UpdateServiceRuleResponse{
-			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
-		}, err
-	}
-	if ruleNum >= 1 && ruleType != in.Rule.RuleType {
-		util.Logger().Errorf(err, "update rule failed, serviceId is %s, ruleId is %s: rule type can exist one type, BLACK or WHITE.rule type is %s", in.ServiceId, in.RuleId, in.Rule.RuleType)
-		return &pb.UpdateServiceRuleResponse{
-			Response: pb.CreateResponse(scerr.ErrModifyRuleNotAllow, "Exist multiple rules,can not change rule type. Rule type is "+ruleType),
+			Response: pb.CreateResponseWithSCErr(err),
 		}, nil
 	}
 
@@ -198,6 +212,7 @@ func (s *MicroServiceService) UpdateRule(ctx context.Context, in *pb.UpdateServi
 		}, nil
 	}
 
+	before := *rule
 	oldRulePatten := rule.Pattern
 	oldRuleAttr := rule.Attribute
 	isChangeIndex := false
@@ -211,6 +226,10 @@ func (s *MicroServiceService) UpdateRule(ctx context.Context, in *pb.UpdateServi
 	}
 	rule.RuleType = in.GetRule().RuleType
 	rule.Description = in.GetRule().Description
+	rule.Priority = in.GetRule().Priority
+	rule.PatternType = in.GetRule().PatternType
+	rule.ValidFrom = in.GetRule().ValidFrom
+	rule.ValidTo = in.GetRule().ValidTo
 	rule.ModTimestamp = strconv.FormatInt(time.Now().Unix(), 10)
 
 	key := apt.GenerateServiceRuleKey(domainProject, in.ServiceId, in.RuleId)
@@ -251,6 +270,7 @@ func (s *MicroServiceService) UpdateRule(ctx context.Context, in *pb.UpdateServi
 		}, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_RULE, in.ServiceId, in.RuleId, &before, rule)
 	util.Logger().Infof("update rule successful: servieId is %s, ruleId is %s.", in.ServiceId, in.RuleId)
 	return &pb.UpdateServiceRuleResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Get service rules successfully."),
@@ -300,6 +320,12 @@ func (s *MicroServiceService) DeleteRule(ctx context.Context, in *pb.DeleteServi
 	}
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckBatchSize(ctx, domainProject, quota.RuleQuotaType, len(in.RuleIds)); err != nil {
+		util.Logger().Errorf(err, "delete rule failed, serviceId is %s, ruleIds are %s.", in.ServiceId, in.RuleIds)
+		return &pb.DeleteServiceRulesResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	// service id存在性校验
 	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
@@ -308,8 +334,15 @@ func (s *MicroServiceService) DeleteRule(ctx context.Context, in *pb.DeleteServi
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "delete rule failed, serviceId is %s, ruleIds are %s: check owner failed.", in.ServiceId, in.RuleIds)
+		return &pb.DeleteServiceRulesResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	opts := []registry.PluginOp{}
+	rulesDel := make([]*pb.ServiceRule, 0, len(in.RuleIds))
 	key := ""
 	indexKey := ""
 	for _, ruleId := range in.RuleIds {
@@ -329,6 +362,7 @@ func (s *MicroServiceService) DeleteRule(ctx context.Context, in *pb.DeleteServi
 			}, nil
 		}
 		indexKey = apt.GenerateRuleIndexKey(domainProject, in.ServiceId, data.Attribute, data.Pattern)
+		rulesDel = append(rulesDel, data)
 		opts = append(opts,
 			registry.OpDel(registry.WithStrKey(key)),
 			registry.OpDel(registry.WithStrKey(indexKey)))
@@ -358,8 +392,133 @@ func (s *MicroServiceService) DeleteRule(ctx context.Context, in *pb.DeleteServi
 		}, nil
 	}
 
+	for _, rule := range rulesDel {
+		auditlog.AddChange(ctx, auditlog.ENTITY_RULE, in.ServiceId, rule.RuleId, rule, nil)
+	}
 	util.Logger().Infof("delete rule successful: serviceId %s, ruleIds %v", in.ServiceId, in.RuleIds)
 	return &pb.DeleteServiceRulesResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Delete service rules successfully."),
 	}, nil
 }
+
+func (s *MicroServiceService) ExplainRules(ctx context.Context, in *pb.ExplainRulesRequest) (*pb.ExplainRulesResponse, error) {
+	err := Validate(in)
+	if err == nil && len(in.ConsumerServiceId) == 0 && in.Consumer == nil {
+		err = errors.New("consumer serviceId or consumer key is required")
+	}
+	if err == nil {
+		for _, rule := range in.Rules {
+			if err = ValidateRuleContent(rule); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		util.Logger().Errorf(err, "explain rules failed, provider is %s.", in.ProviderServiceId)
+		return &pb.ExplainRulesResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+	targetDomainProject := util.ParseTargetDomainProject(ctx)
+
+	provider, err := serviceUtil.GetService(ctx, targetDomainProject, in.ProviderServiceId)
+	if err != nil {
+		util.Logger().Errorf(err, "explain rules failed, provider is %s: query provider failed.", in.ProviderServiceId)
+		return &pb.ExplainRulesResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+	if provider == nil {
+		util.Logger().Errorf(nil, "explain rules failed, provider is %s: provider does not exist.", in.ProviderServiceId)
+		return &pb.ExplainRulesResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Provider does not exist."),
+		}, nil
+	}
+
+	consumer := &pb.MicroService{}
+	tags := map[string]string{}
+	if len(in.ConsumerServiceId) > 0 {
+		consumer, err = serviceUtil.GetService(ctx, domainProject, in.ConsumerServiceId)
+		if err != nil {
+			util.Logger().Errorf(err, "explain rules failed, consumer is %s: query consumer failed.", in.ConsumerServiceId)
+			return &pb.ExplainRulesResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+		if consumer == nil {
+			util.Logger().Errorf(nil, "explain rules failed, consumer is %s: consumer does not exist.", in.ConsumerServiceId)
+			return &pb.ExplainRulesResponse{
+				Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Consumer does not exist."),
+			}, nil
+		}
+		tags, err = serviceUtil.GetTagsUtils(ctx, domainProject, in.ConsumerServiceId)
+		if err != nil {
+			util.Logger().Errorf(err, "explain rules failed, consumer is %s: query consumer tags failed.", in.ConsumerServiceId)
+			return &pb.ExplainRulesResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+	} else {
+		consumer.Environment = in.Consumer.Environment
+		consumer.AppId = in.Consumer.AppId
+		consumer.ServiceName = in.Consumer.ServiceName
+		consumer.Alias = in.Consumer.Alias
+		consumer.Version = in.Consumer.Version
+	}
+	for k, v := range in.Tags {
+		tags[k] = v
+	}
+
+	var rules []*pb.ServiceRule
+	if len(in.Rules) > 0 {
+		for _, rule := range in.Rules {
+			rules = append(rules, &pb.ServiceRule{
+				RuleType:    rule.RuleType,
+				Attribute:   rule.Attribute,
+				Pattern:     rule.Pattern,
+				Description: rule.Description,
+				Priority:    rule.Priority,
+				PatternType: rule.PatternType,
+				ValidFrom:   rule.ValidFrom,
+				ValidTo:     rule.ValidTo,
+			})
+		}
+	} else {
+		rules, err = serviceUtil.GetRulesUtil(ctx, targetDomainProject, in.ProviderServiceId)
+		if err != nil {
+			util.Logger().Errorf(err, "explain rules failed, provider is %s: query provider rules failed.", in.ProviderServiceId)
+			return &pb.ExplainRulesResponse{
+				Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+			}, err
+		}
+	}
+
+	resp := &pb.ExplainRulesResponse{
+		Response:               pb.CreateResponse(pb.Response_SUCCESS, "Explain service rules successfully."),
+		AcrossDimensionAllowed: true,
+	}
+	if err := serviceUtil.AllowAcrossDimension(ctx, provider, consumer); err != nil {
+		resp.AcrossDimensionAllowed = false
+		resp.AcrossDimensionMessage = err.Error()
+	}
+
+	// the same IP as the real decision of Accessible, unless it is specified
+	consumerIPs := serviceUtil.ConsumerIPsOf(ctx, rules)
+	if len(in.InstanceIP) > 0 {
+		consumerIPs = []string{in.InstanceIP}
+	}
+	results, decision := serviceUtil.EvaluateRules(rules, consumer, tags, consumerIPs)
+	resp.Rules = results
+	resp.Allowed = resp.AcrossDimensionAllowed && decision == nil
+	switch {
+	case !resp.AcrossDimensionAllowed:
+		resp.Reason = resp.AcrossDimensionMessage
+	case decision != nil:
+		resp.Reason = decision.Detail
+	default:
+		resp.Reason = "Access allowed"
+	}
+	return resp, nil
+}
@@ -189,13 +189,66 @@ var _ = Describe("'Rule' service", func() {
 						{
 							RuleType:    "WHITE",
 							Attribute:   "ServiceName",
-							Pattern:     "Test*",
+							Pattern:     "^White*",
 							Description: "test white",
+							Priority:    10,
 						},
 					},
 				})
 				Expect(err).To(BeNil())
-				Expect(respAddRule.Response.Code).ToNot(Equal(pb.Response_SUCCESS))
+				Expect(respAddRule.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respAddRule.RuleIds)).To(Equal(1))
+
+				By("create a CIDR rule with time window")
+				respAddRule, err = serviceResource.AddRule(getContext(), &pb.AddServiceRulesRequest{
+					ServiceId: serviceId1,
+					Rules: []*pb.AddOrUpdateServiceRule{
+						{
+							RuleType:    "WHITE",
+							Attribute:   "InstanceIP",
+							PatternType: "CIDR",
+							Pattern:     "10.0.0.0/8",
+							Description: "test cidr",
+							ValidFrom:   "1",
+							ValidTo:     "4102444800",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respAddRule.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				By("create an invalid CIDR rule")
+				respAddRule, err = serviceResource.AddRule(getContext(), &pb.AddServiceRulesRequest{
+					ServiceId: serviceId1,
+					Rules: []*pb.AddOrUpdateServiceRule{
+						{
+							RuleType:    "BLACK",
+							Attribute:   "InstanceIP",
+							PatternType: "CIDR",
+							Pattern:     "10.0.0.0",
+							Description: "test cidr",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respAddRule.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("create a rule with invalid time window")
+				respAddRule, err = serviceResource.AddRule(getContext(), &pb.AddServiceRulesRequest{
+					ServiceId: serviceId1,
+					Rules: []*pb.AddOrUpdateServiceRule{
+						{
+							RuleType:    "BLACK",
+							Attribute:   "AppId",
+							Pattern:     "Test*",
+							Description: "test window",
+							ValidFrom:   "2",
+							ValidTo:     "1",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respAddRule.Response.Code).To(Equal(scerr.ErrInvalidParams))
 			})
 		})
 
@@ -430,9 +483,13 @@ var _ = Describe("'Rule' service", func() {
 				})
 				Expect(err).To(BeNil())
 				Expect(respAddRule.Response.Code).ToNot(Equal(pb.Response_SUCCESS))
+			})
+		})
 
+		Context("when request is valid", func() {
+			It("should be passed", func() {
 				By("change rule type")
-				respAddRule, err = serviceResource.UpdateRule(getContext(), &pb.UpdateServiceRuleRequest{
+				respAddRule, err := serviceResource.UpdateRule(getContext(), &pb.UpdateServiceRuleRequest{
 					ServiceId: serviceId,
 					RuleId:    ruleId,
 					Rule: &pb.AddOrUpdateServiceRule{
@@ -443,13 +500,9 @@ var _ = Describe("'Rule' service", func() {
 					},
 				})
 				Expect(err).To(BeNil())
-				Expect(respAddRule.Response.Code).ToNot(Equal(pb.Response_SUCCESS))
-			})
-		})
+				Expect(respAddRule.Response.Code).To(Equal(pb.Response_SUCCESS))
 
-		Context("when request is valid", func() {
-			It("should be passed", func() {
-				respAddRule, err := serviceResource.UpdateRule(getContext(), &pb.UpdateServiceRuleRequest{
+				respAddRule, err = serviceResource.UpdateRule(getContext(), &pb.UpdateServiceRuleRequest{
 					ServiceId: serviceId,
 					RuleId:    ruleId,
 					Rule: &pb.AddOrUpdateServiceRule{
@@ -726,4 +779,160 @@ var _ = Describe("'Rule' service", func() {
 			})
 		})
 	})
+
+	Describe("execute 'explain' operartion", func() {
+		var (
+			providerId string
+			consumerId string
+		)
+
+		It("should be passed", func() {
+			respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "explain_rule_group",
+					ServiceName: "explain_rule_provider",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			providerId = respCreateService.ServiceId
+
+			respCreateService, err = serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "explain_rule_group",
+					ServiceName: "explain_rule_consumer",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			consumerId = respCreateService.ServiceId
+
+			By("dry run add rules")
+			respAddRule, err := serviceResource.AddRule(getContext(), &pb.AddServiceRulesRequest{
+				ServiceId: providerId,
+				Rules: []*pb.AddOrUpdateServiceRule{
+					{
+						RuleType:    "BLACK",
+						Attribute:   "ServiceName",
+						Pattern:     "explain_rule_consumer",
+						Description: "test black",
+					},
+				},
+				DryRun: true,
+			})
+			Expect(err).To(BeNil())
+			Expect(respAddRule.Response.Code).To(Equal(pb.Response_SUCCESS))
+			Expect(len(respAddRule.RuleIds)).To(Equal(0))
+
+			respGetRule, err := serviceResource.GetRule(getContext(), &pb.GetServiceRulesRequest{
+				ServiceId: providerId,
+			})
+			Expect(err).To(BeNil())
+			Expect(len(respGetRule.Rules)).To(Equal(0))
+
+			respAddRule, err = serviceResource.AddRule(getContext(), &pb.AddServiceRulesRequest{
+				ServiceId: providerId,
+				Rules: []*pb.AddOrUpdateServiceRule{
+					{
+						RuleType:    "BLACK",
+						Attribute:   "ServiceName",
+						Pattern:     "explain_rule_consumer",
+						Description: "test black",
+					},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respAddRule.Response.Code).To(Equal(pb.Response_SUCCESS))
+		})
+
+		Context("when request is invalid", func() {
+			It("should be failed", func() {
+				By("consumer is empty")
+				resp, err := serviceResource.ExplainRules(getContext(), &pb.ExplainRulesRequest{
+					ProviderServiceId: providerId,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				By("provider does not exist")
+				resp, err = serviceResource.ExplainRules(getContext(), &pb.ExplainRulesRequest{
+					ProviderServiceId: "notexistservice",
+					ConsumerServiceId: consumerId,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrServiceNotExists))
+
+				By("proposed rule is invalid")
+				resp, err = serviceResource.ExplainRules(getContext(), &pb.ExplainRulesRequest{
+					ProviderServiceId: providerId,
+					ConsumerServiceId: consumerId,
+					Rules: []*pb.AddOrUpdateServiceRule{
+						{
+							RuleType:    "WHITE",
+							Attribute:   "InstanceIP",
+							PatternType: "CIDR",
+							Pattern:     "10.0.0",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+			})
+		})
+
+		Context("when request is valid", func() {
+			It("should be passed", func() {
+				By("registered consumer in black list")
+				resp, err := serviceResource.ExplainRules(getContext(), &pb.ExplainRulesRequest{
+					ProviderServiceId: providerId,
+					ConsumerServiceId: consumerId,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.AcrossDimensionAllowed).To(BeTrue())
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(len(resp.Rules)).To(Equal(1))
+				Expect(resp.Rules[0].Decisive).To(BeTrue())
+
+				By("hypothetical consumer in another app")
+				resp, err = serviceResource.ExplainRules(getContext(), &pb.ExplainRulesRequest{
+					ProviderServiceId: providerId,
+					Consumer: &pb.MicroServiceKey{
+						AppId:       "explain_rule_other",
+						ServiceName: "explain_rule_other",
+						Version:     "1.0.0",
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.AcrossDimensionAllowed).To(BeFalse())
+				Expect(resp.Allowed).To(BeFalse())
+
+				By("dry run proposed rules")
+				resp, err = serviceResource.ExplainRules(getContext(), &pb.ExplainRulesRequest{
+					ProviderServiceId: providerId,
+					ConsumerServiceId: consumerId,
+					Tags: map[string]string{
+						"team": "a",
+					},
+					Rules: []*pb.AddOrUpdateServiceRule{
+						{
+							RuleType:  "WHITE",
+							Attribute: "tag_team",
+							Pattern:   "^a$",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Allowed).To(BeTrue())
+			})
+		})
+	})
 })
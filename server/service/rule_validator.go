@@ -17,21 +17,27 @@
 package service
 
 import (
+	"fmt"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/validate"
-	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"net"
 	"regexp"
+	"strconv"
 )
 
 var (
-	getRulesReqValidator    validate.Validator
-	updateRuleReqValidator  validate.Validator
-	addRulesReqValidator    validate.Validator
-	deleteRulesReqValidator validate.Validator
+	getRulesReqValidator     validate.Validator
+	updateRuleReqValidator   validate.Validator
+	addRulesReqValidator     validate.Validator
+	deleteRulesReqValidator  validate.Validator
+	explainRulesReqValidator validate.Validator
 )
 
 var (
-	ruleRegex, _     = regexp.Compile(`^(WHITE|BLACK)$`)
-	ruleAttrRegex, _ = regexp.Compile(`((^tag_[a-zA-Z][a-zA-Z0-9_\-.]{0,63}$)|(^ServiceId$)|(^AppId$)|(^ServiceName$)|(^Version$)|(^Description$)|(^Level$)|(^Status$))`)
+	ruleRegex, _            = regexp.Compile(`^(WHITE|BLACK)$`)
+	ruleAttrRegex, _        = regexp.Compile(`((^tag_[a-zA-Z][a-zA-Z0-9_\-.]{0,63}$)|(^ServiceId$)|(^AppId$)|(^ServiceName$)|(^Version$)|(^Description$)|(^Level$)|(^Status$)|(^Environment$)|(^InstanceIP$))`)
+	rulePatternTypeRegex, _ = regexp.Compile(`^(REGEX|CIDR)?$`)
+	ruleTimestampRegex, _   = regexp.Compile(`^[0-9]{0,19}$`)
 )
 
 func GetRulesReqValidator() *validate.Validator {
@@ -47,6 +53,9 @@ func UpdateRuleReqValidator() *validate.Validator {
 		ruleValidator.AddRule("Attribute", &validate.ValidateRule{Regexp: ruleAttrRegex})
 		ruleValidator.AddRule("Pattern", &validate.ValidateRule{Min: 1, Max: 64})
 		ruleValidator.AddRule("Description", CreateServiceReqValidator().GetSub("Service").GetRule("Description"))
+		ruleValidator.AddRule("PatternType", &validate.ValidateRule{Regexp: rulePatternTypeRegex})
+		ruleValidator.AddRule("ValidFrom", &validate.ValidateRule{Regexp: ruleTimestampRegex})
+		ruleValidator.AddRule("ValidTo", &validate.ValidateRule{Regexp: ruleTimestampRegex})
 
 		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
 		v.AddRule("RuleId", GetServiceReqValidator().GetRule("ServiceId"))
@@ -57,7 +66,7 @@ func UpdateRuleReqValidator() *validate.Validator {
 func AddRulesReqValidator() *validate.Validator {
 	return addRulesReqValidator.Init(func(v *validate.Validator) {
 		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
-		v.AddRule("Rules", &validate.ValidateRule{Min: 1, Max: quota.DefaultRuleQuota})
+		v.AddRule("Rules", &validate.ValidateRule{Min: 1, Max: MAX_RULES_PER_REQUEST})
 		v.AddSub("Rules", UpdateRuleReqValidator().GetSub("Rule"))
 	})
 }
@@ -65,6 +74,35 @@ func AddRulesReqValidator() *validate.Validator {
 func DeleteRulesReqValidator() *validate.Validator {
 	return deleteRulesReqValidator.Init(func(v *validate.Validator) {
 		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
-		v.AddRule("RuleIds", &validate.ValidateRule{Min: 1, Max: quota.DefaultRuleQuota})
+		v.AddRule("RuleIds", &validate.ValidateRule{Min: 1, Max: MAX_RULES_PER_REQUEST})
 	})
 }
+
+func ExplainRulesReqValidator() *validate.Validator {
+	return explainRulesReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("ProviderServiceId", GetServiceReqValidator().GetRule("ServiceId"))
+		v.AddRule("ConsumerServiceId", &validate.ValidateRule{Max: 64, Regexp: serviceIdRegex})
+		v.AddSub("Consumer", MicroServiceKeyValidator())
+		v.AddRule("Tags", &validate.ValidateRule{Max: MAX_TAGS_PER_REQUEST, Regexp: tagRegex})
+		v.AddRule("Rules", &validate.ValidateRule{Max: MAX_RULES_PER_REQUEST})
+		v.AddSub("Rules", UpdateRuleReqValidator().GetSub("Rule"))
+	})
+}
+
+func ValidateRuleContent(rule *pb.AddOrUpdateServiceRule) error {
+	if rule.GetPatternType() == pb.RULE_PATTERN_CIDR {
+		if _, _, err := net.ParseCIDR(rule.GetPattern()); err != nil {
+			return fmt.Errorf("invalid CIDR pattern '%s'", rule.GetPattern())
+		}
+	} else if _, err := regexp.Compile(rule.GetPattern()); err != nil {
+		return fmt.Errorf("invalid regex pattern '%s'", rule.GetPattern())
+	}
+	if len(rule.GetValidFrom()) > 0 && len(rule.GetValidTo()) > 0 {
+		from, _ := strconv.ParseInt(rule.GetValidFrom(), 10, 64)
+		to, _ := strconv.ParseInt(rule.GetValidTo(), 10, 64)
+		if from > to {
+			return fmt.Errorf("validFrom '%s' is later than validTo '%s'", rule.GetValidFrom(), rule.GetValidTo())
+		}
+	}
+	return nil
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	schemaTypes "github.com/apache/incubator-servicecomb-service-center/server/infra/schema"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
 	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	"golang.org/x/net/context"
@@ -65,6 +67,14 @@ func (s *MicroServiceService) GetSchemaInfo(ctx context.Context, in *pb.GetSchem
 		}, nil
 	}
 
+	content, err := serviceUtil.ResolveSchemaContent(ctx, domainProject, resp.Kvs[0].Value)
+	if err != nil {
+		util.Logger().Errorf(err, "get schema failed, serviceId %s, schemaId %s: get schema content failed.", in.ServiceId, in.SchemaId)
+		return &pb.GetSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+
 	schemaSummary, err := getSchemaSummary(ctx, domainProject, in.ServiceId, in.SchemaId)
 	if err != nil {
 		util.Logger().Errorf(err, "get schema failed, serviceId %s, schemaId %s: get schema summary failed.", in.ServiceId, in.SchemaId)
@@ -72,11 +82,23 @@ func (s *MicroServiceService) GetSchemaInfo(ctx context.Context, in *pb.GetSchem
 			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
 		}, err
 	}
+	if len(schemaSummary) == 0 {
+		schemaSummary = serviceUtil.CanonicalSchemaSummary(content)
+	}
+
+	schemaType, err := getSchemaType(ctx, domainProject, in.ServiceId, in.SchemaId)
+	if err != nil {
+		util.Logger().Errorf(err, "get schema failed, serviceId %s, schemaId %s: get schema type failed.", in.ServiceId, in.SchemaId)
+		return &pb.GetSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
 
 	return &pb.GetSchemaResponse{
 		Response:      pb.CreateResponse(pb.Response_SUCCESS, "Get schema info successfully."),
-		Schema:        util.BytesToStringWithNoCopy(resp.Kvs[0].Value),
+		Schema:        content,
 		SchemaSummary: schemaSummary,
+		SchemaType:    schemaType,
 	}, nil
 }
 
@@ -123,17 +145,23 @@ func (s *MicroServiceService) GetAllSchemaInfo(ctx context.Context, in *pb.GetAl
 		}, errDo
 	}
 
-	respWithSchema := &registry.PluginResponse{}
-	if in.WithSchema {
-		key := apt.GenerateServiceSchemaKey(domainProject, in.ServiceId, "")
-		opts := append(serviceUtil.FromContext(ctx), registry.WithStrKey(key), registry.WithPrefix())
-		respWithSchema, errDo = backend.Store().Schema().Search(ctx, opts...)
-		if errDo != nil {
-			util.Logger().Errorf(errDo, "get schema failed, serviceId %s: get schema info failed.", in.ServiceId)
-			return &pb.GetAllSchemaResponse{
-				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, errDo.Error()),
-			}, errDo
-		}
+	key = apt.GenerateServiceSchemaTypeKey(domainProject, in.ServiceId, "")
+	respType, errDo := backend.Registry().Do(ctx, registry.GET, registry.WithStrKey(key), registry.WithPrefix())
+	if errDo != nil {
+		util.Logger().Errorf(errDo, "get schema failed, serviceId %s: get schema type failed.", in.ServiceId)
+		return &pb.GetAllSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, errDo.Error()),
+		}, errDo
+	}
+
+	key = apt.GenerateServiceSchemaKey(domainProject, in.ServiceId, "")
+	opts = append(serviceUtil.FromContext(ctx), registry.WithStrKey(key), registry.WithPrefix())
+	respWithSchema, errDo := backend.Store().Schema().Search(ctx, opts...)
+	if errDo != nil {
+		util.Logger().Errorf(errDo, "get schema failed, serviceId %s: get schema info failed.", in.ServiceId)
+		return &pb.GetAllSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, errDo.Error()),
+		}, errDo
 	}
 
 	schemas := make([]*pb.Schema, 0, len(schemasList))
@@ -147,10 +175,23 @@ func (s *MicroServiceService) GetAllSchemaInfo(ctx context.Context, in *pb.GetAl
 			}
 		}
 
+		for _, typeSchema := range respType.Kvs {
+			schemaIdOfType, typeData := pb.GetInfoFromSchemaKV(typeSchema)
+			if schemaId == schemaIdOfType {
+				tempSchema.SchemaType = util.BytesToStringWithNoCopy(typeData)
+			}
+		}
+
 		for _, contentSchema := range respWithSchema.Kvs {
 			schemaIdOfSchema, schemaData := pb.GetInfoFromSchemaKV(contentSchema)
-			if schemaId == schemaIdOfSchema {
-				tempSchema.Schema = util.BytesToStringWithNoCopy(schemaData)
+			if schemaId != schemaIdOfSchema {
+				continue
+			}
+			if err := fillSchemaContentInfo(ctx, domainProject, in.ServiceId, tempSchema, schemaData, in.WithSchema); err != nil {
+				util.Logger().Errorf(err, "get schema failed, serviceId %s, schemaId %s: get schema content failed.", in.ServiceId, schemaId)
+				return &pb.GetAllSchemaResponse{
+					Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+				}, err
 			}
 		}
 		schemas = append(schemas, tempSchema)
@@ -179,6 +220,12 @@ func (s *MicroServiceService) DeleteSchema(ctx context.Context, in *pb.DeleteSch
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "delete schema failed, serviceId %s, schemaId %s: check owner failed.", in.ServiceId, in.SchemaId)
+		return &pb.DeleteSchemaResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	key := apt.GenerateServiceSchemaKey(domainProject, in.ServiceId, in.SchemaId)
 	exist, err := serviceUtil.CheckSchemaInfoExist(ctx, key)
@@ -194,11 +241,17 @@ func (s *MicroServiceService) DeleteSchema(ctx context.Context, in *pb.DeleteSch
 			Response: pb.CreateResponse(scerr.ErrSchemaNotExists, "Schema info does not exist."),
 		}, nil
 	}
-	epSummaryKey := apt.GenerateServiceSchemaSummaryKey(domainProject, in.ServiceId, in.SchemaId)
-	opts := []registry.PluginOp{
-		registry.OpDel(registry.WithStrKey(epSummaryKey)),
-		registry.OpDel(registry.WithStrKey(key)),
+	content, err := getSchemaContent(ctx, domainProject, in.ServiceId, in.SchemaId)
+	if err != nil {
+		util.Logger().Errorf(err, "delete schema failed, serviceId %s, schemaId %s: get schema content failed.", in.ServiceId, in.SchemaId)
+		return &pb.DeleteSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
 	}
+	opts := schemaWithDatabaseOpera(registry.OpDel, domainProject, in.ServiceId, &pb.Schema{
+		SchemaId: in.SchemaId,
+		Schema:   content,
+	})
 
 	resp, errDo := backend.Registry().TxnWithCmp(ctx, opts,
 		[]registry.CompareOp{registry.OpCmp(
@@ -218,6 +271,9 @@ func (s *MicroServiceService) DeleteSchema(ctx context.Context, in *pb.DeleteSch
 		}, nil
 	}
 
+	serviceUtil.GCSchemaContent(ctx, domainProject, serviceUtil.SchemaContentHash(content))
+
+	auditlog.AddChange(ctx, auditlog.ENTITY_SCHEMA, in.ServiceId, in.SchemaId, schemaAuditOf(content), nil)
 	util.Logger().Infof("delete schema info successfully.%s", in.SchemaId)
 	return &pb.DeleteSchemaResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Delete schema info successfully."),
@@ -235,6 +291,12 @@ func (s *MicroServiceService) ModifySchemas(ctx context.Context, in *pb.ModifySc
 	serviceId := in.ServiceId
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckBatchSize(ctx, domainProject, quota.SchemaQuotaType, len(in.Schemas)); err != nil {
+		util.Logger().Errorf(err, "modify schemas failed: invalid params.")
+		return &pb.ModifySchemasResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	service, err := serviceUtil.GetService(ctx, domainProject, serviceId)
 	if err != nil {
@@ -249,6 +311,12 @@ func (s *MicroServiceService) ModifySchemas(ctx context.Context, in *pb.ModifySc
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, serviceId); err != nil {
+		util.Logger().Errorf(err, "modify schemas failed: check owner failed. %s", serviceId)
+		return &pb.ModifySchemasResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	respErr := modifySchemas(ctx, domainProject, service, in.Schemas)
 	if respErr != nil {
@@ -322,6 +390,13 @@ func schemasAnalysis(schemas []*pb.Schema, schemasFromDb []*pb.Schema, schemaIds
 
 func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroService, schemas []*pb.Schema) *scerr.Error {
 	serviceId := service.ServiceId
+	for _, schema := range schemas {
+		if err := validateSchemaContent(schema); err != nil {
+			util.Logger().Errorf(err, "modify schemas failed, serviceId %s, schemaId %s: invalid schema.", serviceId, schema.SchemaId)
+			return err
+		}
+	}
+
 	schemasFromDatabase, err := GetSchemasFromDatabase(ctx, domainProject, serviceId)
 	if err != nil {
 		util.Logger().Errorf(nil, "modify schema failed: get schema from database failed, %s", serviceId)
@@ -330,7 +405,15 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 
 	needUpdateSchemas, needAddSchemas, needDeleteSchemas, nonExistSchemaIds := schemasAnalysis(schemas, schemasFromDatabase, service.Schemas)
 
+	oldSchemas := make(map[string]string, len(schemasFromDatabase))
+	for _, schema := range schemasFromDatabase {
+		oldSchemas[schema.SchemaId] = schema.Schema
+	}
+
 	pluginOps := make([]registry.PluginOp, 0)
+	releasedHashes := make([]string, 0, len(needUpdateSchemas)+len(needDeleteSchemas))
+	// the schemas committed, for the audit log
+	var changedSchemas, deletedSchemas []*pb.Schema
 	if len(service.Environment) == 0 || service.Environment == pb.ENV_PROD {
 		if len(service.Schemas) == 0 {
 			res := quota.NewApplyQuotaResource(quota.SchemaQuotaType, domainProject, serviceId, int64(len(nonExistSchemaIds)))
@@ -360,8 +443,21 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 					return scerr.NewError(scerr.ErrInternal, err.Error())
 				}
 				if !exist {
-					opts := schemaWithDatabaseOpera(registry.OpPut, domainProject, serviceId, needUpdateSchema)
+					if err := checkSchemaCompatibility(ctx, domainProject, service, needUpdateSchema.SchemaId,
+						oldSchemas[needUpdateSchema.SchemaId], needUpdateSchema.Schema); err != nil {
+						return err
+					}
+					opts, err := schemaWithRevisionOpera(ctx, domainProject, service, needUpdateSchema)
+					if err != nil {
+						return scerr.NewError(scerr.ErrInternal, err.Error())
+					}
 					pluginOps = append(pluginOps, opts...)
+					changedSchemas = append(changedSchemas, needUpdateSchema)
+					if op, hash, ok := releaseSchemaContentOpera(domainProject, serviceId, needUpdateSchema.SchemaId,
+						oldSchemas[needUpdateSchema.SchemaId], needUpdateSchema.Schema); ok {
+						pluginOps = append(pluginOps, op)
+						releasedHashes = append(releasedHashes, hash)
+					}
 				} else {
 					util.Logger().Warnf(nil, "schema and summary already exist, skip to update, serviceId %s, schemaId %s", serviceId, needUpdateSchema.SchemaId)
 				}
@@ -370,8 +466,12 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 
 		for _, schema := range needAddSchemas {
 			util.Logger().Infof("add new schema: serviceId %s, schemaId %s", serviceId, schema.SchemaId)
-			opts := schemaWithDatabaseOpera(registry.OpPut, domainProject, service.ServiceId, schema)
+			opts, err := schemaWithRevisionOpera(ctx, domainProject, service, schema)
+			if err != nil {
+				return scerr.NewError(scerr.ErrInternal, err.Error())
+			}
 			pluginOps = append(pluginOps, opts...)
+			changedSchemas = append(changedSchemas, schema)
 		}
 	} else {
 		quotaSize := len(needAddSchemas) - len(needDeleteSchemas)
@@ -388,15 +488,32 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 		var schemaIds []string
 		for _, schema := range needAddSchemas {
 			util.Logger().Infof("add new schema: serviceId %s, schemaId %s", serviceId, schema.SchemaId)
-			opts := schemaWithDatabaseOpera(registry.OpPut, domainProject, service.ServiceId, schema)
+			opts, err := schemaWithRevisionOpera(ctx, domainProject, service, schema)
+			if err != nil {
+				return scerr.NewError(scerr.ErrInternal, err.Error())
+			}
 			pluginOps = append(pluginOps, opts...)
+			changedSchemas = append(changedSchemas, schema)
 			schemaIds = append(schemaIds, schema.SchemaId)
 		}
 
 		for _, schema := range needUpdateSchemas {
+			if err := checkSchemaCompatibility(ctx, domainProject, service, schema.SchemaId,
+				oldSchemas[schema.SchemaId], schema.Schema); err != nil {
+				return err
+			}
 			util.Logger().Infof("update schema: serviceId %s, schemaId %s", serviceId, schema.SchemaId)
-			opts := schemaWithDatabaseOpera(registry.OpPut, domainProject, serviceId, schema)
+			opts, err := schemaWithRevisionOpera(ctx, domainProject, service, schema)
+			if err != nil {
+				return scerr.NewError(scerr.ErrInternal, err.Error())
+			}
 			pluginOps = append(pluginOps, opts...)
+			if op, hash, ok := releaseSchemaContentOpera(domainProject, serviceId, schema.SchemaId,
+				oldSchemas[schema.SchemaId], schema.Schema); ok {
+				pluginOps = append(pluginOps, op)
+				releasedHashes = append(releasedHashes, hash)
+			}
+			changedSchemas = append(changedSchemas, schema)
 			schemaIds = append(schemaIds, schema.SchemaId)
 		}
 
@@ -404,7 +521,9 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 			util.Logger().Infof("delete non-exist schema: serviceId %s, schemaId %s", serviceId, schema.SchemaId)
 			opts := schemaWithDatabaseOpera(registry.OpDel, domainProject, serviceId, schema)
 			pluginOps = append(pluginOps, opts...)
+			releasedHashes = append(releasedHashes, serviceUtil.SchemaContentHash(schema.Schema))
 		}
+		deletedSchemas = needDeleteSchemas
 
 		service.Schemas = schemaIds
 		opt, err := serviceUtil.UpdateService(domainProject, serviceId, service)
@@ -416,7 +535,7 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 	}
 
 	if len(pluginOps) != 0 {
-		resp, err := backend.BatchCommitWithCmp(ctx, pluginOps,
+		resp, err := backend.BatchCommitWithCmp(ctx, distinctPluginOps(pluginOps),
 			[]registry.CompareOp{registry.OpCmp(
 				registry.CmpVer(util.StringToBytesWithNoCopy(apt.GenerateServiceKey(domainProject, serviceId))),
 				registry.CMP_NOT_EQUAL, 0)},
@@ -427,6 +546,19 @@ func modifySchemas(ctx context.Context, domainProject string, service *pb.MicroS
 		if !resp.Succeeded {
 			return scerr.NewError(scerr.ErrServiceNotExists, "Service does not exist.")
 		}
+		serviceUtil.GCSchemaContent(ctx, domainProject, releasedHashes...)
+	}
+	changedSchemaIds := make([]string, 0, len(changedSchemas))
+	for _, schema := range changedSchemas {
+		changedSchemaIds = append(changedSchemaIds, schema.SchemaId)
+	}
+	pruneSchemaRevisions(ctx, domainProject, serviceId, changedSchemaIds...)
+	for _, schema := range changedSchemas {
+		auditlog.AddChange(ctx, auditlog.ENTITY_SCHEMA, serviceId, schema.SchemaId,
+			schemaAuditOf(oldSchemas[schema.SchemaId]), schemaAuditOf(schema.Schema))
+	}
+	for _, schema := range deletedSchemas {
+		auditlog.AddChange(ctx, auditlog.ENTITY_SCHEMA, serviceId, schema.SchemaId, schemaAuditOf(schema.Schema), nil)
 	}
 	return nil
 }
@@ -446,18 +578,66 @@ func schemaWithDatabaseOpera(invoke registry.Operation, domainProject string, se
 	pluginOps := make([]registry.PluginOp, 0)
 	key := apt.GenerateServiceSchemaKey(domainProject, serviceId, schema.SchemaId)
 	opt := invoke(registry.WithStrKey(key), registry.WithStrValue(schema.Schema))
-	pluginOps = append(pluginOps, opt)
+	if opt.Action == registry.Put {
+		// the content is stored once, and the schema key refers to it
+		pluginOps = append(pluginOps, serviceUtil.SchemaContentOpera(domainProject, serviceId, schema.SchemaId, schema.Schema)...)
+	} else {
+		pluginOps = append(pluginOps, opt,
+			serviceUtil.ReleaseSchemaContentOpera(domainProject, serviceId, schema.SchemaId, schema.Schema))
+	}
 	keySummary := apt.GenerateServiceSchemaSummaryKey(domainProject, serviceId, schema.SchemaId)
 	opt = invoke(registry.WithStrKey(keySummary), registry.WithStrValue(schema.Summary))
 	pluginOps = append(pluginOps, opt)
+	keyType := apt.GenerateServiceSchemaTypeKey(domainProject, serviceId, schema.SchemaId)
+	opt = invoke(registry.WithStrKey(keyType), registry.WithStrValue(schema.SchemaType))
+	pluginOps = append(pluginOps, opt)
 	return pluginOps
 }
 
+// releaseSchemaContentOpera returns the operation to release the old
+// content of the schema if the content is changed
+func releaseSchemaContentOpera(domainProject, serviceId, schemaId, oldSchema, newSchema string) (registry.PluginOp, string, bool) {
+	if len(oldSchema) == 0 || oldSchema == newSchema {
+		return registry.PluginOp{}, "", false
+	}
+	return serviceUtil.ReleaseSchemaContentOpera(domainProject, serviceId, schemaId, oldSchema),
+		serviceUtil.SchemaContentHash(oldSchema), true
+}
+
+// distinctPluginOps removes the duplicate puts of the same key, etcd rejects
+// the txn which modifies a key more than once, and the schemas with the same
+// content put the same content key
+func distinctPluginOps(opts []registry.PluginOp) []registry.PluginOp {
+	keys := make(map[string]struct{}, len(opts))
+	distinct := make([]registry.PluginOp, 0, len(opts))
+	for _, op := range opts {
+		if op.Action == registry.Put {
+			key := util.BytesToStringWithNoCopy(op.Key)
+			if _, ok := keys[key]; ok {
+				continue
+			}
+			keys[key] = struct{}{}
+		}
+		distinct = append(distinct, op)
+	}
+	return distinct
+}
+
+func schemaWithRevisionOpera(ctx context.Context, domainProject string, service *pb.MicroService, schema *pb.Schema) ([]registry.PluginOp, error) {
+	revisionOps, err := schemaRevisionOpera(ctx, domainProject, service, schema)
+	if err != nil {
+		util.Logger().Errorf(err, "record schema revision failed, serviceId %s, schemaId %s", service.ServiceId, schema.SchemaId)
+		return nil, err
+	}
+	return append(schemaWithDatabaseOpera(registry.OpPut, domainProject, service.ServiceId, schema), revisionOps...), nil
+}
+
 func GetSchemasFromDatabase(ctx context.Context, domainProject string, serviceId string) ([]*pb.Schema, error) {
 	key := apt.GenerateServiceSchemaKey(domainProject, serviceId, "")
 	resp, err := backend.Store().Schema().Search(ctx,
 		registry.WithPrefix(),
-		registry.WithStrKey(key))
+		registry.WithStrKey(key),
+		registry.WithNoCache())
 	if err != nil {
 		util.Logger().Errorf(err, "Get schema of one service failed. %s", serviceId)
 		return nil, err
@@ -467,7 +647,11 @@ func GetSchemasFromDatabase(ctx context.Context, domainProject string, serviceId
 		key := util.BytesToStringWithNoCopy(kv.Key)
 		tmp := strings.Split(key, "/")
 		schemaId := tmp[len(tmp)-1]
-		schema := util.BytesToStringWithNoCopy(kv.Value)
+		schema, err := serviceUtil.ResolveSchemaContent(ctx, domainProject, kv.Value)
+		if err != nil {
+			util.Logger().Errorf(err, "Get schema content of one service failed. %s %s", serviceId, schemaId)
+			return nil, err
+		}
 		schemaStruct := &pb.Schema{
 			SchemaId: schemaId,
 			Schema:   schema,
@@ -477,6 +661,53 @@ func GetSchemasFromDatabase(ctx context.Context, domainProject string, serviceId
 	return schemas, nil
 }
 
+func (s *MicroServiceService) CheckSchemaCompatibility(ctx context.Context, in *pb.CheckSchemaCompatibilityRequest) (*pb.CheckSchemaCompatibilityResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "check schema compatibility failed, serviceId %s, schemaId %s: invalid params.", in.ServiceId, in.SchemaId)
+		return &pb.CheckSchemaCompatibilityResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	service, err := serviceUtil.GetService(ctx, domainProject, in.ServiceId)
+	if err != nil {
+		util.Logger().Errorf(err, "check schema compatibility failed, serviceId %s, schemaId %s: get service failed.", in.ServiceId, in.SchemaId)
+		return &pb.CheckSchemaCompatibilityResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+	if service == nil {
+		util.Logger().Errorf(nil, "check schema compatibility failed, serviceId %s, schemaId %s: service not exist.", in.ServiceId, in.SchemaId)
+		return &pb.CheckSchemaCompatibilityResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
+		}, nil
+	}
+
+	oldSchema, err := getSchemaContent(ctx, domainProject, in.ServiceId, in.SchemaId)
+	if err != nil {
+		util.Logger().Errorf(err, "check schema compatibility failed, serviceId %s, schemaId %s: get schema failed.", in.ServiceId, in.SchemaId)
+		return &pb.CheckSchemaCompatibilityResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+
+	report, err := serviceUtil.NewSchemaCompatibilityReport(ctx, domainProject, service, in.SchemaId, oldSchema, in.Schema)
+	if err != nil {
+		util.Logger().Errorf(err, "check schema compatibility failed, serviceId %s, schemaId %s: get consumers failed.", in.ServiceId, in.SchemaId)
+		return &pb.CheckSchemaCompatibilityResponse{
+			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
+		}, err
+	}
+
+	return &pb.CheckSchemaCompatibilityResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Check schema compatibility successfully."),
+		Report:   report,
+	}, nil
+}
+
 func (s *MicroServiceService) ModifySchema(ctx context.Context, request *pb.ModifySchemaRequest) (*pb.ModifySchemaResponse, error) {
 	domainProject := util.ParseDomainProject(ctx)
 	respErr := s.canModifySchema(ctx, domainProject, request)
@@ -529,6 +760,11 @@ func (s *MicroServiceService) canModifySchema(ctx context.Context, domainProject
 		return scerr.NewError(scerr.ErrInvalidParams, err.Error())
 	}
 
+	if respErr := serviceUtil.CheckServiceOwner(ctx, domainProject, serviceId); respErr != nil {
+		util.Logger().Errorf(respErr, "update schema failed, serviceId %s, schemaId %s: check owner failed.", serviceId, schemaId)
+		return respErr
+	}
+
 	res := quota.NewApplyQuotaResource(quota.SchemaQuotaType, domainProject, serviceId, 1)
 	rst := plugin.Plugins().Quota().Apply4Quotas(ctx, res)
 	errQuota := rst.Err
@@ -546,6 +782,11 @@ func (s *MicroServiceService) modifySchema(ctx context.Context, serviceId string
 	domainProject := util.ParseDomainProject(ctx)
 	schemaId := schema.SchemaId
 
+	if err := validateSchemaContent(schema); err != nil {
+		util.Logger().Errorf(err, "modify schema failed, serviceId %s, schemaId %s: invalid schema.", serviceId, schemaId)
+		return err
+	}
+
 	service, err := serviceUtil.GetService(ctx, domainProject, serviceId)
 	if err != nil {
 		util.Logger().Errorf(err, "modify schema failed, serviceId %s, schemaId %s: get service failed.", serviceId, schemaId)
@@ -565,7 +806,7 @@ func (s *MicroServiceService) modifySchema(ctx context.Context, serviceId string
 		}
 
 		key := apt.GenerateServiceSchemaKey(domainProject, serviceId, schemaId)
-		respSchema, err := backend.Store().Schema().Search(ctx, registry.WithStrKey(key), registry.WithCountOnly())
+		respSchema, err := backend.Store().Schema().Search(ctx, registry.WithStrKey(key), registry.WithCountOnly(), registry.WithNoCache())
 		if err != nil {
 			util.Logger().Errorf(err, "modify schema failed, get schema summary failed, %s %s", serviceId, schemaId)
 			return scerr.NewError(scerr.ErrUnavailableBackend, err.Error())
@@ -609,8 +850,27 @@ func (s *MicroServiceService) modifySchema(ctx context.Context, serviceId string
 		}
 	}
 
+	oldSchema, err := getSchemaContent(ctx, domainProject, serviceId, schemaId)
+	if err != nil {
+		util.Logger().Errorf(err, "modify schema failed, get old schema failed, %s %s", serviceId, schemaId)
+		return scerr.NewError(scerr.ErrUnavailableBackend, err.Error())
+	}
+	if err := checkSchemaCompatibility(ctx, domainProject, service, schemaId, oldSchema, schema.Schema); err != nil {
+		return err
+	}
+
 	opts := CommitSchemaInfo(domainProject, serviceId, schema)
 	pluginOps = append(pluginOps, opts...)
+	revisionOps, err := schemaRevisionOpera(ctx, domainProject, service, schema)
+	if err != nil {
+		util.Logger().Errorf(err, "modify schema failed, serviceId %s, schemaId %s: record revision failed.", serviceId, schemaId)
+		return scerr.NewError(scerr.ErrInternal, err.Error())
+	}
+	pluginOps = append(pluginOps, revisionOps...)
+	releaseOp, releasedHash, released := releaseSchemaContentOpera(domainProject, serviceId, schemaId, oldSchema, schema.Schema)
+	if released {
+		pluginOps = append(pluginOps, releaseOp)
+	}
 
 	resp, err := backend.Registry().TxnWithCmp(ctx, pluginOps,
 		[]registry.CompareOp{registry.OpCmp(
@@ -623,6 +883,11 @@ func (s *MicroServiceService) modifySchema(ctx context.Context, serviceId string
 	if !resp.Succeeded {
 		return scerr.NewError(scerr.ErrServiceNotExists, "Service does not exist.")
 	}
+	if released {
+		serviceUtil.GCSchemaContent(ctx, domainProject, releasedHash)
+	}
+	pruneSchemaRevisions(ctx, domainProject, serviceId, schemaId)
+	auditlog.AddChange(ctx, auditlog.ENTITY_SCHEMA, serviceId, schemaId, schemaAuditOf(oldSchema), schemaAuditOf(schema.Schema))
 	return nil
 }
 
@@ -642,9 +907,10 @@ func CommitSchemaInfo(domainProject string, serviceId string, schema *pb.Schema)
 	if len(schema.Summary) != 0 {
 		return schemaWithDatabaseOpera(registry.OpPut, domainProject, serviceId, schema)
 	} else {
-		key := apt.GenerateServiceSchemaKey(domainProject, serviceId, schema.SchemaId)
-		opt := registry.OpPut(registry.WithStrKey(key), registry.WithStrValue(schema.Schema))
-		return []registry.PluginOp{opt}
+		opts := serviceUtil.SchemaContentOpera(domainProject, serviceId, schema.SchemaId, schema.Schema)
+		keyType := apt.GenerateServiceSchemaTypeKey(domainProject, serviceId, schema.SchemaId)
+		optType := registry.OpPut(registry.WithStrKey(keyType), registry.WithStrValue(schema.SchemaType))
+		return append(opts, optType)
 	}
 }
 
@@ -674,3 +940,113 @@ func getSchemaSummary(ctx context.Context, domainProject string, serviceId strin
 	}
 	return util.BytesToStringWithNoCopy(resp.Kvs[0].Value), nil
 }
+
+func getSchemaType(ctx context.Context, domainProject string, serviceId string, schemaId string) (string, error) {
+	key := apt.GenerateServiceSchemaTypeKey(domainProject, serviceId, schemaId)
+	resp, err := backend.Registry().Do(ctx, registry.GET, registry.WithStrKey(key))
+	if err != nil {
+		util.Logger().Errorf(err, "get %s schema %s type failed", serviceId, schemaId)
+		return "", err
+	}
+	if len(resp.Kvs) == 0 {
+		return "", nil
+	}
+	return util.BytesToStringWithNoCopy(resp.Kvs[0].Value), nil
+}
+
+// schemaAuditOf returns the hash of the schema content recorded in the audit log
+// instead of the content, nil if the schema does not exist
+func schemaAuditOf(content string) interface{} {
+	if len(content) == 0 {
+		return nil
+	}
+	return map[string]string{"hash": serviceUtil.SchemaContentHash(content)}
+}
+
+// validateSchemaContent detects the type of the schema and validates the
+// content by the type, the schema of unknown type is stored as it is
+func validateSchemaContent(in *pb.Schema) *scerr.Error {
+	schemaType, err := schemaTypes.Validate(in.Schema)
+	if err != nil {
+		return scerr.NewError(scerr.ErrInvalidSchema,
+			fmt.Sprintf("invalid %s schema %s: %s", schemaType, in.SchemaId, err.Error()))
+	}
+	in.SchemaType = schemaType
+	return nil
+}
+
+func getSchemaContent(ctx context.Context, domainProject string, serviceId string, schemaId string) (string, error) {
+	key := apt.GenerateServiceSchemaKey(domainProject, serviceId, schemaId)
+	resp, err := backend.Store().Schema().Search(ctx, registry.WithStrKey(key), registry.WithNoCache())
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Kvs) == 0 {
+		return "", nil
+	}
+	return serviceUtil.ResolveSchemaContent(ctx, domainProject, resp.Kvs[0].Value)
+}
+
+// fillSchemaContentInfo fills the content, the computed summary and the
+// other schemas which share the same content
+func fillSchemaContentInfo(ctx context.Context, domainProject, serviceId string, schema *pb.Schema, value []byte, withSchema bool) error {
+	if withSchema || len(schema.Summary) == 0 {
+		content, err := serviceUtil.ResolveSchemaContent(ctx, domainProject, value)
+		if err != nil {
+			return err
+		}
+		if withSchema {
+			schema.Schema = content
+		}
+		if len(schema.Summary) == 0 {
+			schema.Summary = serviceUtil.CanonicalSchemaSummary(content)
+		}
+	}
+
+	refs, err := serviceUtil.GetSchemaContentRefs(ctx, domainProject, serviceUtil.GetSchemaContentHash(value))
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		if ref.ServiceId == serviceId && ref.SchemaId == schema.SchemaId {
+			continue
+		}
+		schema.SharedWith = append(schema.SharedWith, ref)
+	}
+	return nil
+}
+
+// checkSchemaCompatibility applies the compatibility policy of the service
+// environment to the schema change
+func checkSchemaCompatibility(ctx context.Context, domainProject string, service *pb.MicroService,
+	schemaId, oldSchema, newSchema string) *scerr.Error {
+	if len(oldSchema) == 0 || oldSchema == newSchema {
+		return nil
+	}
+	report, err := serviceUtil.NewSchemaCompatibilityReport(ctx, domainProject, service, schemaId, oldSchema, newSchema)
+	if err != nil {
+		util.Logger().Errorf(err, "check schema compatibility failed, serviceId %s, schemaId %s", service.ServiceId, schemaId)
+		return scerr.NewError(scerr.ErrInternal, err.Error())
+	}
+	if report.Compatible || report.Policy == pb.SCHEMA_POLICY_ALLOW {
+		return nil
+	}
+
+	breaking := report.BreakingChanges()
+	changes := make([]string, 0, len(breaking))
+	for _, change := range breaking {
+		changes = append(changes, change.Operation+" "+change.Message)
+	}
+	message := fmt.Sprintf("schema %s has %d incompatible change(s) which affect %d consumer(s): %s",
+		schemaId, len(breaking), len(report.Consumers), util.StringJoin(changes, "; "))
+	if report.Policy == pb.SCHEMA_POLICY_REJECT {
+		util.Logger().Errorf(nil, "modify schema failed, serviceId %s, %s", service.ServiceId, message)
+		return scerr.NewError(scerr.ErrIncompatibleSchema, message)
+	}
+	util.Logger().Warnf(nil, "serviceId %s, %s", service.ServiceId, message)
+	if warn, _ := ctx.Value(serviceUtil.CTX_RESPONSE_SCHEMA_WARN).(string); len(warn) > 0 {
+		message = warn + "; " + message
+	}
+	util.SetContext(ctx, serviceUtil.CTX_RESPONSE_SCHEMA_WARN, message)
+	return nil
+}
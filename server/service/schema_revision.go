@@ -0,0 +1,370 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"golang.org/x/net/context"
+	"strconv"
+	"time"
+)
+
+func (s *MicroServiceService) GetSchemaRevisions(ctx context.Context, in *pb.GetSchemaRevisionsRequest) (*pb.GetSchemaRevisionsResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "get schema revisions failed, serviceId %s, schemaId %s: invalid params.", in.ServiceId, in.SchemaId)
+		return &pb.GetSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
+		util.Logger().Errorf(nil, "get schema revisions failed, serviceId %s, schemaId %s: service not exist.", in.ServiceId, in.SchemaId)
+		return &pb.GetSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
+		}, nil
+	}
+
+	revisions, err := getSchemaRevisions(ctx, domainProject, in.ServiceId, in.SchemaId)
+	if err != nil {
+		util.Logger().Errorf(err, "get schema revisions failed, serviceId %s, schemaId %s: get revisions failed.", in.ServiceId, in.SchemaId)
+		return &pb.GetSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	for _, revision := range revisions {
+		if !in.WithSchema {
+			revision.Schema = ""
+			continue
+		}
+		if err := resolveSchemaRevision(ctx, domainProject, revision); err != nil {
+			util.Logger().Errorf(err, "get schema revisions failed, serviceId %s, schemaId %s: get revision %s content failed.",
+				in.ServiceId, in.SchemaId, revision.Revision)
+			return &pb.GetSchemaRevisionsResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+	}
+
+	return &pb.GetSchemaRevisionsResponse{
+		Response:  pb.CreateResponse(pb.Response_SUCCESS, "Get schema revisions successfully."),
+		Revisions: revisions,
+	}, nil
+}
+
+func (s *MicroServiceService) DiffSchemaRevisions(ctx context.Context, in *pb.DiffSchemaRevisionsRequest) (*pb.DiffSchemaRevisionsResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "diff schema revisions failed, serviceId %s, schemaId %s: invalid params.", in.ServiceId, in.SchemaId)
+		return &pb.DiffSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
+		util.Logger().Errorf(nil, "diff schema revisions failed, serviceId %s, schemaId %s: service not exist.", in.ServiceId, in.SchemaId)
+		return &pb.DiffSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
+		}, nil
+	}
+
+	revisions, err := getSchemaRevisions(ctx, domainProject, in.ServiceId, in.SchemaId)
+	if err != nil {
+		util.Logger().Errorf(err, "diff schema revisions failed, serviceId %s, schemaId %s: get revisions failed.", in.ServiceId, in.SchemaId)
+		return &pb.DiffSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+
+	var base, target *pb.SchemaRevision
+	for _, revision := range revisions {
+		if revision.Revision == in.Base {
+			base = revision
+		}
+		if revision.Revision == in.Target {
+			target = revision
+		}
+	}
+	if len(in.Target) == 0 && len(revisions) > 0 {
+		target = revisions[0]
+	}
+	if base == nil || target == nil {
+		util.Logger().Errorf(nil, "diff schema revisions failed, serviceId %s, schemaId %s: revision %s or %s not exist.",
+			in.ServiceId, in.SchemaId, in.Base, in.Target)
+		return &pb.DiffSchemaRevisionsResponse{
+			Response: pb.CreateResponse(scerr.ErrSchemaNotExists, "Schema revision does not exist."),
+		}, nil
+	}
+
+	for _, revision := range []*pb.SchemaRevision{base, target} {
+		if err := resolveSchemaRevision(ctx, domainProject, revision); err != nil {
+			util.Logger().Errorf(err, "diff schema revisions failed, serviceId %s, schemaId %s: get revision %s content failed.",
+				in.ServiceId, in.SchemaId, revision.Revision)
+			return &pb.DiffSchemaRevisionsResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+	}
+
+	changes, err := serviceUtil.CompareSchemas(base.Schema, target.Schema)
+	if err != nil {
+		// only OpenAPI/Swagger schemas have the compatibility analysis
+		changes = nil
+	}
+	resp := &pb.DiffSchemaRevisionsResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Diff schema revisions successfully."),
+		Diffs:    serviceUtil.DiffSchemas(base.Schema, target.Schema),
+		Changes:  changes,
+	}
+	base.Schema, target.Schema = "", ""
+	resp.Base, resp.Target = base, target
+	return resp, nil
+}
+
+func (s *MicroServiceService) RollbackSchema(ctx context.Context, in *pb.RollbackSchemaRequest) (*pb.RollbackSchemaResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "rollback schema failed, serviceId %s, schemaId %s: invalid params.", in.ServiceId, in.SchemaId)
+		return &pb.RollbackSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	revision, err := getSchemaRevision(ctx, domainProject, in.ServiceId, in.SchemaId, in.Revision)
+	if err != nil {
+		util.Logger().Errorf(err, "rollback schema failed, serviceId %s, schemaId %s: get revision %s failed.",
+			in.ServiceId, in.SchemaId, in.Revision)
+		return &pb.RollbackSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if revision == nil {
+		util.Logger().Errorf(nil, "rollback schema failed, serviceId %s, schemaId %s: revision %s not exist.",
+			in.ServiceId, in.SchemaId, in.Revision)
+		return &pb.RollbackSchemaResponse{
+			Response: pb.CreateResponse(scerr.ErrSchemaNotExists, "Schema revision does not exist."),
+		}, nil
+	}
+
+	// rollback is a modification with the old content, so it is subject to
+	// the same rules of the production environment and compatibility policy
+	request := &pb.ModifySchemaRequest{
+		ServiceId: in.ServiceId,
+		SchemaId:  in.SchemaId,
+		Schema:    revision.Schema,
+		Summary:   revision.Summary,
+	}
+	respErr := s.canModifySchema(ctx, domainProject, request)
+	if respErr == nil {
+		util.SetContext(ctx, serviceUtil.CTX_SCHEMA_ROLLBACK_FROM, in.Revision)
+		respErr = s.modifySchema(ctx, in.ServiceId, &pb.Schema{
+			SchemaId: in.SchemaId,
+			Schema:   revision.Schema,
+			Summary:  revision.Summary,
+		})
+	}
+	if respErr != nil {
+		util.Logger().Errorf(respErr, "rollback schema failed, serviceId %s, schemaId %s, revision %s",
+			in.ServiceId, in.SchemaId, in.Revision)
+		resp := &pb.RollbackSchemaResponse{
+			Response: pb.CreateResponseWithSCErr(respErr),
+		}
+		if respErr.InternalError() {
+			return resp, respErr
+		}
+		return resp, nil
+	}
+
+	util.Logger().Infof("rollback schema successfully: serviceId %s, schemaId %s, revision %s.",
+		in.ServiceId, in.SchemaId, in.Revision)
+	return &pb.RollbackSchemaResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Rollback schema successfully."),
+	}, nil
+}
+
+// getSchemaRevisions returns the revisions of the schema, the latest first
+func getSchemaRevisions(ctx context.Context, domainProject, serviceId, schemaId string) ([]*pb.SchemaRevision, error) {
+	key := apt.GenerateServiceSchemaRevisionKey(domainProject, serviceId, schemaId, "")
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(key),
+		registry.WithPrefix(),
+		registry.WithDescendOrder())
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*pb.SchemaRevision, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		revision := &pb.SchemaRevision{}
+		if err := json.Unmarshal(kv.Value, revision); err != nil {
+			util.Logger().Errorf(err, "unmarshal schema revision %s failed", util.BytesToStringWithNoCopy(kv.Key))
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+func getSchemaRevision(ctx context.Context, domainProject, serviceId, schemaId, rev string) (*pb.SchemaRevision, error) {
+	key := apt.GenerateServiceSchemaRevisionKey(domainProject, serviceId, schemaId, rev)
+	resp, err := backend.Registry().Do(ctx, registry.GET, registry.WithStrKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	revision := &pb.SchemaRevision{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, revision); err != nil {
+		return nil, err
+	}
+	if err := resolveSchemaRevision(ctx, domainProject, revision); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// resolveSchemaRevision replaces the address stored in the revision with
+// the content, the old revisions store the content inline
+func resolveSchemaRevision(ctx context.Context, domainProject string, revision *pb.SchemaRevision) (err error) {
+	revision.Schema, err = serviceUtil.ResolveSchemaContent(ctx, domainProject, util.StringToBytesWithNoCopy(revision.Schema))
+	return
+}
+
+// schemaRevisionOpera records the schema change as a new revision, the
+// revision is a fixed-width timestamp so that revisions are ordered by key.
+// The revision refers to the content stored with the schema in the same txn
+func schemaRevisionOpera(ctx context.Context, domainProject string, service *pb.MicroService, schema *pb.Schema) ([]registry.PluginOp, error) {
+	now := time.Now()
+	revision := &pb.SchemaRevision{
+		Revision:   fmt.Sprintf("%020d", now.UnixNano()),
+		SchemaId:   schema.SchemaId,
+		Summary:    schema.Summary,
+		Schema:     serviceUtil.SCHEMA_CONTENT_REF_PREFIX + serviceUtil.SchemaContentHash(schema.Schema),
+		SchemaType: schema.SchemaType,
+		Author:     util.ParseOperator(ctx),
+		Timestamp:  strconv.FormatInt(now.Unix(), 10),
+		Action:     pb.SCHEMA_REVISION_MODIFY,
+	}
+	if len(revision.Author) == 0 {
+		revision.Author = service.RegisterBy
+	}
+	if from, _ := ctx.Value(serviceUtil.CTX_SCHEMA_ROLLBACK_FROM).(string); len(from) > 0 {
+		revision.Action = pb.SCHEMA_REVISION_ROLLBACK
+		revision.RollbackFrom = from
+	}
+	data, err := json.Marshal(revision)
+	if err != nil {
+		return nil, err
+	}
+	key := apt.GenerateServiceSchemaRevisionKey(domainProject, service.ServiceId, schema.SchemaId, revision.Revision)
+	return []registry.PluginOp{
+		registry.OpPut(registry.WithStrKey(key), registry.WithValue(data)),
+		serviceUtil.SchemaRevisionContentOpera(domainProject, service.ServiceId, schema.SchemaId, revision.Revision, schema.Schema),
+	}, nil
+}
+
+// pruneSchemaRevisions removes the oldest revisions of the schemas exceeding
+// the schema_revision_limit, and the contents no longer referred
+func pruneSchemaRevisions(ctx context.Context, domainProject, serviceId string, schemaIds ...string) {
+	limit := int(apt.ServerInfo.Config.SchemaRevisionLimit)
+	if limit <= 0 {
+		return
+	}
+	var (
+		opts   []registry.PluginOp
+		hashes []string
+	)
+	for _, schemaId := range schemaIds {
+		revisions, err := getSchemaRevisions(ctx, domainProject, serviceId, schemaId)
+		if err != nil {
+			util.Logger().Errorf(err, "prune schema revisions failed, serviceId %s, schemaId %s", serviceId, schemaId)
+			continue
+		}
+		if len(revisions) <= limit {
+			continue
+		}
+		for _, revision := range revisions[limit:] {
+			op, hash, ok := schemaRevisionDeleteOpera(domainProject, serviceId, revision)
+			opts = append(opts, op...)
+			if ok {
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+	if len(opts) == 0 {
+		return
+	}
+	if err := backend.BatchCommit(ctx, opts); err != nil {
+		util.Logger().Errorf(err, "prune schema revisions failed, serviceId %s", serviceId)
+		return
+	}
+	serviceUtil.GCSchemaContent(ctx, domainProject, hashes...)
+}
+
+// releaseServiceSchemaRevisionsOpera returns the operations to remove the
+// references of all the schema revisions of the service to the contents, and
+// the released addresses, they are committed after the service is removed
+func releaseServiceSchemaRevisionsOpera(ctx context.Context, domainProject, serviceId string) ([]registry.PluginOp, []string, error) {
+	key := util.StringJoin([]string{apt.GetServiceSchemaRevisionRootKey(domainProject), serviceId, ""}, "/")
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(key),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := make([]registry.PluginOp, 0, len(resp.Kvs))
+	hashes := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		revision := &pb.SchemaRevision{}
+		if err := json.Unmarshal(kv.Value, revision); err != nil {
+			util.Logger().Errorf(err, "unmarshal schema revision %s failed", util.BytesToStringWithNoCopy(kv.Key))
+			continue
+		}
+		op, hash, ok := serviceUtil.ReleaseSchemaRevisionContentOpera(domainProject, serviceId,
+			revision.SchemaId, revision.Revision, revision.Schema)
+		if ok {
+			opts = append(opts, op)
+			hashes = append(hashes, hash)
+		}
+	}
+	return opts, hashes, nil
+}
+
+func schemaRevisionDeleteOpera(domainProject, serviceId string, revision *pb.SchemaRevision) ([]registry.PluginOp, string, bool) {
+	opts := []registry.PluginOp{registry.OpDel(registry.WithStrKey(
+		apt.GenerateServiceSchemaRevisionKey(domainProject, serviceId, revision.SchemaId, revision.Revision)))}
+	op, hash, ok := serviceUtil.ReleaseSchemaRevisionContentOpera(domainProject, serviceId,
+		revision.SchemaId, revision.Revision, revision.Schema)
+	if ok {
+		opts = append(opts, op)
+	}
+	return opts, hash, ok
+}
@@ -0,0 +1,81 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"errors"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"golang.org/x/net/context"
+	"sort"
+)
+
+func (s *MicroServiceService) SearchSchemas(ctx context.Context, in *pb.SearchSchemasRequest) (*pb.SearchSchemasResponse, error) {
+	err := Validate(in)
+	if err == nil && len(in.Keyword)+len(in.Method)+len(in.Path)+len(in.OperationId)+len(in.Tag)+len(in.Model) == 0 {
+		err = errors.New("at least one search criteria is required")
+	}
+	if err != nil {
+		util.Logger().Errorf(err, "search schemas failed: invalid params.")
+		return &pb.SearchSchemasResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domainProject := util.ParseDomainProject(ctx)
+
+	matches := serviceUtil.SchemaSearchIndex().Search(domainProject, in)
+	results := make([]*pb.SchemaSearchResult, 0, len(matches))
+	for _, result := range matches {
+		service, err := serviceUtil.GetService(ctx, domainProject, result.ServiceId)
+		if err != nil {
+			util.Logger().Errorf(err, "search schemas failed: get service %s failed.", result.ServiceId)
+			return &pb.SearchSchemasResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+		if service == nil {
+			// the index is refreshed later than the service is deleted
+			continue
+		}
+		result.Environment = service.Environment
+		result.AppId = service.AppId
+		result.ServiceName = service.ServiceName
+		result.Version = service.Version
+		results = append(results, result)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		switch {
+		case a.AppId != b.AppId:
+			return a.AppId < b.AppId
+		case a.ServiceName != b.ServiceName:
+			return a.ServiceName < b.ServiceName
+		case a.Version != b.Version:
+			return a.Version < b.Version
+		default:
+			return a.SchemaId < b.SchemaId
+		}
+	})
+
+	return &pb.SearchSchemasResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Search schemas successfully."),
+		Results:  results,
+	}, nil
+}
@@ -17,10 +17,12 @@
 package service_test
 
 import (
+	"github.com/apache/incubator-servicecomb-service-center/server/core"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/quota/buildin"
 	"github.com/apache/incubator-servicecomb-service-center/server/service"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"strconv"
@@ -35,6 +37,51 @@ var (
 	TOO_LONG_SUMMARY = strings.Repeat("x", 129)
 )
 
+const (
+	compatSchemaV1 = `
+swagger: "2.0"
+info:
+  title: hello
+  version: 1.0.0
+paths:
+  /hello:
+    get:
+      parameters:
+        - name: name
+          in: query
+          type: string
+      responses:
+        200:
+          description: ok
+          schema:
+            type: string
+  /bye:
+    get:
+      responses:
+        200:
+          description: ok
+`
+	compatSchemaV2 = `
+swagger: "2.0"
+info:
+  title: hello
+  version: 1.0.0
+paths:
+  /hello:
+    get:
+      parameters:
+        - name: name
+          in: query
+          required: true
+          type: string
+      responses:
+        200:
+          description: ok
+          schema:
+            type: string
+`
+)
+
 var _ = Describe("'Schema' service", func() {
 	Describe("execute 'create' operation", func() {
 		var (
@@ -948,7 +995,7 @@ var _ = Describe("'Schema' service", func() {
 				Expect(err).To(BeNil())
 				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
 				Expect(resp.SchemaId).To(Equal("com.huawei.test.no.summary"))
-				Expect(resp.Summary).To(Equal(""))
+				Expect(resp.Summary).To(Equal(serviceUtil.CanonicalSchemaSummary("query schema")))
 			})
 		})
 	})
@@ -1042,8 +1089,10 @@ var _ = Describe("'Schema' service", func() {
 					Expect(schema.Schema).To(BeEmpty())
 				}
 				if schema.SchemaId == schemaId2 {
-					Expect(schema.Summary).To(BeEmpty())
+					Expect(schema.Summary).To(Equal(serviceUtil.CanonicalSchemaSummary(schemaContent)))
 					Expect(schema.Schema).To(BeEmpty())
+					Expect(len(schema.SharedWith)).To(Equal(1))
+					Expect(schema.SharedWith[0].SchemaId).To(Equal(schemaId3))
 				}
 				if schema.SchemaId == schemaId3 {
 					Expect(schema.Summary).To(Equal(summary))
@@ -1064,7 +1113,7 @@ var _ = Describe("'Schema' service", func() {
 					Expect(schema.Schema).To(BeEmpty())
 				}
 				if schema.SchemaId == schemaId2 {
-					Expect(schema.Summary).To(BeEmpty())
+					Expect(schema.Summary).To(Equal(serviceUtil.CanonicalSchemaSummary(schemaContent)))
 					Expect(schema.Schema).To(Equal(schemaContent))
 				}
 				if schema.SchemaId == schemaId3 {
@@ -1262,4 +1311,729 @@ var _ = Describe("'Schema' service", func() {
 			})
 		})
 	})
+
+	Describe("execute 'compatibility' operation", func() {
+		var (
+			devServiceId, prodServiceId, consumerId string
+		)
+
+		It("should be passed", func() {
+			for _, env := range []string{pb.ENV_DEV, pb.ENV_PROD} {
+				respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "compat_schema_group",
+						ServiceName: "compat_schema_service_" + env,
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+						Environment: env,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: respCreateService.ServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV1,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				if env == pb.ENV_DEV {
+					devServiceId = respCreateService.ServiceId
+				} else {
+					prodServiceId = respCreateService.ServiceId
+				}
+			}
+
+			respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "compat_schema_group",
+					ServiceName: "compat_schema_consumer",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+					Environment: pb.ENV_DEV,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			consumerId = respCreateService.ServiceId
+
+			respFind, err := instanceResource.Find(getContext(), &pb.FindInstancesRequest{
+				ConsumerServiceId: consumerId,
+				AppId:             "compat_schema_group",
+				ServiceName:       "compat_schema_service_" + pb.ENV_DEV,
+				VersionRule:       "1.0.0",
+			})
+			Expect(err).To(BeNil())
+			Expect(respFind.Response.Code).To(Equal(pb.Response_SUCCESS))
+		})
+
+		Context("when check the schema compatibility", func() {
+			It("should be failed", func() {
+				resp, err := serviceResource.CheckSchemaCompatibility(getContext(), &pb.CheckSchemaCompatibilityRequest{
+					ServiceId: devServiceId,
+					SchemaId:  invalidSchemaId,
+					Schema:    compatSchemaV2,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				resp, err = serviceResource.CheckSchemaCompatibility(getContext(), &pb.CheckSchemaCompatibilityRequest{
+					ServiceId: "notExistService",
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV2,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrServiceNotExists))
+			})
+
+			It("should be passed", func() {
+				resp, err := serviceResource.CheckSchemaCompatibility(getContext(), &pb.CheckSchemaCompatibilityRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV2,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Report.Analyzed).To(BeTrue())
+				Expect(resp.Report.Compatible).To(BeFalse())
+				Expect(resp.Report.Policy).To(Equal(pb.SCHEMA_POLICY_WARN))
+				Expect(len(resp.Report.BreakingChanges())).To(Equal(2))
+				Expect(len(resp.Report.Consumers)).To(Equal(1))
+				Expect(resp.Report.Consumers[0].ServiceId).To(Equal(consumerId))
+
+				resp, err = serviceResource.CheckSchemaCompatibility(getContext(), &pb.CheckSchemaCompatibilityRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    "not a swagger",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Report.Analyzed).To(BeFalse())
+				Expect(resp.Report.Compatible).To(BeTrue())
+			})
+		})
+
+		Context("when modify schema incompatibly", func() {
+			It("should warn in dev env", func() {
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV2,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+
+			It("should be rejected in prod env", func() {
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: prodServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV2,
+					Summary:   "v2",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrIncompatibleSchema))
+
+				respSchemas, err := serviceResource.ModifySchemas(getContext(), &pb.ModifySchemasRequest{
+					ServiceId: prodServiceId,
+					Schemas: []*pb.Schema{
+						{
+							SchemaId: "com.huawei.test",
+							Schema:   compatSchemaV2,
+							Summary:  "v2",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respSchemas.Response.Code).To(Equal(scerr.ErrIncompatibleSchema))
+			})
+		})
+
+		It("should be deleted", func() {
+			for _, id := range []string{devServiceId, prodServiceId, consumerId} {
+				resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+					ServiceId: id,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			}
+		})
+	})
+
+	Describe("execute 'revision' operation", func() {
+		var (
+			devServiceId, prodServiceId string
+		)
+
+		It("should be passed", func() {
+			for _, env := range []string{pb.ENV_DEV, pb.ENV_PROD} {
+				respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "revision_schema_group",
+						ServiceName: "revision_schema_service_" + env,
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+						Environment: env,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: respCreateService.ServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV1,
+					Summary:   "v1",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				if env == pb.ENV_DEV {
+					devServiceId = respCreateService.ServiceId
+				} else {
+					prodServiceId = respCreateService.ServiceId
+				}
+			}
+
+			resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+				ServiceId: devServiceId,
+				SchemaId:  "com.huawei.test",
+				Schema:    compatSchemaV2,
+				Summary:   "v2",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+		})
+
+		Context("when get the schema revisions", func() {
+			It("should be failed", func() {
+				resp, err := serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  invalidSchemaId,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				resp, err = serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: "notExistService",
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrServiceNotExists))
+			})
+
+			It("should be passed", func() {
+				resp, err := serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(resp.Revisions)).To(Equal(2))
+				Expect(resp.Revisions[0].Summary).To(Equal("v2"))
+				Expect(resp.Revisions[0].Action).To(Equal(pb.SCHEMA_REVISION_MODIFY))
+				Expect(resp.Revisions[0].Schema).To(BeEmpty())
+				Expect(resp.Revisions[1].Summary).To(Equal("v1"))
+
+				resp, err = serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId:  devServiceId,
+					SchemaId:   "com.huawei.test",
+					WithSchema: true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Revisions[1].Schema).To(Equal(compatSchemaV1))
+			})
+		})
+
+		Context("when diff the schema revisions", func() {
+			It("should be failed", func() {
+				resp, err := serviceResource.DiffSchemaRevisions(getContext(), &pb.DiffSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				resp, err = serviceResource.DiffSchemaRevisions(getContext(), &pb.DiffSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Base:      "1",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrSchemaNotExists))
+			})
+
+			It("should be passed", func() {
+				respRevisions, err := serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(len(respRevisions.Revisions)).To(Equal(2))
+
+				resp, err := serviceResource.DiffSchemaRevisions(getContext(), &pb.DiffSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Base:      respRevisions.Revisions[1].Revision,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(resp.Target.Revision).To(Equal(respRevisions.Revisions[0].Revision))
+				Expect(len(resp.Diffs)).NotTo(Equal(0))
+				Expect(len(resp.Changes)).NotTo(Equal(0))
+			})
+		})
+
+		Context("when rollback the schema", func() {
+			It("should be failed", func() {
+				resp, err := serviceResource.RollbackSchema(getContext(), &pb.RollbackSchemaRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Revision:  "x",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				resp, err = serviceResource.RollbackSchema(getContext(), &pb.RollbackSchemaRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Revision:  "1",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrSchemaNotExists))
+			})
+
+			It("should be refused in prod env", func() {
+				respRevisions, err := serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: prodServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(len(respRevisions.Revisions)).To(Equal(1))
+
+				resp, err := serviceResource.RollbackSchema(getContext(), &pb.RollbackSchemaRequest{
+					ServiceId: prodServiceId,
+					SchemaId:  "com.huawei.test",
+					Revision:  respRevisions.Revisions[0].Revision,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrModifySchemaNotAllow))
+			})
+
+			It("should be passed", func() {
+				respRevisions, err := serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(len(respRevisions.Revisions)).To(Equal(2))
+				rev := respRevisions.Revisions[1].Revision
+
+				resp, err := serviceResource.RollbackSchema(getContext(), &pb.RollbackSchemaRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Revision:  rev,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respSchema, err := serviceResource.GetSchemaInfo(getContext(), &pb.GetSchemaRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(respSchema.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respSchema.Schema).To(Equal(compatSchemaV1))
+
+				respRevisions, err = serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+				})
+				Expect(err).To(BeNil())
+				Expect(len(respRevisions.Revisions)).To(Equal(3))
+				Expect(respRevisions.Revisions[0].Action).To(Equal(pb.SCHEMA_REVISION_ROLLBACK))
+				Expect(respRevisions.Revisions[0].RollbackFrom).To(Equal(rev))
+			})
+		})
+
+		Context("when the revisions exceed the limit", func() {
+			It("should remove the oldest revisions", func() {
+				core.ServerInfo.Config.SchemaRevisionLimit = 2
+				defer func() {
+					core.ServerInfo.Config.SchemaRevisionLimit = 20
+				}()
+
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: devServiceId,
+					SchemaId:  "com.huawei.test",
+					Schema:    compatSchemaV2,
+					Summary:   "v3",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respRevisions, err := serviceResource.GetSchemaRevisions(getContext(), &pb.GetSchemaRevisionsRequest{
+					ServiceId:  devServiceId,
+					SchemaId:   "com.huawei.test",
+					WithSchema: true,
+				})
+				Expect(err).To(BeNil())
+				Expect(len(respRevisions.Revisions)).To(Equal(2))
+				Expect(respRevisions.Revisions[0].Summary).To(Equal("v3"))
+				Expect(respRevisions.Revisions[0].Schema).To(Equal(compatSchemaV2))
+				Expect(respRevisions.Revisions[1].Action).To(Equal(pb.SCHEMA_REVISION_ROLLBACK))
+				Expect(respRevisions.Revisions[1].Schema).To(Equal(compatSchemaV1))
+			})
+		})
+
+		It("should be deleted", func() {
+			for _, id := range []string{devServiceId, prodServiceId} {
+				resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+					ServiceId: id,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			}
+		})
+	})
+
+	Describe("execute 'validate content' operation", func() {
+		var (
+			serviceId string
+		)
+
+		It("should be passed", func() {
+			respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "content_schema_group",
+					ServiceName: "content_schema_service",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+					Environment: pb.ENV_DEV,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			serviceId = respCreateService.ServiceId
+		})
+
+		Context("when upload invalid schemas", func() {
+			It("should be failed", func() {
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: serviceId,
+					SchemaId:  "com.huawei.test.swagger",
+					Schema:    "swagger: \"2.0\"\ninfo:\n  title: hello\n  version: 1.0.0\npaths:\n  /hello:\n    get: {}\n",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidSchema))
+				Expect(resp.Response.Message).To(ContainSubstring("/paths/~1hello/get/responses"))
+
+				resp, err = serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: serviceId,
+					SchemaId:  "com.huawei.test.openapi",
+					Schema:    "{\n  \"openapi\": \"3.0.0\",\n  \"info\": {,\n}",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidSchema))
+				Expect(resp.Response.Message).To(ContainSubstring("line 3"))
+
+				respSchemas, err := serviceResource.ModifySchemas(getContext(), &pb.ModifySchemasRequest{
+					ServiceId: serviceId,
+					Schemas: []*pb.Schema{
+						{
+							SchemaId: "com.huawei.test.plain",
+							Schema:   "plain text schema",
+						},
+						{
+							SchemaId: "com.huawei.test.asyncapi",
+							Schema:   "asyncapi: 2.0.0\ninfo:\n  title: hello\n  version: 1.0.0\n",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respSchemas.Response.Code).To(Equal(scerr.ErrInvalidSchema))
+				Expect(respSchemas.Response.Message).To(ContainSubstring("/channels"))
+			})
+		})
+
+		Context("when upload valid schemas", func() {
+			It("should be passed", func() {
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: serviceId,
+					SchemaId:  "com.huawei.test.swagger",
+					Schema:    compatSchemaV1,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respGet, err := serviceResource.GetSchemaInfo(getContext(), &pb.GetSchemaRequest{
+					ServiceId: serviceId,
+					SchemaId:  "com.huawei.test.swagger",
+				})
+				Expect(err).To(BeNil())
+				Expect(respGet.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respGet.SchemaType).To(Equal("swagger2"))
+
+				respSchemas, err := serviceResource.ModifySchemas(getContext(), &pb.ModifySchemasRequest{
+					ServiceId: serviceId,
+					Schemas: []*pb.Schema{
+						{
+							SchemaId: "com.huawei.test.swagger",
+							Schema:   compatSchemaV1,
+						},
+						{
+							SchemaId: "com.huawei.test.plain",
+							Schema:   "plain text schema",
+						},
+						{
+							SchemaId: "com.huawei.test.asyncapi",
+							Schema:   "asyncapi: 2.0.0\ninfo:\n  title: hello\n  version: 1.0.0\nchannels:\n  hello: {}\n",
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respSchemas.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respAll, err := serviceResource.GetAllSchemaInfo(getContext(), &pb.GetAllSchemaRequest{
+					ServiceId: serviceId,
+				})
+				Expect(err).To(BeNil())
+				Expect(respAll.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respAll.Schemas)).To(Equal(3))
+				types := map[string]string{}
+				for _, schema := range respAll.Schemas {
+					types[schema.SchemaId] = schema.SchemaType
+				}
+				Expect(types["com.huawei.test.swagger"]).To(Equal("swagger2"))
+				Expect(types["com.huawei.test.plain"]).To(BeEmpty())
+				Expect(types["com.huawei.test.asyncapi"]).To(Equal("asyncapi"))
+			})
+		})
+
+		It("should be deleted", func() {
+			resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+				ServiceId: serviceId,
+				Force:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+		})
+	})
+
+	Describe("execute 'share content' operation", func() {
+		var (
+			serviceIds []string
+		)
+
+		It("should be passed", func() {
+			for _, version := range []string{"1.0.0", "1.0.1"} {
+				respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "share_schema_group",
+						ServiceName: "share_schema_service",
+						Version:     version,
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+						Environment: pb.ENV_DEV,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+				serviceIds = append(serviceIds, respCreateService.ServiceId)
+
+				resp, err := serviceResource.ModifySchemas(getContext(), &pb.ModifySchemasRequest{
+					ServiceId: respCreateService.ServiceId,
+					Schemas: []*pb.Schema{
+						{
+							SchemaId: "com.huawei.test.shared",
+							Schema:   compatSchemaV1,
+						},
+						{
+							SchemaId: "com.huawei.test.same",
+							Schema:   compatSchemaV1,
+						},
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			}
+		})
+
+		Context("when get the schemas", func() {
+			It("should expose the shared schemas", func() {
+				resp, err := serviceResource.GetAllSchemaInfo(getContext(), &pb.GetAllSchemaRequest{
+					ServiceId:  serviceIds[0],
+					WithSchema: true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(resp.Schemas)).To(Equal(2))
+				for _, schema := range resp.Schemas {
+					Expect(schema.Schema).To(Equal(compatSchemaV1))
+					Expect(schema.Summary).To(Equal(serviceUtil.CanonicalSchemaSummary(compatSchemaV1)))
+					Expect(len(schema.SharedWith)).To(Equal(3))
+				}
+
+				respGet, err := serviceResource.GetSchemaInfo(getContext(), &pb.GetSchemaRequest{
+					ServiceId: serviceIds[1],
+					SchemaId:  "com.huawei.test.shared",
+				})
+				Expect(err).To(BeNil())
+				Expect(respGet.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respGet.Schema).To(Equal(compatSchemaV1))
+				Expect(respGet.SchemaSummary).To(Equal(serviceUtil.CanonicalSchemaSummary(compatSchemaV1)))
+			})
+		})
+
+		Context("when the shared content is changed", func() {
+			It("should not affect the others", func() {
+				resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+					ServiceId: serviceIds[1],
+					SchemaId:  "com.huawei.test.shared",
+					Schema:    compatSchemaV2,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respDelete, err := serviceResource.DeleteSchema(getContext(), &pb.DeleteSchemaRequest{
+					ServiceId: serviceIds[1],
+					SchemaId:  "com.huawei.test.same",
+				})
+				Expect(err).To(BeNil())
+				Expect(respDelete.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respAll, err := serviceResource.GetAllSchemaInfo(getContext(), &pb.GetAllSchemaRequest{
+					ServiceId:  serviceIds[0],
+					WithSchema: true,
+				})
+				Expect(err).To(BeNil())
+				Expect(respAll.Response.Code).To(Equal(pb.Response_SUCCESS))
+				for _, schema := range respAll.Schemas {
+					Expect(schema.Schema).To(Equal(compatSchemaV1))
+					Expect(len(schema.SharedWith)).To(Equal(1))
+					Expect(schema.SharedWith[0].ServiceId).To(Equal(serviceIds[0]))
+				}
+
+				respGet, err := serviceResource.GetSchemaInfo(getContext(), &pb.GetSchemaRequest{
+					ServiceId: serviceIds[1],
+					SchemaId:  "com.huawei.test.shared",
+				})
+				Expect(err).To(BeNil())
+				Expect(respGet.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(respGet.Schema).To(Equal(compatSchemaV2))
+			})
+		})
+
+		It("should be deleted", func() {
+			for _, id := range serviceIds {
+				resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+					ServiceId: id,
+					Force:     true,
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+			}
+		})
+	})
+
+	Describe("execute 'search' operation", func() {
+		var (
+			serviceId string
+		)
+
+		It("should be passed", func() {
+			respCreateService, err := serviceResource.Create(getContext(), &pb.CreateServiceRequest{
+				Service: &pb.MicroService{
+					AppId:       "search_schema_group",
+					ServiceName: "search_schema_service",
+					Version:     "1.0.0",
+					Level:       "FRONT",
+					Status:      pb.MS_UP,
+					Environment: pb.ENV_DEV,
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(respCreateService.Response.Code).To(Equal(pb.Response_SUCCESS))
+			serviceId = respCreateService.ServiceId
+
+			resp, err := serviceResource.ModifySchema(getContext(), &pb.ModifySchemaRequest{
+				ServiceId: serviceId,
+				SchemaId:  "com.huawei.test.search",
+				Schema:    compatSchemaV1,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+			// the index is refreshed by the schema event handler in server
+			err = serviceUtil.RefreshSchemaIndex(getContext(), "default/default", serviceId, "com.huawei.test.search")
+			Expect(err).To(BeNil())
+		})
+
+		Context("when request is invalid", func() {
+			It("should be failed", func() {
+				resp, err := serviceResource.SearchSchemas(getContext(), &pb.SearchSchemasRequest{})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+
+				resp, err = serviceResource.SearchSchemas(getContext(), &pb.SearchSchemasRequest{
+					Method: "CONNECT",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(scerr.ErrInvalidParams))
+			})
+		})
+
+		Context("when search the operations", func() {
+			It("should return the matched schemas", func() {
+				resp, err := serviceResource.SearchSchemas(getContext(), &pb.SearchSchemasRequest{
+					Method: "get",
+					Path:   "/hello",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(resp.Results)).To(Equal(1))
+				Expect(resp.Results[0].ServiceId).To(Equal(serviceId))
+				Expect(resp.Results[0].ServiceName).To(Equal("search_schema_service"))
+				Expect(resp.Results[0].Version).To(Equal("1.0.0"))
+				Expect(resp.Results[0].SchemaId).To(Equal("com.huawei.test.search"))
+				Expect(len(resp.Results[0].Operations)).To(Equal(1))
+				Expect(resp.Results[0].Operations[0].Path).To(Equal("/hello"))
+
+				resp, err = serviceResource.SearchSchemas(getContext(), &pb.SearchSchemasRequest{
+					Method: "POST",
+					Path:   "/hello",
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(resp.Results)).To(Equal(0))
+			})
+		})
+
+		It("should be deleted", func() {
+			resp, err := serviceResource.Delete(getContext(), &pb.DeleteServiceRequest{
+				ServiceId: serviceId,
+				Force:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+			respSearch, err := serviceResource.SearchSchemas(getContext(), &pb.SearchSchemasRequest{
+				Keyword: "bye",
+			})
+			Expect(err).To(BeNil())
+			Expect(respSearch.Response.Code).To(Equal(pb.Response_SUCCESS))
+			for _, result := range respSearch.Results {
+				Expect(result.ServiceId).NotTo(Equal(serviceId))
+			}
+		})
+	})
 })
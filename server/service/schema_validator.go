@@ -18,19 +18,24 @@ package service
 
 import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/validate"
-	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"regexp"
 )
 
 var (
-	getSchemaReqValidator     validate.Validator
-	modifySchemasReqValidator validate.Validator
-	modifySchemaReqValidator  validate.Validator
+	getSchemaReqValidator      validate.Validator
+	modifySchemasReqValidator  validate.Validator
+	modifySchemaReqValidator   validate.Validator
+	checkSchemaReqValidator    validate.Validator
+	schemaRevisionReqValidator validate.Validator
+	diffSchemaReqValidator     validate.Validator
+	searchSchemasReqValidator  validate.Validator
 )
 
 var (
 	schemaIdUnlimitedRegex, _ = regexp.Compile(`^[a-zA-Z0-9]+$|^[a-zA-Z0-9][a-zA-Z0-9_\-.]*[a-zA-Z0-9]$`)
 	schemaSummaryRegex, _     = regexp.Compile(`^[a-zA-Z0-9]*$`)
+	schemaRevisionRegex, _    = regexp.Compile(`^[0-9]*$`)
+	httpMethodRegex, _        = regexp.Compile(`^(?i)(get|put|post|delete|options|head|patch|trace)?$`)
 )
 
 func GetSchemaReqValidator() *validate.Validator {
@@ -48,7 +53,7 @@ func ModifySchemasReqValidator() *validate.Validator {
 		subSchemaValidator.AddRule("Schema", &validate.ValidateRule{Min: 1})
 
 		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
-		v.AddRule("Schemas", &validate.ValidateRule{Min: 1, Max: quota.DefaultSchemaQuota})
+		v.AddRule("Schemas", &validate.ValidateRule{Min: 1, Max: MAX_SCHEMAS_PER_REQUEST})
 		v.AddSub("Schemas", &subSchemaValidator)
 	})
 }
@@ -61,3 +66,37 @@ func ModifySchemaReqValidator() *validate.Validator {
 		v.AddRule("Summary", &validate.ValidateRule{Max: 128, Regexp: schemaSummaryRegex})
 	})
 }
+
+func CheckSchemaReqValidator() *validate.Validator {
+	return checkSchemaReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
+		v.AddRule("SchemaId", GetSchemaReqValidator().GetRule("SchemaId"))
+		v.AddRule("Schema", &validate.ValidateRule{Min: 1})
+	})
+}
+
+func SchemaRevisionReqValidator() *validate.Validator {
+	return schemaRevisionReqValidator.Init(func(v *validate.Validator) {
+		v.AddRules(GetSchemaReqValidator().GetRules())
+		v.AddRule("Revision", &validate.ValidateRule{Min: 1, Max: 20, Regexp: schemaRevisionRegex})
+	})
+}
+
+func DiffSchemaReqValidator() *validate.Validator {
+	return diffSchemaReqValidator.Init(func(v *validate.Validator) {
+		v.AddRules(GetSchemaReqValidator().GetRules())
+		v.AddRule("Base", SchemaRevisionReqValidator().GetRule("Revision"))
+		v.AddRule("Target", &validate.ValidateRule{Max: 20, Regexp: schemaRevisionRegex})
+	})
+}
+
+func SearchSchemasReqValidator() *validate.Validator {
+	return searchSchemasReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Keyword", &validate.ValidateRule{Max: 256})
+		v.AddRule("Method", &validate.ValidateRule{Max: 7, Regexp: httpMethodRegex})
+		v.AddRule("Path", &validate.ValidateRule{Max: 512})
+		v.AddRule("OperationId", &validate.ValidateRule{Max: 128})
+		v.AddRule("Tag", &validate.ValidateRule{Max: 128})
+		v.AddRule("Model", &validate.ValidateRule{Max: 128})
+	})
+}
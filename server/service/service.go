@@ -23,7 +23,7 @@ import (
 )
 
 var (
-	serviceService  pb.ServiceCtrlServer
+	serviceService  pb.ServiceCtrlServerEx
 	instanceService pb.SerivceInstanceCtrlServerEx
 )
 
@@ -40,6 +40,6 @@ func RegisterGrpcServices(s *grpc.Server) {
 	pb.RegisterServiceInstanceCtrlServer(s, instanceService)
 }
 
-func AssembleResources() (pb.ServiceCtrlServer, pb.SerivceInstanceCtrlServerEx) {
+func AssembleResources() (pb.ServiceCtrlServerEx, pb.SerivceInstanceCtrlServerEx) {
 	return serviceService, instanceService
 }
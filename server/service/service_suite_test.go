@@ -33,7 +33,7 @@ import (
 	"testing"
 )
 
-var serviceResource pb.ServiceCtrlServer
+var serviceResource pb.ServiceCtrlServerEx
 var instanceResource pb.SerivceInstanceCtrlServerEx
 
 var _ = BeforeSuite(func() {
@@ -23,6 +23,7 @@ import (
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/auditlog"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin"
@@ -40,6 +41,12 @@ func (s *MicroServiceService) AddTags(ctx context.Context, in *pb.AddServiceTags
 	}
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckBatchSize(ctx, domainProject, quota.TagQuotaType, len(in.Tags)); err != nil {
+		util.Logger().Errorf(err, "add service tags failed, serviceId %s, tags %v: invalid parameters.", in.ServiceId, in.Tags)
+		return &pb.AddServiceTagsResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 	// service id存在性校验
 	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
 		util.Logger().Errorf(nil, "add service tags failed, serviceId %s, tags %v: service not exist.", in.ServiceId, in.Tags)
@@ -47,6 +54,12 @@ func (s *MicroServiceService) AddTags(ctx context.Context, in *pb.AddServiceTags
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "add service tags failed, serviceId %s, tags %v: check owner failed.", in.ServiceId, in.Tags)
+		return &pb.AddServiceTagsResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	addTags := in.GetTags()
 	res := quota.NewApplyQuotaResource(quota.TagQuotaType, domainProject, in.ServiceId, int64(len(addTags)))
@@ -70,6 +83,7 @@ func (s *MicroServiceService) AddTags(ctx context.Context, in *pb.AddServiceTags
 			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
 		}, err
 	}
+	before := copyTags(dataTags)
 	if len(dataTags) > 0 {
 		for key, value := range addTags {
 			dataTags[key] = value
@@ -90,6 +104,7 @@ func (s *MicroServiceService) AddTags(ctx context.Context, in *pb.AddServiceTags
 		return resp, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_TAG, in.ServiceId, "", before, dataTags)
 	util.Logger().Infof("add service tags successful, serviceId %s, tags %v.", in.ServiceId, in.Tags)
 	return &pb.AddServiceTagsResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Add service tags successfully."),
@@ -114,6 +129,12 @@ func (s *MicroServiceService) UpdateTag(ctx context.Context, in *pb.UpdateServic
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "update service tag failed, serviceId %s, tag %s: check owner failed.", in.ServiceId, tagFlag)
+		return &pb.UpdateServiceTagResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	tags, err := serviceUtil.GetTagsUtils(ctx, domainProject, in.ServiceId)
 	if err != nil {
@@ -129,6 +150,7 @@ func (s *MicroServiceService) UpdateTag(ctx context.Context, in *pb.UpdateServic
 			Response: pb.CreateResponse(scerr.ErrTagNotExists, "Update tag for service failed for update tags not exist, please add first."),
 		}, nil
 	}
+	before := copyTags(tags)
 	tags[in.Key] = in.Value
 
 	checkErr := serviceUtil.AddTagIntoETCD(ctx, domainProject, in.ServiceId, tags)
@@ -143,6 +165,7 @@ func (s *MicroServiceService) UpdateTag(ctx context.Context, in *pb.UpdateServic
 		return resp, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_TAG, in.ServiceId, "", before, tags)
 	util.Logger().Infof("update tag successful, serviceId %s, tag %s.", in.ServiceId, tagFlag)
 	return &pb.UpdateServiceTagResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Update service tag success."),
@@ -159,6 +182,12 @@ func (s *MicroServiceService) DeleteTags(ctx context.Context, in *pb.DeleteServi
 	}
 
 	domainProject := util.ParseDomainProject(ctx)
+	if err := serviceUtil.CheckBatchSize(ctx, domainProject, quota.TagQuotaType, len(in.Keys)); err != nil {
+		util.Logger().Errorf(err, "delete service tags failed, serviceId %s, tags %v: invalid params.", in.ServiceId, in.Keys)
+		return &pb.DeleteServiceTagsResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	if !serviceUtil.ServiceExist(ctx, domainProject, in.ServiceId) {
 		util.Logger().Errorf(nil, "delete service tags failed, serviceId %s, tags %v: service not exist.", in.ServiceId, in.Keys)
@@ -166,6 +195,12 @@ func (s *MicroServiceService) DeleteTags(ctx context.Context, in *pb.DeleteServi
 			Response: pb.CreateResponse(scerr.ErrServiceNotExists, "Service does not exist."),
 		}, nil
 	}
+	if err := serviceUtil.CheckServiceOwner(ctx, domainProject, in.ServiceId); err != nil {
+		util.Logger().Errorf(err, "delete service tags failed, serviceId %s, tags %v: check owner failed.", in.ServiceId, in.Keys)
+		return &pb.DeleteServiceTagsResponse{
+			Response: pb.CreateResponseWithSCErr(err),
+		}, nil
+	}
 
 	tags, err := serviceUtil.GetTagsUtils(ctx, domainProject, in.ServiceId)
 	if err != nil {
@@ -174,6 +209,7 @@ func (s *MicroServiceService) DeleteTags(ctx context.Context, in *pb.DeleteServi
 			Response: pb.CreateResponse(scerr.ErrInternal, err.Error()),
 		}, err
 	}
+	before := copyTags(tags)
 	for _, key := range in.Keys {
 		if _, ok := tags[key]; !ok {
 			util.Logger().Errorf(nil, "delete service tags failed, serviceId %s, tags %v: tag %s not exist.", in.ServiceId, in.Keys, key)
@@ -214,6 +250,7 @@ func (s *MicroServiceService) DeleteTags(ctx context.Context, in *pb.DeleteServi
 		}, nil
 	}
 
+	auditlog.AddChange(ctx, auditlog.ENTITY_TAG, in.ServiceId, "", before, tags)
 	util.Logger().Infof("delete service tags successful: serviceId %s, tag %v.", in.ServiceId, in.Keys)
 	return &pb.DeleteServiceTagsResponse{
 		Response: pb.CreateResponse(pb.Response_SUCCESS, "Delete service tags successfully."),
@@ -251,3 +288,11 @@ func (s *MicroServiceService) GetTags(ctx context.Context, in *pb.GetServiceTags
 		Tags:     tags,
 	}, nil
 }
+
+func copyTags(tags map[string]string) map[string]string {
+	c := make(map[string]string, len(tags))
+	for k, v := range tags {
+		c[k] = v
+	}
+	return c
+}
@@ -18,11 +18,16 @@ package service_test
 
 import (
 	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"github.com/apache/incubator-servicecomb-service-center/server/plugin/infra/quota/buildin"
+	"github.com/apache/incubator-servicecomb-service-center/server/service"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
 	"strconv"
 	"strings"
 )
@@ -159,6 +164,30 @@ var _ = Describe("'Tag' service", func() {
 				Expect(respAddTags.Response.Code).To(Equal(scerr.ErrNotEnoughQuota))
 			})
 		})
+
+		Context("when the tag quota is unlimited", func() {
+			It("should be limited in one request", func() {
+				ctx := util.SetContext(
+					util.SetDomainProject(context.Background(), "default", "tag_unlimited"),
+					serviceUtil.CTX_NOCACHE, "1")
+				err := serviceUtil.PutQuotaRecord(ctx, "default", "tag_unlimited", &quota.Limits{Tag: quota.UNLIMITED})
+				Expect(err).To(BeNil())
+				defer serviceUtil.DeleteQuotaRecord(ctx, "default", "tag_unlimited")
+
+				size := service.MAX_TAGS_PER_REQUEST + 1
+				tags := make(map[string]string, size)
+				for i := 0; i < size; i++ {
+					s := "tag" + strconv.Itoa(i)
+					tags[s] = s
+				}
+				respAddTags, err := serviceResource.AddTags(ctx, &pb.AddServiceTagsRequest{
+					ServiceId: serviceId2,
+					Tags:      tags,
+				})
+				Expect(err).To(BeNil())
+				Expect(respAddTags.Response.Code).To(Equal(scerr.ErrInvalidParams))
+			})
+		})
 	})
 
 	Describe("execute 'get' operartion", func() {
@@ -18,7 +18,6 @@ package service
 
 import (
 	"github.com/apache/incubator-servicecomb-service-center/pkg/validate"
-	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
 	"regexp"
 )
 
@@ -42,7 +41,7 @@ func GetTagsReqValidator() *validate.Validator {
 func AddTagsReqValidator() *validate.Validator {
 	return addTagsReqValidator.Init(func(v *validate.Validator) {
 		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
-		v.AddRule("Tags", &validate.ValidateRule{Min: 1, Max: quota.DefaultTagQuota, Regexp: tagRegex})
+		v.AddRule("Tags", &validate.ValidateRule{Min: 1, Max: MAX_TAGS_PER_REQUEST, Regexp: tagRegex})
 	})
 }
 
@@ -58,6 +57,6 @@ func UpdateTagReqValidator() *validate.Validator {
 func DeleteTagReqValidator() *validate.Validator {
 	return deleteTagReqValidator.Init(func(v *validate.Validator) {
 		v.AddRule("ServiceId", GetServiceReqValidator().GetRule("ServiceId"))
-		v.AddRule("Keys", &validate.ValidateRule{Min: 1, Max: quota.DefaultTagQuota, Regexp: tagRegex})
+		v.AddRule("Keys", &validate.ValidateRule{Min: 1, Max: MAX_TAGS_PER_REQUEST, Regexp: tagRegex})
 	})
 }
@@ -0,0 +1,339 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"errors"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	"golang.org/x/net/context"
+	"strconv"
+	"time"
+)
+
+func (s *MicroServiceService) CreateDomain(ctx context.Context, in *pb.CreateDomainRequest) (*pb.CreateDomainResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "create domain failed: invalid parameters.")
+		return &pb.CreateDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	domain := in.Domain
+	domain.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+	domain.Usage = nil
+	ok, err := serviceUtil.PutDomain(ctx, domain)
+	if err != nil {
+		util.Logger().Errorf(err, "create domain %s failed: commit data into etcd failed.", domain.Name)
+		return &pb.CreateDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !ok {
+		util.Logger().Errorf(nil, "create domain %s failed: domain already exists.", domain.Name)
+		return &pb.CreateDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrDomainAlreadyExists, "Domain already exists."),
+		}, nil
+	}
+
+	util.Logger().Infof("create domain %s successfully, operator: %s.", domain.Name, util.ParseOperator(ctx))
+	return &pb.CreateDomainResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Create domain successfully."),
+	}, nil
+}
+
+func (s *MicroServiceService) GetDomains(ctx context.Context, in *pb.GetDomainsRequest) (*pb.GetDomainsResponse, error) {
+	domains, err := serviceUtil.GetDomains(ctx)
+	if err != nil {
+		util.Logger().Errorf(err, "get domains failed.")
+		return &pb.GetDomainsResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	for _, domain := range domains {
+		domain.Usage, err = serviceUtil.GetTenantUsage(ctx, domain.Name, "")
+		if err != nil {
+			util.Logger().Errorf(err, "get domains failed: count the resources of domain %s failed.", domain.Name)
+			return &pb.GetDomainsResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+	}
+	return &pb.GetDomainsResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Get domains successfully."),
+		Domains:  domains,
+	}, nil
+}
+
+// DeleteDomain deletes the domain without any project, or deletes the
+// projects and their resources in the domain if forced
+func (s *MicroServiceService) DeleteDomain(ctx context.Context, in *pb.DeleteDomainRequest) (*pb.DeleteDomainResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "delete domain failed: invalid parameters.")
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	title := "delete"
+	if in.Force {
+		title = "force delete"
+	}
+
+	if in.Domain == apt.REGISTRY_DOMAIN {
+		err := errors.New("not allow to delete the domain of service center")
+		util.Logger().Errorf(err, "%s domain %s failed.", title, in.Domain)
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	exist, err := serviceUtil.DomainExist(util.SetContext(util.CloneContext(ctx), serviceUtil.CTX_NOCACHE, "1"), in.Domain)
+	if err != nil {
+		util.Logger().Errorf(err, "%s domain %s failed: get domain failed.", title, in.Domain)
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !exist {
+		util.Logger().Errorf(nil, "%s domain %s failed: domain does not exist.", title, in.Domain)
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrDomainNotExists, "Domain does not exist."),
+		}, nil
+	}
+
+	usage, err := serviceUtil.GetTenantUsage(ctx, in.Domain, "")
+	if err != nil {
+		util.Logger().Errorf(err, "%s domain %s failed: count the resources failed.", title, in.Domain)
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !in.Force && (usage.Projects > 0 || usage.Services > 0) {
+		util.Logger().Errorf(nil, "delete domain %s failed: domain has %d project(s), %d micro-service(s).",
+			in.Domain, usage.Projects, usage.Services)
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrDomainNotEmpty, "Can not delete the domain, it has project(s)."),
+		}, nil
+	}
+
+	if in.Force {
+		projects, err := serviceUtil.GetProjects(ctx, in.Domain)
+		if err != nil {
+			util.Logger().Errorf(err, "%s domain %s failed: get projects failed.", title, in.Domain)
+			return &pb.DeleteDomainResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+		for _, project := range projects {
+			if respErr := s.deleteProjectServices(ctx, in.Domain, project.Name); respErr != nil {
+				util.Logger().Errorf(respErr, "%s domain %s failed: delete project %s failed.",
+					title, in.Domain, project.Name)
+				return &pb.DeleteDomainResponse{Response: pb.CreateResponseWithSCErr(respErr)}, nil
+			}
+		}
+	}
+
+	// the resources of the projects created implicitly are removed too
+	err = backend.BatchCommit(ctx, serviceUtil.DeleteDomainProjectOps(in.Domain, ""))
+	if err != nil {
+		util.Logger().Errorf(err, "%s domain %s failed: commit data into etcd failed.", title, in.Domain)
+		return &pb.DeleteDomainResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+
+	util.Logger().Infof("%s domain %s successfully, operator: %s.", title, in.Domain, util.ParseOperator(ctx))
+	return &pb.DeleteDomainResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Delete domain successfully."),
+	}, nil
+}
+
+func (s *MicroServiceService) CreateProject(ctx context.Context, in *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "create project failed: invalid parameters.")
+		return &pb.CreateProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	project := in.Project
+	project.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+	project.Usage = nil
+	// the domain is created implicitly as the registration does
+	if err := serviceUtil.NewDomain(ctx, in.Domain); err != nil {
+		util.Logger().Errorf(err, "create project %s/%s failed: create domain failed.", in.Domain, project.Name)
+		return &pb.CreateProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	ok, err := serviceUtil.PutProject(ctx, in.Domain, project)
+	if err != nil {
+		util.Logger().Errorf(err, "create project %s/%s failed: commit data into etcd failed.", in.Domain, project.Name)
+		return &pb.CreateProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !ok {
+		util.Logger().Errorf(nil, "create project %s/%s failed: project already exists.", in.Domain, project.Name)
+		return &pb.CreateProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrProjectAlreadyExists, "Project already exists."),
+		}, nil
+	}
+
+	util.Logger().Infof("create project %s/%s successfully, operator: %s.",
+		in.Domain, project.Name, util.ParseOperator(ctx))
+	return &pb.CreateProjectResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Create project successfully."),
+	}, nil
+}
+
+func (s *MicroServiceService) GetProjects(ctx context.Context, in *pb.GetProjectsRequest) (*pb.GetProjectsResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "get projects failed: invalid parameters.")
+		return &pb.GetProjectsResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	projects, err := serviceUtil.GetProjects(ctx, in.Domain)
+	if err != nil {
+		util.Logger().Errorf(err, "get projects of domain %s failed.", in.Domain)
+		return &pb.GetProjectsResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	for _, project := range projects {
+		project.Usage, err = serviceUtil.GetTenantUsage(ctx, in.Domain, project.Name)
+		if err != nil {
+			util.Logger().Errorf(err, "get projects failed: count the resources of project %s/%s failed.",
+				in.Domain, project.Name)
+			return &pb.GetProjectsResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+	}
+	return &pb.GetProjectsResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Get projects successfully."),
+		Projects: projects,
+	}, nil
+}
+
+// DeleteProject deletes the project without any micro-service, or deletes
+// the micro-services and their instances in the project if forced
+func (s *MicroServiceService) DeleteProject(ctx context.Context, in *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	err := Validate(in)
+	if err != nil {
+		util.Logger().Errorf(err, "delete project failed: invalid parameters.")
+		return &pb.DeleteProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	title := "delete"
+	if in.Force {
+		title = "force delete"
+	}
+	domainProject := util.StringJoin([]string{in.Domain, in.Project}, "/")
+
+	if apt.IsDefaultDomainProject(domainProject) {
+		err := errors.New("not allow to delete the project of service center")
+		util.Logger().Errorf(err, "%s project %s failed.", title, domainProject)
+		return &pb.DeleteProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrInvalidParams, err.Error()),
+		}, nil
+	}
+
+	exist, err := serviceUtil.ProjectExist(util.SetContext(util.CloneContext(ctx), serviceUtil.CTX_NOCACHE, "1"), in.Domain, in.Project)
+	if err != nil {
+		util.Logger().Errorf(err, "%s project %s failed: get project failed.", title, domainProject)
+		return &pb.DeleteProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+	if !exist {
+		util.Logger().Errorf(nil, "%s project %s failed: project does not exist.", title, domainProject)
+		return &pb.DeleteProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrProjectNotExists, "Project does not exist."),
+		}, nil
+	}
+
+	if !in.Force {
+		usage, err := serviceUtil.GetTenantUsage(ctx, in.Domain, in.Project)
+		if err != nil {
+			util.Logger().Errorf(err, "delete project %s failed: count the resources failed.", domainProject)
+			return &pb.DeleteProjectResponse{
+				Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+			}, err
+		}
+		if usage.Services > 0 {
+			util.Logger().Errorf(nil, "delete project %s failed: project has %d micro-service(s).",
+				domainProject, usage.Services)
+			return &pb.DeleteProjectResponse{
+				Response: pb.CreateResponse(scerr.ErrProjectNotEmpty, "Can not delete the project, it has micro-service(s)."),
+			}, nil
+		}
+	} else if respErr := s.deleteProjectServices(ctx, in.Domain, in.Project); respErr != nil {
+		util.Logger().Errorf(respErr, "%s project %s failed: delete micro-services failed.", title, domainProject)
+		return &pb.DeleteProjectResponse{Response: pb.CreateResponseWithSCErr(respErr)}, nil
+	}
+
+	err = backend.BatchCommit(ctx, serviceUtil.DeleteDomainProjectOps(in.Domain, in.Project))
+	if err != nil {
+		util.Logger().Errorf(err, "%s project %s failed: commit data into etcd failed.", title, domainProject)
+		return &pb.DeleteProjectResponse{
+			Response: pb.CreateResponse(scerr.ErrUnavailableBackend, err.Error()),
+		}, err
+	}
+
+	util.Logger().Infof("%s project %s successfully, operator: %s.", title, domainProject, util.ParseOperator(ctx))
+	return &pb.DeleteProjectResponse{
+		Response: pb.CreateResponse(pb.Response_SUCCESS, "Delete project successfully."),
+	}, nil
+}
+
+// deleteProjectServices force deletes the micro-services of the project one
+// by one, so that their instances, dependencies and credentials are cleaned
+// up and the events are sent as the micro-service is unregistered
+func (s *MicroServiceService) deleteProjectServices(ctx context.Context, domain, project string) *scerr.Error {
+	domainProject := util.StringJoin([]string{domain, project}, "/")
+	kvs, err := serviceUtil.GetServicesRawData(util.SetContext(util.CloneContext(ctx), serviceUtil.CTX_NOCACHE, "1"), domainProject)
+	if err != nil {
+		return scerr.NewError(scerr.ErrUnavailableBackend, err.Error())
+	}
+	// the operator is allowed to delete all the micro-services of the project
+	pctx := util.SetAdmin(util.SetDomainProject(util.CloneContext(ctx), domain, project), true)
+	for _, kv := range kvs {
+		serviceId, _, _ := pb.GetInfoFromSvcKV(kv)
+		resp, err := s.DeleteServicePri(pctx, serviceId, true)
+		if err != nil {
+			return scerr.NewError(scerr.ErrUnavailableBackend, err.Error())
+		}
+		if resp.Code != pb.Response_SUCCESS && resp.Code != scerr.ErrServiceNotExists {
+			return scerr.NewError(resp.Code, resp.Message)
+		}
+	}
+	return nil
+}
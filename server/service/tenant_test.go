@@ -0,0 +1,131 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service_test
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	serviceUtil "github.com/apache/incubator-servicecomb-service-center/server/service/util"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("'Tenant' service", func() {
+	Describe("execute 'tenant' operation", func() {
+		Context("when the project has micro-services", func() {
+			It("should refuse to delete it unless forced", func() {
+				respCreate, err := serviceResource.CreateProject(getContext(), &pb.CreateProjectRequest{
+					Domain:  "tenant_domain",
+					Project: &pb.Project{Name: "tenant_project"},
+				})
+				Expect(err).To(BeNil())
+				Expect(respCreate.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				By("project already exists")
+				respCreate, err = serviceResource.CreateProject(getContext(), &pb.CreateProjectRequest{
+					Domain:  "tenant_domain",
+					Project: &pb.Project{Name: "tenant_project"},
+				})
+				Expect(err).To(BeNil())
+				Expect(respCreate.Response.Code).To(Equal(scerr.ErrProjectAlreadyExists))
+
+				ctx := util.SetContext(
+					util.SetDomainProject(context.Background(), "tenant_domain", "tenant_project"),
+					serviceUtil.CTX_NOCACHE, "1")
+				resp, err := serviceResource.Create(ctx, &pb.CreateServiceRequest{
+					Service: &pb.MicroService{
+						AppId:       "tenant",
+						ServiceName: "tenant_service",
+						Version:     "1.0.0",
+						Level:       "FRONT",
+						Status:      pb.MS_UP,
+					},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respGet, err := serviceResource.GetProjects(getContext(), &pb.GetProjectsRequest{
+					Domain: "tenant_domain",
+				})
+				Expect(err).To(BeNil())
+				Expect(respGet.Response.Code).To(Equal(pb.Response_SUCCESS))
+				Expect(len(respGet.Projects)).To(Equal(1))
+				Expect(respGet.Projects[0].Usage.Services).To(Equal(int64(1)))
+
+				By("project is not empty")
+				respDel, err := serviceResource.DeleteProject(getContext(), &pb.DeleteProjectRequest{
+					Domain:  "tenant_domain",
+					Project: "tenant_project",
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(scerr.ErrProjectNotEmpty))
+
+				By("domain is not empty")
+				respDelDomain, err := serviceResource.DeleteDomain(getContext(), &pb.DeleteDomainRequest{
+					Domain: "tenant_domain",
+				})
+				Expect(err).To(BeNil())
+				Expect(respDelDomain.Response.Code).To(Equal(scerr.ErrDomainNotEmpty))
+
+				By("force delete the project")
+				respDel, err = serviceResource.DeleteProject(getContext(), &pb.DeleteProjectRequest{
+					Domain:  "tenant_domain",
+					Project: "tenant_project",
+					Force:   true,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(pb.Response_SUCCESS))
+
+				respExist, err := serviceResource.Exist(ctx, &pb.GetExistenceRequest{
+					Type:        "microservice",
+					AppId:       "tenant",
+					ServiceName: "tenant_service",
+					Version:     "1.0.0",
+				})
+				Expect(err).To(BeNil())
+				Expect(respExist.Response.Code).ToNot(Equal(pb.Response_SUCCESS))
+
+				respDel, err = serviceResource.DeleteProject(getContext(), &pb.DeleteProjectRequest{
+					Domain:  "tenant_domain",
+					Project: "tenant_project",
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(scerr.ErrProjectNotExists))
+
+				respDelDomain, err = serviceResource.DeleteDomain(getContext(), &pb.DeleteDomainRequest{
+					Domain: "tenant_domain",
+				})
+				Expect(err).To(BeNil())
+				Expect(respDelDomain.Response.Code).To(Equal(pb.Response_SUCCESS))
+			})
+		})
+
+		Context("when delete the default project", func() {
+			It("should be failed", func() {
+				respDel, err := serviceResource.DeleteProject(getContext(), &pb.DeleteProjectRequest{
+					Domain:  "default",
+					Project: "default",
+					Force:   true,
+				})
+				Expect(err).To(BeNil())
+				Expect(respDel.Response.Code).To(Equal(scerr.ErrInvalidParams))
+			})
+		})
+	})
+})
@@ -0,0 +1,79 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/validate"
+	"regexp"
+)
+
+var (
+	tenantValidator           validate.Validator
+	createDomainReqValidator  validate.Validator
+	deleteDomainReqValidator  validate.Validator
+	createProjectReqValidator validate.Validator
+	getProjectsReqValidator   validate.Validator
+	deleteProjectReqValidator validate.Validator
+)
+
+var (
+	// the name is a segment of the registry keys
+	tenantNameRegex, _ = regexp.Compile(`^[a-zA-Z0-9]$|^[a-zA-Z0-9][a-zA-Z0-9_\-.]*[a-zA-Z0-9]$`)
+)
+
+// TenantValidator validates the metadata of the domain or project
+func TenantValidator() *validate.Validator {
+	return tenantValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Name", &validate.ValidateRule{Min: 1, Max: 64, Regexp: tenantNameRegex})
+		v.AddRule("Description", &validate.ValidateRule{Max: 256})
+		v.AddRule("Properties", &validate.ValidateRule{Max: 64})
+	})
+}
+
+func CreateDomainReqValidator() *validate.Validator {
+	return createDomainReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Domain", &validate.ValidateRule{Min: 1})
+		v.AddSub("Domain", TenantValidator())
+	})
+}
+
+func DeleteDomainReqValidator() *validate.Validator {
+	return deleteDomainReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Domain", TenantValidator().GetRule("Name"))
+	})
+}
+
+func CreateProjectReqValidator() *validate.Validator {
+	return createProjectReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Domain", TenantValidator().GetRule("Name"))
+		v.AddRule("Project", &validate.ValidateRule{Min: 1})
+		v.AddSub("Project", TenantValidator())
+	})
+}
+
+func GetProjectsReqValidator() *validate.Validator {
+	return getProjectsReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Domain", TenantValidator().GetRule("Name"))
+	})
+}
+
+func DeleteProjectReqValidator() *validate.Validator {
+	return deleteProjectReqValidator.Init(func(v *validate.Validator) {
+		v.AddRule("Domain", TenantValidator().GetRule("Name"))
+		v.AddRule("Project", TenantValidator().GetRule("Name"))
+	})
+}
@@ -19,11 +19,18 @@ package util
 import "time"
 
 const (
-	HEADER_REV            = "X-Resource-Revision"
-	CTX_NOCACHE           = "noCache"
-	CTX_CACHEONLY         = "cacheOnly"
-	CTX_REQUEST_REVISION  = "requestRev"
-	CTX_RESPONSE_REVISION = "responseRev"
+	HEADER_REV               = "X-Resource-Revision"
+	HEADER_WARNING           = "Warning"
+	HEADER_CREDENTIAL        = "X-Service-Credential"
+	CTX_NOCACHE              = "noCache"
+	CTX_CACHEONLY            = "cacheOnly"
+	CTX_REQUEST_REVISION     = "requestRev"
+	CTX_RESPONSE_REVISION    = "responseRev"
+	CTX_RESPONSE_DEPRECATION = "responseDeprecation"
+	CTX_RESPONSE_SCHEMA_WARN = "responseSchemaWarn"
+	CTX_SCHEMA_ROLLBACK_FROM = "schemaRollbackFrom"
+	CTX_SERVICE_CREDENTIAL   = "service-credential"
+	CTX_PEER_IP              = "x-peer-ip"
 
 	cacheTTL = 5 * time.Minute
 )
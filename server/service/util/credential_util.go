@@ -0,0 +1,134 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+func ServiceOwnershipEnabled() bool {
+	return apt.ServerInfo.Config.ServiceOwnership
+}
+
+// NewServiceCredential generates a random owner credential of the service
+func NewServiceCredential() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashServiceCredential returns the digest of the credential, only the digest
+// is stored so that the credential can not be read from the registry
+func HashServiceCredential(credential string) string {
+	sum := sha256.Sum256(util.StringToBytesWithNoCopy(credential))
+	return hex.EncodeToString(sum[:])
+}
+
+func ServiceCredentialOp(domainProject string, serviceId string, credential string) registry.PluginOp {
+	return registry.OpPut(
+		registry.WithStrKey(apt.GenerateServiceCredentialKey(domainProject, serviceId)),
+		registry.WithStrValue(HashServiceCredential(credential)))
+}
+
+// GetServiceCredentialHash returns the digest of the owner credential of the
+// service, it is empty if the service has no owner
+func GetServiceCredentialHash(ctx context.Context, domainProject string, serviceId string) (string, error) {
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(apt.GenerateServiceCredentialKey(domainProject, serviceId)))
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Kvs) == 0 {
+		return "", nil
+	}
+	return util.BytesToStringWithNoCopy(resp.Kvs[0].Value), nil
+}
+
+// ParseServiceCredential returns the credential of the X-Service-Credential
+// header, or the x-service-credential metadata of the grpc call
+func ParseServiceCredential(ctx context.Context) string {
+	v, _ := ctx.Value(CTX_SERVICE_CREDENTIAL).(string)
+	if len(v) > 0 {
+		return v
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md[strings.ToLower(HEADER_CREDENTIAL)]; len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func SetServiceCredential(ctx context.Context, credential string) context.Context {
+	return util.SetContext(ctx, CTX_SERVICE_CREDENTIAL, credential)
+}
+
+// CheckServiceOwner checks the request presents the owner credential of the
+// service. The administrators and service-center itself are always allowed,
+// and the services registered without credential are open to the domain
+func CheckServiceOwner(ctx context.Context, domainProject string, serviceId string) *scerr.Error {
+	return checkServiceOwner(ctx, domainProject, serviceId, true)
+}
+
+// CheckServiceCredentialRotation is the same as CheckServiceOwner, except that
+// only the administrators can claim the service registered without credential
+func CheckServiceCredentialRotation(ctx context.Context, domainProject string, serviceId string) *scerr.Error {
+	return checkServiceOwner(ctx, domainProject, serviceId, false)
+}
+
+func checkServiceOwner(ctx context.Context, domainProject string, serviceId string, openIfNoOwner bool) *scerr.Error {
+	if !ServiceOwnershipEnabled() || util.IsAdmin(ctx) || apt.IsSCInstance(ctx) {
+		return nil
+	}
+	hash, err := GetServiceCredentialHash(ctx, domainProject, serviceId)
+	if err != nil {
+		util.Logger().Errorf(err, "get the credential of service %s failed", serviceId)
+		return scerr.NewError(scerr.ErrUnavailableBackend, err.Error())
+	}
+	if len(hash) == 0 {
+		if openIfNoOwner {
+			return nil
+		}
+		return scerr.NewError(scerr.ErrPermissionDeny,
+			"Service "+serviceId+" has no owner, only the administrators can set its credential.")
+	}
+	credential := ParseServiceCredential(ctx)
+	if len(credential) == 0 {
+		return scerr.NewError(scerr.ErrPermissionDeny,
+			"Service "+serviceId+" is owned, the owner credential is required.")
+	}
+	if subtle.ConstantTimeCompare(
+		util.StringToBytesWithNoCopy(HashServiceCredential(credential)),
+		util.StringToBytesWithNoCopy(hash)) != 1 {
+		return scerr.NewError(scerr.ErrPermissionDeny,
+			"The owner credential of service "+serviceId+" is incorrect.")
+	}
+	return nil
+}
@@ -65,17 +65,19 @@ func GetConsumerIdsByProvider(ctx context.Context, domainProject string, provide
 	if err != nil {
 		return nil, nil, err
 	}
-	if len(providerRules) == 0 {
-		return getConsumerIdsWithFilter(ctx, domainProject, provider, noFilter)
-	}
-
 	rf := RuleFilter{
 		DomainProject: domainProject,
 		Provider:      provider,
 		ProviderRules: providerRules,
 	}
+	filter := rf.Filter
+	if len(providerRules) == 0 {
+		// the consumers are still loaded to check the export policy,
+		// but their tags are not as no rule matches them
+		filter = rf.FilterAcrossDimension
+	}
 
-	allow, deny, err = getConsumerIdsWithFilter(ctx, domainProject, provider, rf.Filter)
+	allow, deny, err = getConsumerIdsWithFilter(ctx, domainProject, provider, filter)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -141,8 +143,14 @@ func GetProviderIdsByConsumer(ctx context.Context, domainProject string, service
 			return nil, nil, err
 		}
 		if len(providerRules) == 0 {
-			providerIds[allowIdx] = providerId
-			allowIdx++
+			// only the export policy applies, the consumer tags are not loaded
+			if AllowAcrossDimension(copyCtx, provider, service) == nil {
+				providerIds[allowIdx] = providerId
+				allowIdx++
+			} else {
+				denyIdx--
+				providerIds[denyIdx] = providerId
+			}
 			continue
 		}
 		rf.Provider = provider
@@ -813,6 +821,9 @@ func (dr *DependencyRelation) parseDependencyRule(dependencyRule *pb.MicroServic
 		}
 	default:
 		serviceIds, err = FindServiceIds(dr.ctx, dependencyRule.Version, dependencyRule)
+		if err == nil && len(serviceIds) == 0 {
+			serviceIds, err = FindExportedServiceIds(dr.ctx, dependencyRule.Version, dependencyRule)
+		}
 	}
 	return
 }
@@ -17,9 +17,11 @@
 package util
 
 import (
+	"encoding/json"
 	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
 	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
 	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"github.com/coreos/etcd/mvcc/mvccpb"
 	"golang.org/x/net/context"
@@ -122,3 +124,127 @@ func NewDomainProject(ctx context.Context, domain, project string) error {
 	}
 	return err
 }
+
+// PutDomain creates the domain with the metadata, returns false if the
+// domain already exists
+func PutDomain(ctx context.Context, domain *pb.Domain) (bool, error) {
+	data, err := json.Marshal(domain)
+	if err != nil {
+		return false, err
+	}
+	return backend.Registry().PutNoOverride(ctx,
+		registry.WithStrKey(apt.GenerateDomainKey(domain.Name)),
+		registry.WithValue(data))
+}
+
+// PutProject creates the project with the metadata, returns false if the
+// project already exists
+func PutProject(ctx context.Context, domain string, project *pb.Project) (bool, error) {
+	data, err := json.Marshal(project)
+	if err != nil {
+		return false, err
+	}
+	return backend.Registry().PutNoOverride(ctx,
+		registry.WithStrKey(apt.GenerateProjectKey(domain, project.Name)),
+		registry.WithValue(data))
+}
+
+// GetDomains returns the metadata of all the domains
+func GetDomains(ctx context.Context) ([]*pb.Domain, error) {
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(apt.GenerateDomainKey("")),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	domains := make([]*pb.Domain, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		domain := &pb.Domain{}
+		if len(kv.Value) > 0 {
+			if err := json.Unmarshal(kv.Value, domain); err != nil {
+				util.Logger().Errorf(err, "invalid domain metadata %s", kv.Key)
+			}
+		}
+		domain.Name = tenantNameOf(kv.Key)
+		domains = append(domains, domain)
+	}
+	return domains, nil
+}
+
+// GetProjects returns the metadata of all the projects of the domain
+func GetProjects(ctx context.Context, domain string) ([]*pb.Project, error) {
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(apt.GenerateProjectKey(domain, "")),
+		registry.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]*pb.Project, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		project := &pb.Project{}
+		if len(kv.Value) > 0 {
+			if err := json.Unmarshal(kv.Value, project); err != nil {
+				util.Logger().Errorf(err, "invalid project metadata %s", kv.Key)
+			}
+		}
+		project.Name = tenantNameOf(kv.Key)
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+func tenantNameOf(key []byte) string {
+	k := util.BytesToStringWithNoCopy(key)
+	return k[strings.LastIndex(k, "/")+1:]
+}
+
+// GetTenantUsage counts the resources of the domain if the project is empty,
+// otherwise the resources of the project
+func GetTenantUsage(ctx context.Context, domain, project string) (*pb.TenantUsage, error) {
+	usage := &pb.TenantUsage{}
+	scope := domain
+	if len(project) > 0 {
+		scope = util.StringJoin([]string{domain, project}, "/")
+	} else {
+		resp, err := backend.Registry().Do(ctx, registry.GET,
+			registry.WithStrKey(apt.GenerateProjectKey(domain, "")),
+			registry.WithPrefix(),
+			registry.WithCountOnly())
+		if err != nil {
+			return nil, err
+		}
+		usage.Projects = resp.Count
+	}
+	ctx = util.SetContext(util.CloneContext(ctx), CTX_NOCACHE, "1")
+	var err error
+	if usage.Services, err = GetOneDomainProjectServiceCount(ctx, scope); err != nil {
+		return nil, err
+	}
+	if usage.Instances, err = GetOneDomainProjectInstanceCount(ctx, scope); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// DeleteDomainProjectOps returns the operations to delete all the resources
+// and the quota record of the project, and the project itself. If the
+// project is empty, the operations delete the whole domain
+func DeleteDomainProjectOps(domain, project string) []registry.PluginOp {
+	scope := domain
+	if len(project) > 0 {
+		scope = util.StringJoin([]string{domain, project}, "/")
+	}
+	roots := apt.GetDomainProjectRootKeys(scope)
+	opts := make([]registry.PluginOp, 0, len(roots)+4)
+	for _, root := range roots {
+		opts = append(opts, registry.OpDel(registry.WithStrKey(root+"/"), registry.WithPrefix()))
+	}
+	opts = append(opts, registry.OpDel(registry.WithStrKey(apt.GenerateQuotaKey(domain, project))))
+	if len(project) > 0 {
+		return append(opts, registry.OpDel(registry.WithStrKey(apt.GenerateProjectKey(domain, project))))
+	}
+	return append(opts,
+		registry.OpDel(registry.WithStrKey(apt.GenerateQuotaKey(domain, "")+"/"), registry.WithPrefix()),
+		registry.OpDel(registry.WithStrKey(apt.GenerateProjectKey(domain, "")), registry.WithPrefix()),
+		registry.OpDel(registry.WithStrKey(apt.GenerateDomainKey(domain))))
+}
@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"golang.org/x/net/context"
+	"strings"
+)
+
+const EXPORT_ALL = "*"
+
+var exportEnvironments = []string{"", pb.ENV_DEV, pb.ENV_TEST, pb.ENV_ACCEPT, pb.ENV_PROD}
+
+// IsExportedTo checks the provider export policy property, the value of
+// property is a comma separated list or '*' for all.
+func IsExportedTo(provider *pb.MicroService, prop string, targets ...string) bool {
+	if len(provider.Properties) == 0 {
+		return false
+	}
+	value, ok := provider.Properties[prop]
+	if !ok {
+		return false
+	}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == EXPORT_ALL {
+			return true
+		}
+		for _, target := range targets {
+			if item == target {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func IsExportedToApp(provider *pb.MicroService, appId string) bool {
+	if allowCrossApp, ok := provider.Properties[pb.PROP_ALLOW_CROSS_APP]; ok && strings.ToLower(allowCrossApp) == "true" {
+		return true
+	}
+	return IsExportedTo(provider, pb.PROP_EXPORT_APPS, appId)
+}
+
+func IsExportedToEnvironment(provider *pb.MicroService, env string) bool {
+	return IsExportedTo(provider, pb.PROP_EXPORT_ENVIRONMENTS, env)
+}
+
+func IsExportedToDomainProject(provider *pb.MicroService, domainProject string) bool {
+	domain := strings.Split(domainProject, "/")[0]
+	return IsExportedTo(provider, pb.PROP_EXPORT_DOMAINS, domain, domainProject)
+}
+
+// FindExportedServiceIds finds the provider which is in the other environment
+// and exported to the environment of the key, it returns the ids in the first
+// matched environment.
+func FindExportedServiceIds(ctx context.Context, versionRule string, key *pb.MicroServiceKey) ([]string, error) {
+	for _, env := range exportEnvironments {
+		if env == key.Environment {
+			continue
+		}
+		k := *key
+		k.Environment = env
+		ids, err := FindServiceIds(ctx, versionRule, &k)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := make([]string, 0, len(ids))
+		for _, id := range ids {
+			provider, err := GetService(ctx, key.Tenant, id)
+			if err != nil {
+				return nil, err
+			}
+			if provider == nil || !IsExportedToEnvironment(provider, key.Environment) {
+				continue
+			}
+			exported = append(exported, id)
+		}
+		if len(exported) > 0 {
+			util.Logger().Infof("find provider %s/%s/%s exported from %s environment to %s environment",
+				key.AppId, key.ServiceName, versionRule, env, key.Environment)
+			return exported, nil
+		}
+	}
+	return nil, nil
+}
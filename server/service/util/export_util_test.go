@@ -0,0 +1,89 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"golang.org/x/net/context"
+	"testing"
+)
+
+func TestIsExportedTo(t *testing.T) {
+	provider := &proto.MicroService{
+		AppId:       "a",
+		Environment: proto.ENV_PROD,
+		Properties: map[string]string{
+			proto.PROP_EXPORT_APPS:         "b, c",
+			proto.PROP_EXPORT_ENVIRONMENTS: "*",
+			proto.PROP_EXPORT_DOMAINS:      "d1,d2/p2",
+		},
+	}
+	if !IsExportedToApp(provider, "c") || IsExportedToApp(provider, "d") {
+		t.Fatalf("IsExportedToApp failed")
+	}
+	if !IsExportedToEnvironment(provider, proto.ENV_DEV) {
+		t.Fatalf("IsExportedToEnvironment failed")
+	}
+	if !IsExportedToDomainProject(provider, "d1/default") ||
+		!IsExportedToDomainProject(provider, "d2/p2") ||
+		IsExportedToDomainProject(provider, "d2/p3") {
+		t.Fatalf("IsExportedToDomainProject failed")
+	}
+	if IsExportedToApp(&proto.MicroService{}, "a") {
+		t.Fatalf("IsExportedToApp without policy failed")
+	}
+}
+
+func TestAllowAcrossDimensionWithExportPolicy(t *testing.T) {
+	provider := &proto.MicroService{
+		AppId:       "a",
+		Environment: proto.ENV_PROD,
+		Properties: map[string]string{
+			proto.PROP_EXPORT_APPS:         "b",
+			proto.PROP_EXPORT_ENVIRONMENTS: proto.ENV_TEST,
+			proto.PROP_EXPORT_DOMAINS:      "d1",
+		},
+	}
+	ctx := util.SetDomainProject(context.Background(), "d1", "p1")
+	if AllowAcrossDimension(ctx, provider, &proto.MicroService{AppId: "b", Environment: proto.ENV_TEST}) != nil {
+		t.Fatalf("AllowAcrossDimension exported app and environment failed")
+	}
+	if AllowAcrossDimension(ctx, provider, &proto.MicroService{AppId: "b", Environment: proto.ENV_DEV}) == nil {
+		t.Fatalf("AllowAcrossDimension not exported environment failed")
+	}
+
+	ctx = util.SetTargetDomainProject(ctx, "d2", "p2")
+	if AllowAcrossDimension(ctx, provider, &proto.MicroService{AppId: "a", Environment: proto.ENV_PROD}) != nil {
+		t.Fatalf("AllowAcrossDimension exported domain failed")
+	}
+	ctx = util.SetDomainProject(ctx, "d3", "p3")
+	if AllowAcrossDimension(ctx, provider, &proto.MicroService{AppId: "a", Environment: proto.ENV_PROD}) == nil {
+		t.Fatalf("AllowAcrossDimension not exported domain failed")
+	}
+}
+
+func TestFindExportedServiceIds(t *testing.T) {
+	_, err := FindExportedServiceIds(util.SetContext(context.Background(), CTX_CACHEONLY, "1"), "1.0.0",
+		&proto.MicroServiceKey{
+			AppId:       "a",
+			ServiceName: "b",
+		})
+	if err != nil {
+		t.Fatalf("FindExportedServiceIds WithCacheOnly failed")
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/quota"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"golang.org/x/net/context"
+	"strings"
+)
+
+// GetQuotaRecord returns the quota record of the domain if the project is
+// empty, otherwise the record of the project. It is nil if not set
+func GetQuotaRecord(ctx context.Context, domain, project string) (*quota.Limits, error) {
+	opts := append(FromContext(ctx),
+		registry.WithStrKey(apt.GenerateQuotaKey(domain, project)))
+	resp, err := backend.Store().Quota().Search(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	limits := &quota.Limits{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, limits); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func PutQuotaRecord(ctx context.Context, domain, project string, limits *quota.Limits) error {
+	data, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+	_, err = backend.Registry().Do(ctx, registry.PUT,
+		registry.WithStrKey(apt.GenerateQuotaKey(domain, project)),
+		registry.WithValue(data))
+	return err
+}
+
+func DeleteQuotaRecord(ctx context.Context, domain, project string) error {
+	_, err := backend.Registry().Do(ctx, registry.DEL,
+		registry.WithStrKey(apt.GenerateQuotaKey(domain, project)))
+	return err
+}
+
+// GetQuotaLimits returns the effective limits of the domain and the project,
+// the project inherits the unset limits from the domain, and the domain
+// inherits from the defaults
+func GetQuotaLimits(ctx context.Context, domainProject string) (domainLimits, projectLimits *quota.Limits, err error) {
+	domain, project := SplitDomainProject(domainProject)
+	domainRecord, err := GetQuotaRecord(ctx, domain, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	projectRecord, err := GetQuotaRecord(ctx, domain, project)
+	if err != nil {
+		return nil, nil, err
+	}
+	domainLimits = domainRecord.Inherit(quota.DefaultLimits)
+	projectLimits = projectRecord.Inherit(domainLimits)
+	return
+}
+
+// CheckBatchSize checks the number of the per-service resources in one request
+// does not exceed the effective limit of the domain project
+func CheckBatchSize(ctx context.Context, domainProject string, quotaType quota.ResourceType, size int) *scerr.Error {
+	_, projectLimits, err := GetQuotaLimits(ctx, domainProject)
+	if err != nil {
+		util.Logger().Errorf(err, "get the quota limits of %s failed", domainProject)
+		return scerr.NewError(scerr.ErrUnavailableBackend, err.Error())
+	}
+	if max := projectLimits.Get(quotaType); max > 0 && int64(size) > max {
+		return scerr.NewError(scerr.ErrInvalidParams,
+			fmt.Sprintf("the number of %s in one request exceeds the quota %d", quotaType, max))
+	}
+	return nil
+}
+
+func SplitDomainProject(domainProject string) (string, string) {
+	i := strings.Index(domainProject, "/")
+	if i < 0 {
+		return domainProject, ""
+	}
+	return domainProject[:i], domainProject[i+1:]
+}
+
+// GetQuotaUsed counts the resources of the domain if the project is empty,
+// otherwise the resources of the project
+func GetQuotaUsed(ctx context.Context, quotaType quota.ResourceType, domain, project string) (int64, error) {
+	scope := domain
+	if len(project) > 0 {
+		scope = util.StringJoin([]string{domain, project}, "/")
+	}
+	var (
+		prefix  string
+		indexer *backend.Indexer
+	)
+	switch quotaType {
+	case quota.MicroServiceQuotaType:
+		prefix, indexer = apt.GetServiceRootKey(scope)+"/", backend.Store().Service()
+	case quota.MicroServiceInstanceQuotaType:
+		prefix, indexer = apt.GetInstanceRootKey(scope)+"/", backend.Store().Instance()
+	default:
+		return 0, nil
+	}
+	opts := append(FromContext(ctx),
+		registry.WithStrKey(prefix),
+		registry.WithPrefix(),
+		registry.WithCountOnly())
+	resp, err := indexer.Search(ctx, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
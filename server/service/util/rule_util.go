@@ -26,9 +26,14 @@ import (
 	scerr "github.com/apache/incubator-servicecomb-service-center/server/error"
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/peer"
+	"net"
 	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type RuleFilter struct {
@@ -44,6 +49,10 @@ func (rf *RuleFilter) Filter(ctx context.Context, consumerId string) (bool, erro
 		return false, err
 	}
 
+	if rf.Provider != nil && AllowAcrossDimension(copyCtx, rf.Provider, consumer) != nil {
+		return false, nil
+	}
+
 	tags, err := GetTagsUtils(copyCtx, rf.DomainProject, consumerId)
 	if err != nil {
 		return false, err
@@ -58,6 +67,17 @@ func (rf *RuleFilter) Filter(ctx context.Context, consumerId string) (bool, erro
 	return true, nil
 }
 
+// FilterAcrossDimension only checks whether the consumer can access the
+// provider across the app, environment and domain
+func (rf *RuleFilter) FilterAcrossDimension(ctx context.Context, consumerId string) (bool, error) {
+	copyCtx := util.SetContext(util.CloneContext(ctx), CTX_CACHEONLY, "1")
+	consumer, err := GetService(copyCtx, rf.DomainProject, consumerId)
+	if consumer == nil {
+		return false, err
+	}
+	return rf.Provider == nil || AllowAcrossDimension(copyCtx, rf.Provider, consumer) == nil, nil
+}
+
 func GetRulesUtil(ctx context.Context, domainProject string, serviceId string) ([]*pb.ServiceRule, error) {
 	key := util.StringJoin([]string{
 		apt.GetServiceRuleRootKey(domainProject),
@@ -137,61 +157,167 @@ func GetOneRule(ctx context.Context, domainProject, serviceId, ruleId string) (*
 }
 
 func AllowAcrossDimension(ctx context.Context, providerService *pb.MicroService, consumerService *pb.MicroService) error {
-	if providerService.AppId != consumerService.AppId {
-		if len(providerService.Properties) == 0 {
-			return fmt.Errorf("not allow across app access")
-		}
+	if providerService.AppId != consumerService.AppId && !IsExportedToApp(providerService, consumerService.AppId) {
+		return fmt.Errorf("not allow across app access")
+	}
 
-		if allowCrossApp, ok := providerService.Properties[pb.PROP_ALLOW_CROSS_APP]; !ok || strings.ToLower(allowCrossApp) != "true" {
-			return fmt.Errorf("not allow across app access")
-		}
+	domainProject, targetDomainProject := util.ParseDomainProject(ctx), util.ParseTargetDomainProject(ctx)
+	if apt.IsShared(pb.MicroServiceToKey(targetDomainProject, providerService)) {
+		return nil
 	}
 
-	if !apt.IsShared(pb.MicroServiceToKey(util.ParseTargetDomainProject(ctx), providerService)) &&
-		providerService.Environment != consumerService.Environment {
+	if providerService.Environment != consumerService.Environment &&
+		!IsExportedToEnvironment(providerService, consumerService.Environment) {
 		return fmt.Errorf("not allow across environment access")
 	}
 
+	if domainProject != targetDomainProject && !IsExportedToDomainProject(providerService, domainProject) {
+		return fmt.Errorf("not allow across domain access")
+	}
+
 	return nil
 }
 
 func MatchRules(rulesOfProvider []*pb.ServiceRule, consumer *pb.MicroService, tagsOfConsumer map[string]string) *scerr.Error {
+	return MatchRulesWithIP(rulesOfProvider, consumer, tagsOfConsumer, nil)
+}
+
+func MatchRulesWithIP(rulesOfProvider []*pb.ServiceRule, consumer *pb.MicroService,
+	tagsOfConsumer map[string]string, consumerIPs []string) *scerr.Error {
+	_, err := EvaluateRules(rulesOfProvider, consumer, tagsOfConsumer, consumerIPs)
+	return err
+}
+
+// EvaluateRules evaluates the active rules in descending priority, the first
+// matched rule decides the access, BLACK rule wins if the priorities are equal.
+// Consumer is denied if no rule matched and any WHITE rule is active.
+// The InstanceIP rule matches if any of the consumer IPs matches, and it
+// never matches if the IPs are unknown.
+// It returns the match result of each rule and the final decision.
+func EvaluateRules(rulesOfProvider []*pb.ServiceRule, consumer *pb.MicroService,
+	tagsOfConsumer map[string]string, consumerIPs []string) ([]*pb.RuleMatchResult, *scerr.Error) {
 	if consumer == nil {
-		return scerr.NewError(scerr.ErrInvalidParams, "consumer is nil")
+		return nil, scerr.NewError(scerr.ErrInvalidParams, "consumer is nil")
 	}
 
 	if len(rulesOfProvider) <= 0 {
-		return nil
-	}
-	if rulesOfProvider[0].RuleType == "WHITE" {
-		return patternWhiteList(rulesOfProvider, tagsOfConsumer, consumer)
+		return nil, nil
 	}
-	return patternBlackList(rulesOfProvider, tagsOfConsumer, consumer)
-}
 
-func patternWhiteList(rulesOfProvider []*pb.ServiceRule, tagsOfConsumer map[string]string, consumer *pb.MicroService) *scerr.Error {
 	v := reflect.Indirect(reflect.ValueOf(consumer))
 	consumerId := consumer.ServiceId
-	for _, rule := range rulesOfProvider {
-		value, err := parsePattern(v, rule, tagsOfConsumer, consumerId)
-		if err != nil {
-			return err
+	now := time.Now().Unix()
+	results := make([]*pb.RuleMatchResult, 0, len(rulesOfProvider))
+	var (
+		decision *scerr.Error
+		decided  bool
+		hasWhite bool
+	)
+	for _, rule := range SortRulesByPriority(rulesOfProvider) {
+		result := &pb.RuleMatchResult{Rule: rule, Active: IsRuleActive(rule, now)}
+		results = append(results, result)
+		if !result.Active {
+			continue
 		}
-		if len(value) == 0 {
+		if rule.RuleType == pb.RULE_WHITE {
+			hasWhite = true
+		}
+
+		if rule.Attribute == pb.RULE_ATTR_INSTANCE_IP && len(consumerIPs) == 0 {
+			util.Logger().Infof("can not find service %s ip, rule %s does not match", consumerId, rule.RuleId)
+			result.Message = "Can not find the ip of the consumer"
+			continue
+		}
+		if rule.Attribute == pb.RULE_ATTR_INSTANCE_IP {
+			result.Value = strings.Join(consumerIPs, ",")
+			result.Matched = matchAnyPattern(rule, consumerIPs)
+		} else {
+			value, err := parsePattern(v, rule, tagsOfConsumer, consumerId)
+			if err != nil {
+				result.Message = err.Detail
+				if !decided {
+					decided, decision = true, err
+				}
+				continue
+			}
+			result.Value = value
+			result.Matched = len(value) > 0 && matchPattern(rule, value)
+		}
+		if !result.Matched || decided {
 			continue
 		}
 
-		match, _ := regexp.MatchString(rule.Pattern, value)
-		if match {
+		decided, result.Decisive = true, true
+		if rule.RuleType == pb.RULE_WHITE {
 			util.Logger().Infof("consumer %s match white list, rule.Pattern is %s, value is %s",
-				consumerId, rule.Pattern, value)
-			return nil
+				consumerId, rule.Pattern, result.Value)
+			continue
+		}
+		util.Logger().Warnf(nil, "no permission to access, consumer %s match black list, rule.Pattern is %s, value is %s",
+			consumerId, rule.Pattern, result.Value)
+		decision = scerr.NewError(scerr.ErrPermissionDeny, "Found in black list")
+	}
+	if !decided && hasWhite {
+		decision = scerr.NewError(scerr.ErrPermissionDeny, "Not found in white list")
+	}
+	return results, decision
+}
+
+func SortRulesByPriority(rules []*pb.ServiceRule) []*pb.ServiceRule {
+	sorted := make([]*pb.ServiceRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].RuleType == pb.RULE_BLACK && sorted[j].RuleType != pb.RULE_BLACK
+	})
+	return sorted
+}
+
+func IsRuleActive(rule *pb.ServiceRule, now int64) bool {
+	if len(rule.ValidFrom) > 0 {
+		from, err := strconv.ParseInt(rule.ValidFrom, 10, 64)
+		if err == nil && now < from {
+			return false
+		}
+	}
+	if len(rule.ValidTo) > 0 {
+		to, err := strconv.ParseInt(rule.ValidTo, 10, 64)
+		if err == nil && now > to {
+			return false
 		}
 	}
-	return scerr.NewError(scerr.ErrPermissionDeny, "Not found in white list")
+	return true
 }
 
-func parsePattern(v reflect.Value, rule *pb.ServiceRule, tagsOfConsumer map[string]string, consumerId string) (string, *scerr.Error) {
+func matchPattern(rule *pb.ServiceRule, value string) bool {
+	switch rule.PatternType {
+	case pb.RULE_PATTERN_CIDR:
+		_, ipNet, err := net.ParseCIDR(rule.Pattern)
+		if err != nil {
+			util.Logger().Errorf(err, "invalid CIDR pattern %s, rule %s", rule.Pattern, rule.RuleId)
+			return false
+		}
+		ip := net.ParseIP(value)
+		return ip != nil && ipNet.Contains(ip)
+	default:
+		match, _ := regexp.MatchString(rule.Pattern, value)
+		return match
+	}
+}
+
+func matchAnyPattern(rule *pb.ServiceRule, values []string) bool {
+	for _, value := range values {
+		if len(value) > 0 && matchPattern(rule, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePattern(v reflect.Value, rule *pb.ServiceRule, tagsOfConsumer map[string]string,
+	consumerId string) (string, *scerr.Error) {
 	if strings.HasPrefix(rule.Attribute, "tag_") {
 		key := rule.Attribute[4:]
 		value := tagsOfConsumer[key]
@@ -210,29 +336,6 @@ func parsePattern(v reflect.Value, rule *pb.ServiceRule, tagsOfConsumer map[stri
 
 }
 
-func patternBlackList(rulesOfProvider []*pb.ServiceRule, tagsOfConsumer map[string]string, consumer *pb.MicroService) *scerr.Error {
-	v := reflect.Indirect(reflect.ValueOf(consumer))
-	consumerId := consumer.ServiceId
-	for _, rule := range rulesOfProvider {
-		var value string
-		value, err := parsePattern(v, rule, tagsOfConsumer, consumerId)
-		if err != nil {
-			return err
-		}
-		if len(value) == 0 {
-			continue
-		}
-
-		match, _ := regexp.MatchString(rule.Pattern, value)
-		if match {
-			util.Logger().Warnf(nil, "no permission to access, consumer %s match black list, rule.Pattern is %s, value is %s",
-				consumerId, rule.Pattern, value)
-			return scerr.NewError(scerr.ErrPermissionDeny, "Found in black list")
-		}
-	}
-	return nil
-}
-
 func Accessible(ctx context.Context, consumerId string, providerId string) *scerr.Error {
 	domainProject := util.ParseDomainProject(ctx)
 	targetDomainProject := util.ParseTargetDomainProject(ctx)
@@ -276,5 +379,44 @@ func Accessible(ctx context.Context, consumerId string, providerId string) *scer
 		return scerr.NewErrorf(scerr.ErrInternal, "An error occurred in query consumer tags(%s)", err.Error())
 	}
 
-	return MatchRules(rules, consumerService, validateTags)
+	return MatchRulesWithIP(rules, consumerService, validateTags, ConsumerIPsOf(ctx, rules))
+}
+
+// ConsumerIPsOf returns the IP matched by the InstanceIP rules, it is the
+// peer address of the request, the addresses reported by the clients, e.g.
+// the X-Forwarded-For header or the instance endpoints, are never trusted
+func ConsumerIPsOf(ctx context.Context, rules []*pb.ServiceRule) []string {
+	if !hasInstanceIPRule(rules) {
+		return nil
+	}
+	if ip := PeerIPOf(ctx); len(ip) > 0 {
+		return []string{ip}
+	}
+	return nil
+}
+
+// PeerIPOf returns the IP of the connection which the REST or gRPC request
+// comes from, empty if it is unknown
+func PeerIPOf(ctx context.Context) string {
+	if ip, ok := ctx.Value(CTX_PEER_IP).(string); ok {
+		return ip
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+func hasInstanceIPRule(rules []*pb.ServiceRule) bool {
+	for _, rule := range rules {
+		if rule.Attribute == pb.RULE_ATTR_INSTANCE_IP {
+			return true
+		}
+	}
+	return false
 }
@@ -261,6 +261,147 @@ func TestMatchRules(t *testing.T) {
 	if err != nil {
 		t.Fatalf("MatchRules with not exist tag failed")
 	}
+
+	err = MatchRules([]*proto.ServiceRule{
+		{
+			RuleType:  "WHITE",
+			Attribute: "ServiceName",
+			Pattern:   "^a.*",
+			Priority:  1,
+		},
+		{
+			RuleType:  "BLACK",
+			Attribute: "ServiceName",
+			Pattern:   "^ab.*",
+			Priority:  2,
+		},
+	}, &proto.MicroService{
+		ServiceName: "ab",
+	}, nil)
+	if err == nil {
+		t.Fatalf("MatchRules mixed rules with higher BLACK priority failed")
+	}
+
+	err = MatchRules([]*proto.ServiceRule{
+		{
+			RuleType:  "WHITE",
+			Attribute: "ServiceName",
+			Pattern:   "^a.*",
+			Priority:  3,
+		},
+		{
+			RuleType:  "BLACK",
+			Attribute: "ServiceName",
+			Pattern:   "^ab.*",
+			Priority:  2,
+		},
+	}, &proto.MicroService{
+		ServiceName: "ab",
+	}, nil)
+	if err != nil {
+		t.Fatalf("MatchRules mixed rules with higher WHITE priority failed")
+	}
+
+	err = MatchRules([]*proto.ServiceRule{
+		{
+			RuleType:  "WHITE",
+			Attribute: "ServiceName",
+			Pattern:   "^a.*",
+			ValidTo:   "1",
+		},
+	}, &proto.MicroService{
+		ServiceName: "b",
+	}, nil)
+	if err != nil {
+		t.Fatalf("MatchRules with expired WHITE rule failed")
+	}
+
+	rules := []*proto.ServiceRule{
+		{
+			RuleType:    "WHITE",
+			Attribute:   "InstanceIP",
+			PatternType: "CIDR",
+			Pattern:     "10.0.0.0/8",
+		},
+	}
+	err = MatchRulesWithIP(rules, &proto.MicroService{}, nil, []string{"10.1.2.3"})
+	if err != nil {
+		t.Fatalf("MatchRulesWithIP in CIDR failed")
+	}
+	err = MatchRulesWithIP(rules, &proto.MicroService{}, nil, []string{"192.168.0.1"})
+	if err == nil {
+		t.Fatalf("MatchRulesWithIP not in CIDR failed")
+	}
+	err = MatchRulesWithIP(rules, &proto.MicroService{}, nil, []string{"192.168.0.1", "10.1.2.3"})
+	if err != nil {
+		t.Fatalf("MatchRulesWithIP any instance in CIDR failed")
+	}
+	err = MatchRulesWithIP(rules, &proto.MicroService{}, nil, nil)
+	if err == nil {
+		t.Fatalf("MatchRulesWithIP white rule without consumer ip should deny")
+	}
+
+	rules = []*proto.ServiceRule{
+		{
+			RuleType:    "BLACK",
+			Attribute:   "InstanceIP",
+			PatternType: "CIDR",
+			Pattern:     "10.0.0.0/8",
+		},
+	}
+	err = MatchRulesWithIP(rules, &proto.MicroService{}, nil, nil)
+	if err != nil {
+		t.Fatalf("MatchRulesWithIP black rule without consumer ip failed")
+	}
+	err = MatchRulesWithIP(rules, &proto.MicroService{}, nil, []string{"10.1.2.3"})
+	if err == nil {
+		t.Fatalf("MatchRulesWithIP black rule in CIDR failed")
+	}
+
+	ctx := util.SetContext(context.Background(), CTX_PEER_IP, "10.1.2.3")
+	if ips := ConsumerIPsOf(ctx, rules); len(ips) != 1 || ips[0] != "10.1.2.3" {
+		t.Fatalf("ConsumerIPsOf failed, %v", ips)
+	}
+	if ips := ConsumerIPsOf(context.Background(), rules); len(ips) != 0 {
+		t.Fatalf("ConsumerIPsOf without peer failed, %v", ips)
+	}
+}
+
+func TestEvaluateRules(t *testing.T) {
+	results, err := EvaluateRules([]*proto.ServiceRule{
+		{
+			RuleType:  "BLACK",
+			Attribute: "ServiceName",
+			Pattern:   "^b.*",
+		},
+		{
+			RuleType:  "WHITE",
+			Attribute: "ServiceName",
+			Pattern:   "^a.*",
+			Priority:  1,
+		},
+		{
+			RuleType:  "WHITE",
+			Attribute: "Version",
+			Pattern:   ".*",
+			ValidFrom: "4102444800",
+		},
+	}, &proto.MicroService{
+		ServiceName: "a",
+		Version:     "1.0.0",
+	}, nil, nil)
+	if err != nil || len(results) != 3 {
+		t.Fatalf("EvaluateRules failed")
+	}
+	if !results[0].Decisive || !results[0].Matched || results[0].Rule.RuleType != "WHITE" {
+		t.Fatalf("EvaluateRules decisive rule failed")
+	}
+	if results[1].Decisive || results[1].Matched {
+		t.Fatalf("EvaluateRules unmatched rule failed")
+	}
+	if results[2].Active {
+		t.Fatalf("EvaluateRules inactive rule failed")
+	}
 }
 
 func TestGetConsumer(t *testing.T) {
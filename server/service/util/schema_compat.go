@@ -0,0 +1,441 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"github.com/ghodss/yaml"
+	"golang.org/x/net/context"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	httpMethods       = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+	pathTemplateRegex = regexp.MustCompile(`\{[^}]*\}`)
+)
+
+type apiSchema struct {
+	Ref   string     `json:"$ref"`
+	Type  string     `json:"type"`
+	Items *apiSchema `json:"items"`
+}
+
+type apiParameter struct {
+	Ref      string     `json:"$ref"`
+	Name     string     `json:"name"`
+	In       string     `json:"in"`
+	Required bool       `json:"required"`
+	Type     string     `json:"type"`
+	Items    *apiSchema `json:"items"`
+	Schema   *apiSchema `json:"schema"`
+}
+
+type apiMediaType struct {
+	Schema *apiSchema `json:"schema"`
+}
+
+type apiRequestBody struct {
+	Ref      string                   `json:"$ref"`
+	Required bool                     `json:"required"`
+	Content  map[string]*apiMediaType `json:"content"`
+}
+
+type apiResponse struct {
+	Ref     string                   `json:"$ref"`
+	Schema  *apiSchema               `json:"schema"`
+	Content map[string]*apiMediaType `json:"content"`
+}
+
+type apiOperation struct {
+	Parameters  []*apiParameter         `json:"parameters"`
+	RequestBody *apiRequestBody         `json:"requestBody"`
+	Responses   map[string]*apiResponse `json:"responses"`
+
+	// position of the path parameters in the path template
+	pathParams map[string]int
+}
+
+type apiComponents struct {
+	Parameters    map[string]*apiParameter   `json:"parameters"`
+	Responses     map[string]*apiResponse    `json:"responses"`
+	RequestBodies map[string]*apiRequestBody `json:"requestBodies"`
+}
+
+// apiDocument is the part of a Swagger 2.0 or OpenAPI 3.x document
+// which the compatibility analysis cares about
+type apiDocument struct {
+	Swagger    string                                `json:"swagger"`
+	OpenAPI    string                                `json:"openapi"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Parameters map[string]*apiParameter              `json:"parameters"`
+	Responses  map[string]*apiResponse               `json:"responses"`
+	Components apiComponents                         `json:"components"`
+
+	operations map[string]*apiOperation
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func (doc *apiDocument) parameter(p *apiParameter) *apiParameter {
+	if len(p.Ref) == 0 {
+		return p
+	}
+	name := refName(p.Ref)
+	if r, ok := doc.Parameters[name]; ok {
+		return r
+	}
+	if r, ok := doc.Components.Parameters[name]; ok {
+		return r
+	}
+	return p
+}
+
+func (doc *apiDocument) response(r *apiResponse) *apiResponse {
+	if r == nil || len(r.Ref) == 0 {
+		return r
+	}
+	name := refName(r.Ref)
+	if o, ok := doc.Responses[name]; ok {
+		return o
+	}
+	if o, ok := doc.Components.Responses[name]; ok {
+		return o
+	}
+	return r
+}
+
+func (doc *apiDocument) requestBody(b *apiRequestBody) *apiRequestBody {
+	if b == nil || len(b.Ref) == 0 {
+		return b
+	}
+	if o, ok := doc.Components.RequestBodies[refName(b.Ref)]; ok {
+		return o
+	}
+	return b
+}
+
+// params returns the resolved parameters of the operation indexed by
+// location and name, operation level parameters override path level ones
+func (doc *apiDocument) params(op *apiOperation) map[string]*apiParameter {
+	params := make(map[string]*apiParameter, len(op.Parameters))
+	for _, p := range op.Parameters {
+		p = doc.parameter(p)
+		key := p.In + ":" + p.Name
+		switch p.In {
+		case "body":
+			// the name of body parameter is meaningless for the consumers
+			key = p.In + ":"
+		case "path":
+			if i, ok := op.pathParams[p.Name]; ok {
+				key = fmt.Sprintf("%s:%d", p.In, i)
+			}
+		}
+		params[key] = p
+	}
+	return params
+}
+
+func parseAPIDocument(content string) (*apiDocument, error) {
+	data, err := yaml.YAMLToJSON(util.StringToBytesWithNoCopy(content))
+	if err != nil {
+		return nil, err
+	}
+	doc := &apiDocument{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	if (len(doc.Swagger) == 0 && len(doc.OpenAPI) == 0) || doc.Paths == nil {
+		return nil, errors.New("not an OpenAPI/Swagger document")
+	}
+
+	doc.operations = make(map[string]*apiOperation)
+	for path, item := range doc.Paths {
+		var common []*apiParameter
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &common); err != nil {
+				return nil, err
+			}
+		}
+		// '/users/{id}' and '/users/{userId}' are the same operation
+		normalized := pathTemplateRegex.ReplaceAllString(path, "{}")
+		templates := pathTemplateRegex.FindAllString(path, -1)
+		pathParams := make(map[string]int, len(templates))
+		for i, template := range templates {
+			pathParams[template[1:len(template)-1]] = i
+		}
+		for _, method := range httpMethods {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+			op := &apiOperation{}
+			if err := json.Unmarshal(raw, op); err != nil {
+				return nil, err
+			}
+			op.Parameters = append(append([]*apiParameter{}, common...), op.Parameters...)
+			op.pathParams = pathParams
+			doc.operations[strings.ToUpper(method)+" "+normalized] = op
+		}
+	}
+	return doc, nil
+}
+
+func schemaType(s *apiSchema) string {
+	switch {
+	case s == nil:
+		return ""
+	case len(s.Ref) > 0:
+		return refName(s.Ref)
+	case s.Type == "array":
+		return "array<" + schemaType(s.Items) + ">"
+	default:
+		return s.Type
+	}
+}
+
+func parameterType(p *apiParameter) string {
+	if p.Schema != nil {
+		return schemaType(p.Schema)
+	}
+	return schemaType(&apiSchema{Type: p.Type, Items: p.Items})
+}
+
+func contentType(content map[string]*apiMediaType) string {
+	if len(content) == 0 {
+		return ""
+	}
+	if mt, ok := content["application/json"]; ok && mt != nil {
+		return schemaType(mt.Schema)
+	}
+	keys := make([]string, 0, len(content))
+	for k := range content {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if mt := content[keys[0]]; mt != nil {
+		return schemaType(mt.Schema)
+	}
+	return ""
+}
+
+func responseType(r *apiResponse) string {
+	if r.Schema != nil {
+		return schemaType(r.Schema)
+	}
+	return contentType(r.Content)
+}
+
+func compareOperation(name string, oldDoc *apiDocument, oldOp *apiOperation,
+	newDoc *apiDocument, newOp *apiOperation) (changes []*pb.SchemaChange) {
+	change := func(t string, breaking bool, format string, args ...interface{}) {
+		changes = append(changes, &pb.SchemaChange{
+			Type:      t,
+			Operation: name,
+			Breaking:  breaking,
+			Message:   fmt.Sprintf(format, args...),
+		})
+	}
+
+	oldParams, newParams := oldDoc.params(oldOp), newDoc.params(newOp)
+	keys := make([]string, 0, len(newParams))
+	for key := range newParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		newParam := newParams[key]
+		oldParam, ok := oldParams[key]
+		switch {
+		case !ok && newParam.Required:
+			change(pb.SCHEMA_CHANGE_PARAMETER_REQUIRED, true,
+				"required %s parameter '%s' is added", newParam.In, newParam.Name)
+		case !ok:
+			// optional parameter is compatible
+		case !oldParam.Required && newParam.Required:
+			change(pb.SCHEMA_CHANGE_PARAMETER_REQUIRED, true,
+				"%s parameter '%s' becomes required", newParam.In, newParam.Name)
+			fallthrough
+		default:
+			if o, n := parameterType(oldParam), parameterType(newParam); o != n {
+				change(pb.SCHEMA_CHANGE_PARAMETER_TYPE_CHANGED, true,
+					"type of %s parameter '%s' is changed from '%s' to '%s'", newParam.In, newParam.Name, o, n)
+			}
+		}
+	}
+	keys = keys[:0]
+	for key := range oldParams {
+		if _, ok := newParams[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		change(pb.SCHEMA_CHANGE_PARAMETER_REMOVED, false,
+			"%s parameter '%s' is removed", oldParams[key].In, oldParams[key].Name)
+	}
+
+	oldBody, newBody := oldDoc.requestBody(oldOp.RequestBody), newDoc.requestBody(newOp.RequestBody)
+	if newBody != nil {
+		if newBody.Required && (oldBody == nil || !oldBody.Required) {
+			change(pb.SCHEMA_CHANGE_REQUEST_BODY_REQUIRED, true, "request body becomes required")
+		}
+		if oldBody != nil {
+			if o, n := contentType(oldBody.Content), contentType(newBody.Content); o != n {
+				change(pb.SCHEMA_CHANGE_PARAMETER_TYPE_CHANGED, true,
+					"type of request body is changed from '%s' to '%s'", o, n)
+			}
+		}
+	}
+
+	codes := make([]string, 0, len(oldOp.Responses))
+	for code := range oldOp.Responses {
+		// only the successful responses are what the consumers depend on
+		if strings.HasPrefix(code, "2") || code == "default" {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		oldResp := oldDoc.response(oldOp.Responses[code])
+		newResp := newDoc.response(newOp.Responses[code])
+		if newResp == nil {
+			change(pb.SCHEMA_CHANGE_RESPONSE_REMOVED, true, "response '%s' is removed", code)
+			continue
+		}
+		if oldResp == nil {
+			continue
+		}
+		if o, n := responseType(oldResp), responseType(newResp); o != n {
+			change(pb.SCHEMA_CHANGE_RESPONSE_TYPE_CHANGED, true,
+				"type of response '%s' is changed from '%s' to '%s'", code, o, n)
+		}
+	}
+	return
+}
+
+// CompareSchemas analyzes the changes between two Swagger 2.0 or OpenAPI 3.x
+// documents written in json or yaml, it returns an error if any of them
+// could not be parsed
+func CompareSchemas(oldSchema, newSchema string) ([]*pb.SchemaChange, error) {
+	oldDoc, err := parseAPIDocument(oldSchema)
+	if err != nil {
+		return nil, err
+	}
+	newDoc, err := parseAPIDocument(newSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(oldDoc.operations)+len(newDoc.operations))
+	for name := range oldDoc.operations {
+		names = append(names, name)
+	}
+	for name := range newDoc.operations {
+		if _, ok := oldDoc.operations[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]*pb.SchemaChange, 0)
+	for _, name := range names {
+		oldOp, newOp := oldDoc.operations[name], newDoc.operations[name]
+		switch {
+		case newOp == nil:
+			changes = append(changes, &pb.SchemaChange{
+				Type:      pb.SCHEMA_CHANGE_OPERATION_REMOVED,
+				Operation: name,
+				Breaking:  true,
+				Message:   "operation is removed",
+			})
+		case oldOp == nil:
+			changes = append(changes, &pb.SchemaChange{
+				Type:      pb.SCHEMA_CHANGE_OPERATION_ADDED,
+				Operation: name,
+				Message:   "operation is added",
+			})
+		default:
+			changes = append(changes, compareOperation(name, oldDoc, oldOp, newDoc, newOp)...)
+		}
+	}
+	return changes, nil
+}
+
+// GetSchemaCompatibilityPolicy returns the policy applied to the incompatible
+// schema changes in the environment, it is configured like
+// 'production:reject,testing:warn', the production environment rejects
+// and the others warn by default
+func GetSchemaCompatibilityPolicy(env string) string {
+	if len(env) == 0 {
+		env = pb.ENV_PROD
+	}
+	for _, item := range strings.Split(apt.ServerInfo.Config.SchemaCompatibilityPolicy, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), ":", 2)
+		if len(kv) != 2 || kv[0] != env {
+			continue
+		}
+		switch policy := strings.ToLower(strings.TrimSpace(kv[1])); policy {
+		case pb.SCHEMA_POLICY_REJECT, pb.SCHEMA_POLICY_WARN, pb.SCHEMA_POLICY_ALLOW:
+			return policy
+		}
+		util.Logger().Warnf(nil, "invalid schema compatibility policy '%s', use the default one.", item)
+	}
+	if env == pb.ENV_PROD {
+		return pb.SCHEMA_POLICY_REJECT
+	}
+	return pb.SCHEMA_POLICY_WARN
+}
+
+// NewSchemaCompatibilityReport analyzes the schema changes of the service
+// version and lists the consumers which may be affected
+func NewSchemaCompatibilityReport(ctx context.Context, domainProject string, service *pb.MicroService,
+	schemaId, oldSchema, newSchema string) (*pb.SchemaCompatibilityReport, error) {
+	report := &pb.SchemaCompatibilityReport{
+		SchemaId:    schemaId,
+		Environment: service.Environment,
+		Policy:      GetSchemaCompatibilityPolicy(service.Environment),
+		Compatible:  true,
+	}
+	if len(oldSchema) > 0 && oldSchema != newSchema {
+		changes, err := CompareSchemas(oldSchema, newSchema)
+		if err != nil {
+			util.Logger().Debugf("skip schema %s/%s compatibility analysis: %s",
+				service.ServiceId, schemaId, err.Error())
+		} else {
+			report.Analyzed = true
+			report.Changes = changes
+			report.Compatible = len(report.BreakingChanges()) == 0
+		}
+	}
+
+	dr := NewDependencyRelation(ctx, domainProject, service, service)
+	consumers, err := dr.GetDependencyConsumers(WithoutSelfDependency())
+	if err != nil {
+		return nil, err
+	}
+	report.Consumers = consumers
+	return report, nil
+}
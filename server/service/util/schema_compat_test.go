@@ -0,0 +1,202 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"testing"
+)
+
+const swaggerV1 = `
+swagger: "2.0"
+info:
+  title: hello
+  version: 1.0.0
+paths:
+  /hello/{name}:
+    parameters:
+      - name: name
+        in: path
+        required: true
+        type: string
+    get:
+      parameters:
+        - name: lang
+          in: query
+          type: string
+      responses:
+        200:
+          description: ok
+          schema:
+            $ref: '#/definitions/Greeting'
+  /bye:
+    post:
+      parameters:
+        - $ref: '#/parameters/Reason'
+      responses:
+        200:
+          description: ok
+          schema:
+            type: string
+parameters:
+  Reason:
+    name: reason
+    in: query
+    type: string
+definitions:
+  Greeting:
+    type: object
+`
+
+func changesOf(t *testing.T, oldSchema, newSchema string) map[string]*proto.SchemaChange {
+	changes, err := CompareSchemas(oldSchema, newSchema)
+	if err != nil {
+		t.Fatalf("CompareSchemas failed, %s", err.Error())
+	}
+	m := make(map[string]*proto.SchemaChange, len(changes))
+	for _, change := range changes {
+		m[change.Type+" "+change.Operation] = change
+	}
+	return m
+}
+
+func TestCompareSchemas(t *testing.T) {
+	_, err := CompareSchemas("not a schema", swaggerV1)
+	if err == nil {
+		t.Fatalf("CompareSchemas with invalid schema failed")
+	}
+
+	changes := changesOf(t, swaggerV1, swaggerV1)
+	if len(changes) != 0 {
+		t.Fatalf("CompareSchemas with the same schema failed, %v", changes)
+	}
+
+	// json is also accepted and the path template name does not matter
+	changes = changesOf(t, swaggerV1, `{
+  "swagger": "2.0",
+  "paths": {
+    "/hello/{id}": {
+      "get": {
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "type": "string"},
+          {"name": "lang", "in": "query", "required": true, "type": "string"},
+          {"name": "verbose", "in": "query", "type": "boolean"}
+        ],
+        "responses": {"200": {"description": "ok", "schema": {"type": "string"}}}
+      }
+    },
+    "/welcome": {
+      "get": {"responses": {"200": {"description": "ok"}}}
+    }
+  }
+}`)
+	for _, expect := range []struct {
+		Key      string
+		Breaking bool
+	}{
+		{proto.SCHEMA_CHANGE_OPERATION_REMOVED + " POST /bye", true},
+		{proto.SCHEMA_CHANGE_OPERATION_ADDED + " GET /welcome", false},
+		{proto.SCHEMA_CHANGE_PARAMETER_REQUIRED + " GET /hello/{}", true},
+		{proto.SCHEMA_CHANGE_RESPONSE_TYPE_CHANGED + " GET /hello/{}", true},
+	} {
+		change, ok := changes[expect.Key]
+		if !ok || change.Breaking != expect.Breaking {
+			t.Fatalf("CompareSchemas failed, %s not found in %v", expect.Key, changes)
+		}
+	}
+	if len(changes) != 4 {
+		t.Fatalf("CompareSchemas failed, %v", changes)
+	}
+}
+
+func TestCompareOpenAPI3Schemas(t *testing.T) {
+	v1 := `
+openapi: 3.0.0
+paths:
+  /orders:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Order'
+      responses:
+        '201':
+          description: created
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Order'
+`
+	v2 := `
+openapi: 3.0.0
+paths:
+  /orders:
+    post:
+      requestBody:
+        $ref: '#/components/requestBodies/NewOrder'
+      responses:
+        '202':
+          description: accepted
+components:
+  requestBodies:
+    NewOrder:
+      required: true
+      content:
+        application/json:
+          schema:
+            $ref: '#/components/schemas/Order'
+`
+	changes := changesOf(t, v1, v2)
+	if _, ok := changes[proto.SCHEMA_CHANGE_REQUEST_BODY_REQUIRED+" POST /orders"]; !ok {
+		t.Fatalf("CompareSchemas failed, required request body not found in %v", changes)
+	}
+	if _, ok := changes[proto.SCHEMA_CHANGE_RESPONSE_REMOVED+" POST /orders"]; !ok {
+		t.Fatalf("CompareSchemas failed, removed response not found in %v", changes)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("CompareSchemas failed, %v", changes)
+	}
+}
+
+func TestGetSchemaCompatibilityPolicy(t *testing.T) {
+	old := apt.ServerInfo.Config.SchemaCompatibilityPolicy
+	defer func() {
+		apt.ServerInfo.Config.SchemaCompatibilityPolicy = old
+	}()
+
+	apt.ServerInfo.Config.SchemaCompatibilityPolicy = ""
+	if GetSchemaCompatibilityPolicy("") != proto.SCHEMA_POLICY_REJECT ||
+		GetSchemaCompatibilityPolicy(proto.ENV_PROD) != proto.SCHEMA_POLICY_REJECT ||
+		GetSchemaCompatibilityPolicy(proto.ENV_DEV) != proto.SCHEMA_POLICY_WARN {
+		t.Fatalf("GetSchemaCompatibilityPolicy with default config failed")
+	}
+
+	apt.ServerInfo.Config.SchemaCompatibilityPolicy = "production:warn, testing:allow,development:xxx"
+	if GetSchemaCompatibilityPolicy(proto.ENV_PROD) != proto.SCHEMA_POLICY_WARN ||
+		GetSchemaCompatibilityPolicy(proto.ENV_TEST) != proto.SCHEMA_POLICY_ALLOW ||
+		GetSchemaCompatibilityPolicy(proto.ENV_DEV) != proto.SCHEMA_POLICY_WARN {
+		t.Fatalf("GetSchemaCompatibilityPolicy with custom config failed")
+	}
+}
+
+func TestWarningOf(t *testing.T) {
+	if w := WarningOf(`path "/a" removed, \n`); w != `299 - "path \"/a\" removed, \\n"` {
+		t.Fatalf("TestWarningOf failed, %s", w)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/ghodss/yaml"
+	"golang.org/x/net/context"
+	"strings"
+)
+
+// the schema key refers to the content by the address with this prefix,
+// the value without prefix is the content stored by the old versions
+const SCHEMA_CONTENT_REF_PREFIX = "sha256:"
+
+var lineBreakReplacer = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+// SchemaContentHash returns the address of the schema content
+func SchemaContentHash(content string) string {
+	sum := sha256.Sum256(util.StringToBytesWithNoCopy(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// CanonicalSchemaSummary returns a hash of the schema which ignores the
+// format, so the same json or yaml document written in different indents,
+// key orders or styles has the same summary
+func CanonicalSchemaSummary(content string) string {
+	canonical := strings.TrimSpace(lineBreakReplacer.Replace(content))
+	if data, err := yaml.YAMLToJSON(util.StringToBytesWithNoCopy(content)); err == nil {
+		var tree interface{}
+		if json.Unmarshal(data, &tree) == nil {
+			if _, ok := tree.(string); !ok && tree != nil {
+				// keys of the map are sorted when marshal
+				data, _ = json.Marshal(tree)
+				canonical = util.BytesToStringWithNoCopy(data)
+			}
+		}
+	}
+	return SchemaContentHash(canonical)
+}
+
+// GetSchemaContentHash returns the content address of the value stored in
+// schema key
+func GetSchemaContentHash(value []byte) string {
+	v := util.BytesToStringWithNoCopy(value)
+	if strings.HasPrefix(v, SCHEMA_CONTENT_REF_PREFIX) {
+		return v[len(SCHEMA_CONTENT_REF_PREFIX):]
+	}
+	return SchemaContentHash(v)
+}
+
+// SchemaContentOpera returns the operations to store the schema content by
+// its address once, and refer to the content from the schema key
+func SchemaContentOpera(domainProject, serviceId, schemaId, content string) []registry.PluginOp {
+	hash := SchemaContentHash(content)
+	return []registry.PluginOp{
+		registry.OpPut(registry.WithStrKey(apt.GenerateSchemaContentKey(domainProject, hash)),
+			registry.WithStrValue(content)),
+		registry.OpPut(registry.WithStrKey(apt.GenerateSchemaRefKey(domainProject, hash, serviceId, schemaId))),
+		registry.OpPut(registry.WithStrKey(apt.GenerateServiceSchemaKey(domainProject, serviceId, schemaId)),
+			registry.WithStrValue(SCHEMA_CONTENT_REF_PREFIX+hash)),
+	}
+}
+
+// ReleaseSchemaContentOpera returns the operation to remove the reference
+// of the schema to the old content, the content which is no longer referred
+// should be removed by GCSchemaContent after commit
+func ReleaseSchemaContentOpera(domainProject, serviceId, schemaId, content string) registry.PluginOp {
+	hash := SchemaContentHash(content)
+	return registry.OpDel(registry.WithStrKey(apt.GenerateSchemaRefKey(domainProject, hash, serviceId, schemaId)))
+}
+
+// SchemaRevisionContentOpera returns the operation to refer to the content
+// from the schema revision, the content is stored by SchemaContentOpera
+func SchemaRevisionContentOpera(domainProject, serviceId, schemaId, revision, content string) registry.PluginOp {
+	hash := SchemaContentHash(content)
+	return registry.OpPut(registry.WithStrKey(apt.GenerateSchemaRevisionRefKey(domainProject, hash, serviceId, schemaId, revision)))
+}
+
+// ReleaseSchemaRevisionContentOpera returns the operation to remove the
+// reference of the schema revision, the value is the one stored in the
+// revision, the old revisions which store the content inline refer nothing
+func ReleaseSchemaRevisionContentOpera(domainProject, serviceId, schemaId, revision, value string) (registry.PluginOp, string, bool) {
+	if !strings.HasPrefix(value, SCHEMA_CONTENT_REF_PREFIX) {
+		return registry.PluginOp{}, "", false
+	}
+	hash := value[len(SCHEMA_CONTENT_REF_PREFIX):]
+	return registry.OpDel(registry.WithStrKey(apt.GenerateSchemaRevisionRefKey(domainProject, hash, serviceId, schemaId, revision))),
+		hash, true
+}
+
+// ResolveSchemaContent returns the content of the value stored in schema key
+func ResolveSchemaContent(ctx context.Context, domainProject string, value []byte) (string, error) {
+	v := util.BytesToStringWithNoCopy(value)
+	if !strings.HasPrefix(v, SCHEMA_CONTENT_REF_PREFIX) {
+		return v, nil
+	}
+	key := apt.GenerateSchemaContentKey(domainProject, v[len(SCHEMA_CONTENT_REF_PREFIX):])
+	resp, err := backend.Registry().Do(ctx, registry.GET, registry.WithStrKey(key))
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Kvs) == 0 {
+		util.Logger().Warnf(nil, "schema content %s does not exist", v)
+		return "", nil
+	}
+	return util.BytesToStringWithNoCopy(resp.Kvs[0].Value), nil
+}
+
+// GetSchemaContentRefs returns the schemas which refer to the content, the
+// references of the revisions are not included
+func GetSchemaContentRefs(ctx context.Context, domainProject, hash string) ([]*pb.SchemaRef, error) {
+	key := util.StringJoin([]string{apt.GetSchemaRefRootKey(domainProject), hash, ""}, "/")
+	resp, err := backend.Registry().Do(ctx, registry.GET,
+		registry.WithStrKey(key),
+		registry.WithPrefix(),
+		registry.WithKeyOnly())
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]*pb.SchemaRef, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		arr := strings.Split(util.BytesToStringWithNoCopy(kv.Key[len(key):]), "/")
+		if len(arr) != 2 {
+			continue
+		}
+		refs = append(refs, &pb.SchemaRef{ServiceId: arr[0], SchemaId: arr[1]})
+	}
+	return refs, nil
+}
+
+// GCSchemaContent removes the contents which are not referred by any schema
+// or revision, the content uploaded again during the check is kept by the
+// revision compare
+func GCSchemaContent(ctx context.Context, domainProject string, hashes ...string) {
+	for _, hash := range hashes {
+		resp, err := backend.Registry().Do(ctx, registry.GET,
+			registry.WithStrKey(util.StringJoin([]string{apt.GetSchemaRefRootKey(domainProject), hash, ""}, "/")),
+			registry.WithPrefix(),
+			registry.WithCountOnly())
+		if err != nil {
+			util.Logger().Errorf(err, "gc schema content %s failed", hash)
+			continue
+		}
+		if resp.Count > 0 {
+			continue
+		}
+		key := apt.GenerateSchemaContentKey(domainProject, hash)
+		resp, err = backend.Registry().Do(ctx, registry.GET, registry.WithStrKey(key), registry.WithKeyOnly())
+		if err != nil {
+			util.Logger().Errorf(err, "gc schema content %s failed", hash)
+			continue
+		}
+		if len(resp.Kvs) == 0 {
+			continue
+		}
+		_, err = backend.Registry().TxnWithCmp(ctx,
+			[]registry.PluginOp{registry.OpDel(registry.WithStrKey(key))},
+			[]registry.CompareOp{registry.OpCmp(registry.CmpStrModRev(key), registry.CMP_EQUAL, resp.Kvs[0].ModRevision)},
+			nil)
+		if err != nil {
+			util.Logger().Errorf(err, "gc schema content %s failed", hash)
+		}
+	}
+}
+
+// GetServiceSchemaContentHashes returns the content addresses of the
+// schemas of the service, keyed by schemaId
+func GetServiceSchemaContentHashes(ctx context.Context, domainProject, serviceId string) (map[string]string, error) {
+	key := apt.GenerateServiceSchemaKey(domainProject, serviceId, "")
+	resp, err := backend.Store().Schema().Search(ctx, registry.WithStrKey(key), registry.WithPrefix(), registry.WithNoCache())
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		schemaId, value := pb.GetInfoFromSchemaKV(kv)
+		hashes[schemaId] = GetSchemaContentHash(value)
+	}
+	return hashes, nil
+}
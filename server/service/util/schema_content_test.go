@@ -0,0 +1,51 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"testing"
+)
+
+func TestCanonicalSchemaSummary(t *testing.T) {
+	json := `{"swagger": "2.0", "info": {"version": "1.0.0", "title": "hello"}}`
+	yaml := "swagger: \"2.0\"\ninfo:\n  title: hello\n  version: 1.0.0\n"
+	if CanonicalSchemaSummary(json) != CanonicalSchemaSummary(yaml) {
+		t.Fatalf("CanonicalSchemaSummary the same document failed")
+	}
+	if CanonicalSchemaSummary(json) == CanonicalSchemaSummary(`{"swagger": "2.0"}`) {
+		t.Fatalf("CanonicalSchemaSummary the different documents failed")
+	}
+	if CanonicalSchemaSummary("plain text\r\n") != CanonicalSchemaSummary("plain text") {
+		t.Fatalf("CanonicalSchemaSummary plain text failed")
+	}
+	if len(CanonicalSchemaSummary("")) != 64 {
+		t.Fatalf("CanonicalSchemaSummary empty content failed")
+	}
+}
+
+func TestGetSchemaContentHash(t *testing.T) {
+	hash := SchemaContentHash("plain text")
+	if GetSchemaContentHash([]byte(SCHEMA_CONTENT_REF_PREFIX+hash)) != hash {
+		t.Fatalf("GetSchemaContentHash of reference failed")
+	}
+	if GetSchemaContentHash([]byte("plain text")) != hash {
+		t.Fatalf("GetSchemaContentHash of old content failed")
+	}
+	if SchemaContentHash("plain text\n") == hash {
+		t.Fatalf("SchemaContentHash should not ignore the format")
+	}
+}
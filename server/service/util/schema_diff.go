@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"github.com/ghodss/yaml"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func parseSchemaTree(content string) interface{} {
+	var tree interface{}
+	data, err := yaml.YAMLToJSON(util.StringToBytesWithNoCopy(content))
+	if err == nil && json.Unmarshal(data, &tree) == nil {
+		return tree
+	}
+	// not a json or yaml document, compare it as plain text
+	return content
+}
+
+func diffSchemaTree(path string, o, n interface{}) (diffs []*pb.SchemaDiff) {
+	switch ov := o.(type) {
+	case map[string]interface{}:
+		nv, ok := n.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(ov)+len(nv))
+		for k := range ov {
+			keys = append(keys, k)
+		}
+		for k := range nv {
+			if _, ok := ov[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := path + "/" + pointerEscaper.Replace(k)
+			oc, inOld := ov[k]
+			nc, inNew := nv[k]
+			switch {
+			case !inOld:
+				diffs = append(diffs, &pb.SchemaDiff{Path: p, Type: pb.SCHEMA_DIFF_ADDED, New: nc})
+			case !inNew:
+				diffs = append(diffs, &pb.SchemaDiff{Path: p, Type: pb.SCHEMA_DIFF_REMOVED, Old: oc})
+			default:
+				diffs = append(diffs, diffSchemaTree(p, oc, nc)...)
+			}
+		}
+		return
+	case []interface{}:
+		nv, ok := n.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(ov) || i < len(nv); i++ {
+			p := path + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(ov):
+				diffs = append(diffs, &pb.SchemaDiff{Path: p, Type: pb.SCHEMA_DIFF_ADDED, New: nv[i]})
+			case i >= len(nv):
+				diffs = append(diffs, &pb.SchemaDiff{Path: p, Type: pb.SCHEMA_DIFF_REMOVED, Old: ov[i]})
+			default:
+				diffs = append(diffs, diffSchemaTree(p, ov[i], nv[i])...)
+			}
+		}
+		return
+	}
+	if !reflect.DeepEqual(o, n) {
+		diffs = append(diffs, &pb.SchemaDiff{Path: path, Type: pb.SCHEMA_DIFF_CHANGED, Old: o, New: n})
+	}
+	return
+}
+
+// DiffSchemas returns the structural differences between two schemas, every
+// difference is located by a json pointer. The schemas which are not written
+// in json or yaml are compared as a whole
+func DiffSchemas(oldSchema, newSchema string) []*pb.SchemaDiff {
+	diffs := diffSchemaTree("", parseSchemaTree(oldSchema), parseSchemaTree(newSchema))
+	if diffs == nil {
+		return []*pb.SchemaDiff{}
+	}
+	return diffs
+}
@@ -0,0 +1,50 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"testing"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	diffs := DiffSchemas(swaggerV1, swaggerV1)
+	if len(diffs) != 0 {
+		t.Fatalf("DiffSchemas the same schema failed, %v", diffs)
+	}
+
+	diffs = DiffSchemas(`{"a":{"b/c":1,"d":[1,2]},"e":"x"}`, "a:\n  b/c: 2\n  d: [1]\nf: y\n")
+	expected := map[string]string{
+		"/a/b~1c": proto.SCHEMA_DIFF_CHANGED,
+		"/a/d/1":  proto.SCHEMA_DIFF_REMOVED,
+		"/e":      proto.SCHEMA_DIFF_REMOVED,
+		"/f":      proto.SCHEMA_DIFF_ADDED,
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("DiffSchemas failed, %v", diffs)
+	}
+	for _, diff := range diffs {
+		if expected[diff.Path] != diff.Type {
+			t.Fatalf("DiffSchemas failed, unexpected %s %s", diff.Type, diff.Path)
+		}
+	}
+
+	diffs = DiffSchemas("syntax = \"proto3\";", "syntax = \"proto2\";")
+	if len(diffs) != 1 || diffs[0].Path != "" || diffs[0].Type != proto.SCHEMA_DIFF_CHANGED {
+		t.Fatalf("DiffSchemas plain text failed, %v", diffs)
+	}
+}
@@ -0,0 +1,360 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"encoding/json"
+	"github.com/apache/incubator-servicecomb-service-center/pkg/util"
+	apt "github.com/apache/incubator-servicecomb-service-center/server/core"
+	"github.com/apache/incubator-servicecomb-service-center/server/core/backend"
+	pb "github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
+	"github.com/ghodss/yaml"
+	"golang.org/x/net/context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var schemaIndex = &SchemaIndex{
+	items: make(map[string]map[string]*schemaIndexItem),
+}
+
+// indexDocument is the part of a Swagger 2.0 or OpenAPI 3.x document
+// which the search index cares about
+type indexDocument struct {
+	// the version may be written as a number in yaml
+	Swagger     interface{}                           `json:"swagger"`
+	OpenAPI     interface{}                           `json:"openapi"`
+	BasePath    string                                `json:"basePath"`
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]json.RawMessage            `json:"definitions"`
+	Components  struct {
+		Schemas map[string]json.RawMessage `json:"schemas"`
+	} `json:"components"`
+}
+
+type indexOperation struct {
+	OperationId string   `json:"operationId"`
+	Summary     string   `json:"summary"`
+	Tags        []string `json:"tags"`
+}
+
+// ParseSchemaOperations returns the operations and the model names declared
+// in the OpenAPI/Swagger schema, nothing is returned for the other schemas.
+// The paths of Swagger 2.0 operations are prefixed with the basePath.
+func ParseSchemaOperations(content string) ([]*pb.SchemaOperation, []string) {
+	data, err := yaml.YAMLToJSON(util.StringToBytesWithNoCopy(content))
+	if err != nil {
+		return nil, nil
+	}
+	doc := &indexDocument{}
+	if err := json.Unmarshal(data, doc); err != nil || (doc.Swagger == nil && doc.OpenAPI == nil) {
+		return nil, nil
+	}
+
+	basePath := strings.TrimRight(doc.BasePath, "/")
+	var operations []*pb.SchemaOperation
+	for path, item := range doc.Paths {
+		for _, method := range httpMethods {
+			raw, ok := item[method]
+			if !ok {
+				continue
+			}
+			op := &indexOperation{}
+			// the malformed operation is still indexed by method and path
+			json.Unmarshal(raw, op)
+			operations = append(operations, &pb.SchemaOperation{
+				Method:      strings.ToUpper(method),
+				Path:        basePath + path,
+				OperationId: op.OperationId,
+				Summary:     op.Summary,
+				Tags:        op.Tags,
+			})
+		}
+	}
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[i].Path < operations[j].Path
+	})
+
+	models := make([]string, 0, len(doc.Definitions)+len(doc.Components.Schemas))
+	for name := range doc.Definitions {
+		models = append(models, name)
+	}
+	for name := range doc.Components.Schemas {
+		models = append(models, name)
+	}
+	sort.Strings(models)
+	return operations, models
+}
+
+func isPathTemplate(segment string) bool {
+	return pathTemplateRegex.MatchString(segment)
+}
+
+// matchPath returns true if the path matches the pattern case-insensitively,
+// the templates like '{id}' in either of them match any segment
+func matchPath(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range patternSegments {
+		if isPathTemplate(segment) || isPathTemplate(pathSegments[i]) {
+			continue
+		}
+		if !strings.EqualFold(segment, pathSegments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(arr []string, s string) bool {
+	for _, v := range arr {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaIndexItem is a schema, or the paths of a service if the schemaId is
+// empty
+type schemaIndexItem struct {
+	serviceId  string
+	schemaId   string
+	operations []*pb.SchemaOperation
+	models     []string
+	paths      []*pb.ServicePath
+}
+
+func (item *schemaIndexItem) key() string {
+	return item.serviceId + "/" + item.schemaId
+}
+
+func (item *schemaIndexItem) empty() bool {
+	return len(item.operations)+len(item.models)+len(item.paths) == 0
+}
+
+type schemaQuery struct {
+	*pb.SearchSchemasRequest
+	words []string
+}
+
+func (q *schemaQuery) filterOperations() bool {
+	return len(q.Method)+len(q.Path)+len(q.OperationId)+len(q.Tag) > 0
+}
+
+func (q *schemaQuery) matchWords(texts ...string) bool {
+	text := strings.ToLower(strings.Join(texts, " "))
+	for _, word := range q.words {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *schemaQuery) matchOperation(op *pb.SchemaOperation) bool {
+	switch {
+	case len(q.Method) > 0 && !strings.EqualFold(q.Method, op.Method),
+		len(q.Path) > 0 && !matchPath(q.Path, op.Path),
+		len(q.OperationId) > 0 && !strings.EqualFold(q.OperationId, op.OperationId),
+		len(q.Tag) > 0 && !containsFold(op.Tags, q.Tag):
+		return false
+	}
+	return q.matchWords(append([]string{op.Method, op.Path, op.OperationId, op.Summary}, op.Tags...)...)
+}
+
+func (q *schemaQuery) matchServicePaths(item *schemaIndexItem) *pb.SchemaSearchResult {
+	// the service paths have no operation details and models
+	if len(q.Method)+len(q.OperationId)+len(q.Tag)+len(q.Model) > 0 {
+		return nil
+	}
+	var paths []*pb.ServicePath
+	for _, path := range item.paths {
+		if len(q.Path) > 0 && !matchPath(q.Path, path.Path) {
+			continue
+		}
+		if !q.matchWords(path.Path) {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return &pb.SchemaSearchResult{
+		ServiceId: item.serviceId,
+		Paths:     paths,
+	}
+}
+
+// match returns the matched part of the item, or nil if the item does not
+// match all the criteria
+func (q *schemaQuery) match(item *schemaIndexItem) *pb.SchemaSearchResult {
+	if len(item.schemaId) == 0 {
+		return q.matchServicePaths(item)
+	}
+
+	var operations []*pb.SchemaOperation
+	if q.filterOperations() || len(q.words) > 0 {
+		for _, op := range item.operations {
+			if q.matchOperation(op) {
+				operations = append(operations, op)
+			}
+		}
+	}
+
+	var models []string
+	wordsMatched := len(q.words) == 0 || len(operations) > 0
+	for _, name := range item.models {
+		switch {
+		case len(q.Model) > 0:
+			if !strings.EqualFold(q.Model, name) {
+				continue
+			}
+			wordsMatched = wordsMatched || q.matchWords(name)
+		case len(q.words) > 0 && !q.filterOperations():
+			if !q.matchWords(name) {
+				continue
+			}
+			wordsMatched = true
+		default:
+			continue
+		}
+		models = append(models, name)
+	}
+
+	if (q.filterOperations() && len(operations) == 0) ||
+		(len(q.Model) > 0 && len(models) == 0) ||
+		!wordsMatched || len(operations)+len(models) == 0 {
+		return nil
+	}
+	return &pb.SchemaSearchResult{
+		ServiceId:  item.serviceId,
+		SchemaId:   item.schemaId,
+		Operations: operations,
+		Models:     models,
+	}
+}
+
+// SchemaIndex indexes the operations and models declared in the schemas and
+// the paths of the services, it is kept up to date by the event handlers of
+// the schema and service stores
+type SchemaIndex struct {
+	// domainProject -> serviceId/schemaId -> item
+	items map[string]map[string]*schemaIndexItem
+	lock  sync.RWMutex
+}
+
+func (idx *SchemaIndex) set(domainProject string, item *schemaIndexItem) {
+	idx.lock.Lock()
+	items, ok := idx.items[domainProject]
+	if !ok {
+		items = make(map[string]*schemaIndexItem)
+		idx.items[domainProject] = items
+	}
+	if item.empty() {
+		delete(items, item.key())
+	} else {
+		items[item.key()] = item
+	}
+	if len(items) == 0 {
+		delete(idx.items, domainProject)
+	}
+	idx.lock.Unlock()
+}
+
+func (idx *SchemaIndex) SetSchema(domainProject, serviceId, schemaId, content string) {
+	operations, models := ParseSchemaOperations(content)
+	idx.set(domainProject, &schemaIndexItem{
+		serviceId:  serviceId,
+		schemaId:   schemaId,
+		operations: operations,
+		models:     models,
+	})
+}
+
+func (idx *SchemaIndex) RemoveSchema(domainProject, serviceId, schemaId string) {
+	idx.set(domainProject, &schemaIndexItem{serviceId: serviceId, schemaId: schemaId})
+}
+
+func (idx *SchemaIndex) SetServicePaths(domainProject, serviceId string, paths []*pb.ServicePath) {
+	idx.set(domainProject, &schemaIndexItem{serviceId: serviceId, paths: paths})
+}
+
+// RemoveService removes the paths and the schemas of the service
+func (idx *SchemaIndex) RemoveService(domainProject, serviceId string) {
+	prefix := serviceId + "/"
+	idx.lock.Lock()
+	items := idx.items[domainProject]
+	for key := range items {
+		if strings.HasPrefix(key, prefix) {
+			delete(items, key)
+		}
+	}
+	if len(items) == 0 {
+		delete(idx.items, domainProject)
+	}
+	idx.lock.Unlock()
+}
+
+// Search returns the schemas and the service paths in the domain project
+// which match all the criteria of the request, the service information is
+// not filled
+func (idx *SchemaIndex) Search(domainProject string, in *pb.SearchSchemasRequest) []*pb.SchemaSearchResult {
+	q := &schemaQuery{
+		SearchSchemasRequest: in,
+		words:                strings.Fields(strings.ToLower(in.Keyword)),
+	}
+	var results []*pb.SchemaSearchResult
+	idx.lock.RLock()
+	for _, item := range idx.items[domainProject] {
+		if result := q.match(item); result != nil {
+			results = append(results, result)
+		}
+	}
+	idx.lock.RUnlock()
+	return results
+}
+
+func SchemaSearchIndex() *SchemaIndex {
+	return schemaIndex
+}
+
+// RefreshSchemaIndex indexes the current content of the schema, the schema
+// is removed from the index if it does not exist
+func RefreshSchemaIndex(ctx context.Context, domainProject, serviceId, schemaId string) error {
+	key := apt.GenerateServiceSchemaKey(domainProject, serviceId, schemaId)
+	resp, err := backend.Registry().Do(ctx, registry.GET, registry.WithStrKey(key))
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 {
+		schemaIndex.RemoveSchema(domainProject, serviceId, schemaId)
+		return nil
+	}
+	content, err := ResolveSchemaContent(ctx, domainProject, resp.Kvs[0].Value)
+	if err != nil {
+		return err
+	}
+	schemaIndex.SetSchema(domainProject, serviceId, schemaId, content)
+	return nil
+}
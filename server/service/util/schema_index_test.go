@@ -0,0 +1,158 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package util
+
+import (
+	"github.com/apache/incubator-servicecomb-service-center/server/core/proto"
+	"testing"
+)
+
+const indexSwagger = `swagger: 2.0
+info:
+  title: order
+  version: 1.0.0
+basePath: /orders
+paths:
+  /:
+    post:
+      operationId: createOrder
+      summary: create an order
+      tags: [order]
+      responses:
+        200:
+          description: ok
+  /{id}:
+    get:
+      operationId: getOrder
+      tags: [order, query]
+      responses:
+        200:
+          description: ok
+definitions:
+  Order:
+    type: object
+  OrderItem:
+    type: object
+`
+
+const indexOpenAPI = `{"openapi":"3.0.0","info":{"title":"user","version":"1.0.0"},
+"paths":{"/users/{userId}":{"delete":{"operationId":"deleteUser","responses":{"200":{"description":"ok"}}}}},
+"components":{"schemas":{"User":{"type":"object"}}}}`
+
+func TestParseSchemaOperations(t *testing.T) {
+	ops, models := ParseSchemaOperations(indexSwagger)
+	if len(ops) != 2 || len(models) != 2 {
+		t.Fatalf("ParseSchemaOperations failed, %v %v", ops, models)
+	}
+	if ops[0].Method != "POST" || ops[0].Path != "/orders/" || ops[0].OperationId != "createOrder" ||
+		ops[1].Method != "GET" || ops[1].Path != "/orders/{id}" || len(ops[1].Tags) != 2 {
+		t.Fatalf("ParseSchemaOperations failed, %v", ops)
+	}
+	if models[0] != "Order" || models[1] != "OrderItem" {
+		t.Fatalf("ParseSchemaOperations failed, %v", models)
+	}
+
+	ops, models = ParseSchemaOperations(indexOpenAPI)
+	if len(ops) != 1 || ops[0].Method != "DELETE" || len(models) != 1 || models[0] != "User" {
+		t.Fatalf("ParseSchemaOperations OpenAPI 3 failed, %v %v", ops, models)
+	}
+
+	ops, models = ParseSchemaOperations("syntax = \"proto3\";")
+	if len(ops) != 0 || len(models) != 0 {
+		t.Fatalf("ParseSchemaOperations not OpenAPI failed, %v %v", ops, models)
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	cases := []struct {
+		pattern, path string
+		match         bool
+	}{
+		{"/orders", "/orders/", true},
+		{"/ORDERS/123", "/orders/{id}", true},
+		{"/orders/{orderId}", "/orders/{id}", true},
+		{"/orders", "/orders/{id}", false},
+		{"/orders/1/items", "/orders/{id}", false},
+		{"/users/1", "/orders/{id}", false},
+	}
+	for _, c := range cases {
+		if matchPath(c.pattern, c.path) != c.match {
+			t.Fatalf("matchPath(%s, %s) failed", c.pattern, c.path)
+		}
+	}
+}
+
+func TestSchemaIndex(t *testing.T) {
+	idx := &SchemaIndex{items: make(map[string]map[string]*schemaIndexItem)}
+	idx.SetSchema("a/a", "s1", "order", indexSwagger)
+	idx.SetSchema("a/a", "s2", "user", indexOpenAPI)
+	idx.SetSchema("a/a", "s2", "proto", "syntax = \"proto3\";")
+	idx.SetServicePaths("a/a", "s3", []*proto.ServicePath{{Path: "/orders"}, {Path: "/stocks"}})
+	idx.SetSchema("b/b", "s4", "order", indexSwagger)
+
+	search := func(in *proto.SearchSchemasRequest) map[string]*proto.SchemaSearchResult {
+		m := make(map[string]*proto.SchemaSearchResult)
+		for _, r := range idx.Search("a/a", in) {
+			m[r.ServiceId+"/"+r.SchemaId] = r
+		}
+		return m
+	}
+
+	results := search(&proto.SearchSchemasRequest{Method: "post", Path: "/orders"})
+	if len(results) != 1 || len(results["s1/order"].Operations) != 1 ||
+		results["s1/order"].Operations[0].OperationId != "createOrder" {
+		t.Fatalf("search by method and path failed, %v", results)
+	}
+
+	results = search(&proto.SearchSchemasRequest{Path: "/orders"})
+	if len(results) != 2 || results["s3/"] == nil || len(results["s3/"].Paths) != 1 {
+		t.Fatalf("search by path failed, %v", results)
+	}
+
+	results = search(&proto.SearchSchemasRequest{Keyword: "ORDER query"})
+	if len(results) != 1 || len(results["s1/order"].Operations) != 1 ||
+		results["s1/order"].Operations[0].OperationId != "getOrder" {
+		t.Fatalf("search by keyword failed, %v", results)
+	}
+
+	results = search(&proto.SearchSchemasRequest{Keyword: "item"})
+	if len(results) != 1 || len(results["s1/order"].Models) != 1 || len(results["s1/order"].Operations) != 0 {
+		t.Fatalf("search model by keyword failed, %v", results)
+	}
+
+	results = search(&proto.SearchSchemasRequest{Model: "user", OperationId: "deleteUser"})
+	if len(results) != 1 || results["s2/user"] == nil {
+		t.Fatalf("search by model and operationId failed, %v", results)
+	}
+
+	results = search(&proto.SearchSchemasRequest{Model: "user", Tag: "order"})
+	if len(results) != 0 {
+		t.Fatalf("search by model and tag failed, %v", results)
+	}
+
+	idx.RemoveSchema("a/a", "s1", "order")
+	results = search(&proto.SearchSchemasRequest{Path: "/orders"})
+	if len(results) != 1 || results["s3/"] == nil {
+		t.Fatalf("search after removing schema failed, %v", results)
+	}
+
+	idx.RemoveService("a/a", "s3")
+	idx.RemoveService("a/a", "s2")
+	if len(idx.items["a/a"]) != 0 || len(idx.Search("b/b", &proto.SearchSchemasRequest{Tag: "order"})) != 1 {
+		t.Fatalf("search after removing service failed, %v", idx.items)
+	}
+}
@@ -19,8 +19,11 @@ package util
 import (
 	"github.com/apache/incubator-servicecomb-service-center/server/infra/registry"
 	"golang.org/x/net/context"
+	"strings"
 )
 
+var warningEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
 func FromContext(ctx context.Context) []registry.PluginOpOption {
 	opts := make([]registry.PluginOpOption, 0, 5)
 	switch {
@@ -31,3 +34,9 @@ func FromContext(ctx context.Context) []registry.PluginOpOption {
 	}
 	return opts
 }
+
+// WarningOf returns the value of the Warning header with the text in a
+// quoted-string, the '"' and '\' in the text are escaped
+func WarningOf(text string) string {
+	return `299 - "` + warningEscaper.Replace(text) + `"`
+}
@@ -23,6 +23,14 @@ import (
 	"reflect"
 )
 
+// the hard limits of the per-service resources in one request, the quota of
+// the domain project may lower them but never lifts them
+const (
+	MAX_SCHEMAS_PER_REQUEST = 1000
+	MAX_RULES_PER_REQUEST   = 1000
+	MAX_TAGS_PER_REQUEST    = 1000
+)
+
 func Validate(v interface{}) error {
 	if v == nil {
 		return errors.New("data is nil")
@@ -40,6 +48,10 @@ func Validate(v interface{}) error {
 		return GetServiceReqValidator().Validate(v)
 	case *pb.UpdateServicePropsRequest:
 		return UpdateServicePropsReqValidator().Validate(v)
+	case *pb.UpdateDeprecationRequest:
+		return UpdateDeprecationReqValidator().Validate(v)
+	case *pb.RotateServiceCredentialRequest:
+		return RotateCredentialReqValidator().Validate(v)
 
 	case *pb.CreateDependenciesRequest:
 		return CreateDependenciesReqValidator().Validate(v)
@@ -58,12 +70,21 @@ func Validate(v interface{}) error {
 	case *pb.GetAllSchemaRequest:
 		return GetSchemaReqValidator().Validate(v)
 	case *pb.GetSchemaRequest,
-		*pb.DeleteSchemaRequest:
+		*pb.DeleteSchemaRequest,
+		*pb.GetSchemaRevisionsRequest:
 		return GetSchemaReqValidator().Validate(v)
 	case *pb.ModifySchemaRequest:
 		return ModifySchemaReqValidator().Validate(v)
 	case *pb.ModifySchemasRequest:
 		return ModifySchemasReqValidator().Validate(v)
+	case *pb.CheckSchemaCompatibilityRequest:
+		return CheckSchemaReqValidator().Validate(v)
+	case *pb.RollbackSchemaRequest:
+		return SchemaRevisionReqValidator().Validate(v)
+	case *pb.DiffSchemaRevisionsRequest:
+		return DiffSchemaReqValidator().Validate(v)
+	case *pb.SearchSchemasRequest:
+		return SearchSchemasReqValidator().Validate(v)
 
 	case *pb.GetOneInstanceRequest,
 		*pb.GetInstancesRequest:
@@ -87,9 +108,22 @@ func Validate(v interface{}) error {
 		return UpdateRuleReqValidator().Validate(v)
 	case *pb.DeleteServiceRulesRequest:
 		return DeleteRulesReqValidator().Validate(v)
+	case *pb.ExplainRulesRequest:
+		return ExplainRulesReqValidator().Validate(v)
 
 	case *pb.GetAppsRequest:
 		return MicroServiceKeyValidator().Validate(v)
+
+	case *pb.CreateDomainRequest:
+		return CreateDomainReqValidator().Validate(v)
+	case *pb.DeleteDomainRequest:
+		return DeleteDomainReqValidator().Validate(v)
+	case *pb.CreateProjectRequest:
+		return CreateProjectReqValidator().Validate(v)
+	case *pb.GetProjectsRequest:
+		return GetProjectsReqValidator().Validate(v)
+	case *pb.DeleteProjectRequest:
+		return DeleteProjectReqValidator().Validate(v)
 	default:
 		util.Logger().Warnf(nil, "No validator for %T.", t)
 		return nil